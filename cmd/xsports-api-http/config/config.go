@@ -1,13 +1,352 @@
+// Package config provides the configuration of the
+// application.
+//
+// The configuration is read from the default values, then the
+// optional YAML file set in CONFIG_FILE environment variable,
+// then the environment variables, each overriding the
+// previous ones.
 package config
 
-import "os"
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 
-func BaseConfig() string {
-	return "" +
-		"host=" + os.Getenv("PGHOST") +
-		" user=" + os.Getenv("PGUSER") +
-		" password=" + os.Getenv("PGPASSWORD") +
-		" dbname=" + os.Getenv("PGDATABASE") +
-		" port=" + os.Getenv("PGPORT") +
-		" sslmode=disable"
+	"github.com/creasty/defaults"
+	"github.com/x-sports/global/logging"
+	"github.com/x-sports/global/tracing"
+	"gopkg.in/yaml.v3"
+)
+
+// envConfigFile is the environment variable of the YAML
+// config file path.
+const envConfigFile = "CONFIG_FILE"
+
+// Config denotes the application configuration.
+type Config struct {
+	Server   Server   `yaml:"server"`
+	Database Database `yaml:"database"`
+	Auth     Auth     `yaml:"auth"`
+	CORS     CORS     `yaml:"cors"`
+	Upload   Upload   `yaml:"upload"`
+	SMTP     SMTP     `yaml:"smtp"`
+	Log      Log      `yaml:"log"`
+	Tracing  Tracing  `yaml:"tracing"`
+
+	// SiteURL is the public URL of the site used in feed
+	// links, the request host is used if it is empty.
+	SiteURL string `yaml:"site_url" env:"SITE_URL"`
+}
+
+// Server denotes the HTTP server configuration.
+type Server struct {
+	Address      string        `yaml:"address" env:"ADDRESS"`
+	Port         int           `yaml:"port" env:"PORT" default:"8080"`
+	ReadTimeout  time.Duration `yaml:"read_timeout" env:"SERVER_READ_TIMEOUT" default:"10s"`
+	WriteTimeout time.Duration `yaml:"write_timeout" env:"SERVER_WRITE_TIMEOUT" default:"10s"`
+
+	// HandlerTimeout is the processing time limit of an API
+	// request, unless its handler has its own limit. It is
+	// shorter than the write timeout, so that the timeout
+	// response can still be written.
+	HandlerTimeout time.Duration `yaml:"handler_timeout" env:"SERVER_HANDLER_TIMEOUT" default:"3s"`
+
+	// ShutdownTimeout is the maximum duration to wait for the
+	// in-flight requests when the server is stopped.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SERVER_SHUTDOWN_TIMEOUT" default:"15s"`
+}
+
+// Addr returns the address the server listens to.
+func (s Server) Addr() string {
+	return net.JoinHostPort(s.Address, strconv.Itoa(s.Port))
+}
+
+// Database denotes the PostgreSQL database configuration.
+type Database struct {
+	Host     string `yaml:"host" env:"PGHOST" default:"localhost"`
+	Port     int    `yaml:"port" env:"PGPORT" default:"5432"`
+	User     string `yaml:"user" env:"PGUSER"`
+	Password string `yaml:"password" env:"PGPASSWORD"`
+	Name     string `yaml:"name" env:"PGDATABASE"`
+
+	// SSLMode is one of the sslmode supported by lib/pq.
+	SSLMode string `yaml:"ssl_mode" env:"PGSSLMODE" default:"disable"`
+
+	MaxOpenConns    int           `yaml:"max_open_conns" env:"DB_MAX_OPEN_CONNS" default:"20"`
+	MaxIdleConns    int           `yaml:"max_idle_conns" env:"DB_MAX_IDLE_CONNS" default:"5"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" env:"DB_CONN_MAX_LIFETIME" default:"30m"`
+
+	// QueryTimeout is the time limit of a single query. It is
+	// shorter than the handler timeout, so that a slow query
+	// is reported as a query timeout.
+	QueryTimeout time.Duration `yaml:"query_timeout" env:"DB_QUERY_TIMEOUT" default:"2s"`
+
+	// AutoMigrate applies the pending migrations when the
+	// server starts.
+	AutoMigrate bool `yaml:"auto_migrate" env:"AUTO_MIGRATE"`
+}
+
+// sslModes are the sslmode supported by lib/pq.
+var sslModes = map[string]struct{}{
+	"disable":     {},
+	"require":     {},
+	"verify-ca":   {},
+	"verify-full": {},
+}
+
+// DSN returns the data source name of the database.
+func (d Database) DSN() string {
+	params := []struct {
+		key   string
+		value string
+	}{
+		{"host", d.Host},
+		{"port", strconv.Itoa(d.Port)},
+		{"user", d.User},
+		{"password", d.Password},
+		{"dbname", d.Name},
+		{"sslmode", d.SSLMode},
+	}
+
+	// values are quoted, so that they may contain spaces
+	dsn := make([]string, 0, len(params))
+	for _, p := range params {
+		if p.value == "" {
+			continue
+		}
+		value := strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(p.value)
+		dsn = append(dsn, fmt.Sprintf("%s='%s'", p.key, value))
+	}
+
+	return strings.Join(dsn, " ")
+}
+
+// Auth denotes the admin authentication configuration.
+type Auth struct {
+	PasswordSalt    string        `yaml:"password_salt" env:"PasswordSalt"`
+	TokenSecretKey  string        `yaml:"token_secret_key" env:"TokenSecretKey"`
+	TokenExpiration time.Duration `yaml:"token_expiration" env:"TOKEN_EXPIRATION" default:"10000h"`
+}
+
+// CORS denotes the cross-origin resource sharing
+// configuration.
+type CORS struct {
+	// AllowedOrigins are the origins allowed to access the
+	// API, "*" allows any origin.
+	AllowedOrigins []string `yaml:"allowed_origins" env:"CORS_ALLOWED_ORIGINS" default:"[\"*\"]"`
+}
+
+// Upload denotes the file upload configuration, upload is
+// disabled if Cloudinary is not configured.
+type Upload struct {
+	CloudinaryName   string `yaml:"cloudinary_name" env:"CLOUDINARY_API_NAME"`
+	CloudinaryKey    string `yaml:"cloudinary_key" env:"CLOUDINARY_API_KEY"`
+	CloudinarySecret string `yaml:"cloudinary_secret" env:"CLOUDINARY_API_SECRET"`
+	MaxFileSize      int64  `yaml:"max_file_size" env:"UPLOAD_MAX_FILE_SIZE" default:"1048576"`
+}
+
+// Enabled returns whether upload is configured.
+func (u Upload) Enabled() bool {
+	return u.CloudinaryName != "" || u.CloudinaryKey != "" || u.CloudinarySecret != ""
+}
+
+// SMTP denotes the SMTP server configuration used to send
+// email notifications, email is disabled if the address is
+// empty.
+type SMTP struct {
+	Address  string `yaml:"address" env:"SMTP_ADDRESS"`
+	Username string `yaml:"username" env:"SMTP_USERNAME"`
+	Password string `yaml:"password" env:"SMTP_PASSWORD"`
+	From     string `yaml:"from" env:"SMTP_FROM"`
+}
+
+// Log denotes the logging configuration.
+type Log struct {
+	// Level is the minimum level of the logged records, one
+	// of debug, info, warn, or error.
+	Level string `yaml:"level" env:"LOG_LEVEL" default:"info"`
+
+	// Format is the format of the records, either json or
+	// text.
+	Format string `yaml:"format" env:"LOG_FORMAT" default:"json"`
+}
+
+// Tracing denotes the OpenTelemetry tracing configuration.
+type Tracing struct {
+	// Exporter is where the spans are exported, one of none,
+	// stdout, or otlp.
+	Exporter string `yaml:"exporter" env:"TRACING_EXPORTER" default:"none"`
+
+	// Endpoint is the host:port of the OTLP collector over
+	// HTTP, the OTEL_EXPORTER_OTLP_ENDPOINT environment
+	// variable is used if it is empty.
+	Endpoint string `yaml:"endpoint" env:"TRACING_ENDPOINT"`
+	Insecure bool   `yaml:"insecure" env:"TRACING_INSECURE"`
+
+	ServiceName string  `yaml:"service_name" env:"TRACING_SERVICE_NAME" default:"xsports-api-http"`
+	SampleRatio float64 `yaml:"sample_ratio" env:"TRACING_SAMPLE_RATIO" default:"1"`
+}
+
+// Default returns the configuration with only the default
+// values.
+func Default() (Config, error) {
+	var cfg Config
+	if err := defaults.Set(&cfg); err != nil {
+		return Config{}, fmt.Errorf("failed to set default config: %s", err.Error())
+	}
+
+	return cfg, nil
+}
+
+// Load reads and validates the configuration.
+func Load() (Config, error) {
+	cfg, err := Default()
+	if err != nil {
+		return Config{}, err
+	}
+
+	if path := os.Getenv(envConfigFile); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return Config{}, fmt.Errorf("failed to read config file: %s", err.Error())
+		}
+
+		err = yaml.Unmarshal(data, &cfg)
+		if err != nil {
+			return Config{}, fmt.Errorf("failed to parse config file %s: %s", path, err.Error())
+		}
+	}
+
+	err = loadEnv(&cfg)
+	if err != nil {
+		return Config{}, err
+	}
+
+	err = cfg.Validate()
+	if err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// Validate returns an error listing all invalid values of the
+// configuration, or nil if it is valid.
+func (c Config) Validate() error {
+	var errs []error
+	invalid := func(key, format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf("%s: "+format, append([]interface{}{key}, args...)...))
+	}
+
+	if c.Server.Port <= 0 || c.Server.Port > 65535 {
+		invalid("server.port", "must be between 1 and 65535, got %d", c.Server.Port)
+	}
+	if c.Server.ReadTimeout <= 0 {
+		invalid("server.read_timeout", "must be positive, got %s", c.Server.ReadTimeout)
+	}
+	if c.Server.WriteTimeout <= 0 {
+		invalid("server.write_timeout", "must be positive, got %s", c.Server.WriteTimeout)
+	}
+	if c.Server.HandlerTimeout <= 0 {
+		invalid("server.handler_timeout", "must be positive, got %s", c.Server.HandlerTimeout)
+	} else if c.Server.WriteTimeout > 0 && c.Server.HandlerTimeout >= c.Server.WriteTimeout {
+		invalid("server.handler_timeout", "must be shorter than write_timeout %s, got %s", c.Server.WriteTimeout, c.Server.HandlerTimeout)
+	}
+	if c.Server.ShutdownTimeout <= 0 {
+		invalid("server.shutdown_timeout", "must be positive, got %s", c.Server.ShutdownTimeout)
+	}
+
+	if c.Database.Host == "" {
+		invalid("database.host", "is required")
+	}
+	if c.Database.Port <= 0 || c.Database.Port > 65535 {
+		invalid("database.port", "must be between 1 and 65535, got %d", c.Database.Port)
+	}
+	if c.Database.User == "" {
+		invalid("database.user", "is required")
+	}
+	if c.Database.Name == "" {
+		invalid("database.name", "is required")
+	}
+	if _, ok := sslModes[c.Database.SSLMode]; !ok {
+		invalid("database.ssl_mode", "must be one of disable, require, verify-ca, or verify-full, got %q", c.Database.SSLMode)
+	}
+	if c.Database.MaxOpenConns < 0 {
+		invalid("database.max_open_conns", "must not be negative, got %d", c.Database.MaxOpenConns)
+	}
+	if c.Database.MaxIdleConns < 0 {
+		invalid("database.max_idle_conns", "must not be negative, got %d", c.Database.MaxIdleConns)
+	}
+	if c.Database.MaxOpenConns > 0 && c.Database.MaxIdleConns > c.Database.MaxOpenConns {
+		invalid("database.max_idle_conns", "must not exceed max_open_conns %d, got %d", c.Database.MaxOpenConns, c.Database.MaxIdleConns)
+	}
+	if c.Database.ConnMaxLifetime < 0 {
+		invalid("database.conn_max_lifetime", "must not be negative, got %s", c.Database.ConnMaxLifetime)
+	}
+	if c.Database.QueryTimeout <= 0 {
+		invalid("database.query_timeout", "must be positive, got %s", c.Database.QueryTimeout)
+	} else if c.Server.HandlerTimeout > 0 && c.Database.QueryTimeout >= c.Server.HandlerTimeout {
+		invalid("database.query_timeout", "must be shorter than server.handler_timeout %s, got %s", c.Server.HandlerTimeout, c.Database.QueryTimeout)
+	}
+
+	if c.Auth.PasswordSalt == "" {
+		invalid("auth.password_salt", "is required")
+	}
+	if c.Auth.TokenSecretKey == "" {
+		invalid("auth.token_secret_key", "is required")
+	}
+	if c.Auth.TokenExpiration <= 0 {
+		invalid("auth.token_expiration", "must be positive, got %s", c.Auth.TokenExpiration)
+	}
+
+	if len(c.CORS.AllowedOrigins) == 0 {
+		invalid("cors.allowed_origins", "is required, use \"*\" to allow any origin")
+	}
+
+	if c.Upload.Enabled() && (c.Upload.CloudinaryName == "" || c.Upload.CloudinaryKey == "" || c.Upload.CloudinarySecret == "") {
+		invalid("upload", "cloudinary_name, cloudinary_key, and cloudinary_secret must be set together")
+	}
+	if c.Upload.MaxFileSize <= 0 {
+		invalid("upload.max_file_size", "must be positive, got %d", c.Upload.MaxFileSize)
+	}
+
+	if c.SMTP.Address != "" {
+		if _, _, err := net.SplitHostPort(c.SMTP.Address); err != nil {
+			invalid("smtp.address", "must be host:port, got %q", c.SMTP.Address)
+		}
+		if c.SMTP.From == "" {
+			invalid("smtp.from", "is required when smtp.address is set")
+		}
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.Log.Level)); err != nil {
+		invalid("log.level", "must be one of debug, info, warn, or error, got %q", c.Log.Level)
+	}
+	if c.Log.Format != logging.FormatJSON && c.Log.Format != logging.FormatText {
+		invalid("log.format", "must be either json or text, got %q", c.Log.Format)
+	}
+
+	switch c.Tracing.Exporter {
+	case tracing.ExporterNone, tracing.ExporterStdout, tracing.ExporterOTLP:
+	default:
+		invalid("tracing.exporter", "must be one of none, stdout, or otlp, got %q", c.Tracing.Exporter)
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		invalid("tracing.sample_ratio", "must be between 0 and 1, got %g", c.Tracing.SampleRatio)
+	}
+	if c.Tracing.Exporter != tracing.ExporterNone && c.Tracing.ServiceName == "" {
+		invalid("tracing.service_name", "is required when tracing is enabled")
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid config:\n%w", errors.Join(errs...))
+	}
+
+	return nil
 }
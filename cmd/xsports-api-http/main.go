@@ -2,7 +2,6 @@ package main
 
 import (
 	"os"
-	"sync"
 
 	"github.com/joho/godotenv"
 	"github.com/x-sports/cmd/xsports-api-http/server"
@@ -11,11 +10,20 @@ import (
 func main() {
 	godotenv.Load()
 
-	wg := sync.WaitGroup{}
-	wg.Add(1)
-	go func() {
-		os.Exit(server.Run())
-		defer wg.Done()
-	}()
-	wg.Wait()
+	// run the given command, or the server if there is none
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "import":
+			os.Exit(server.RunImport(os.Args[2:]))
+		case "export":
+			os.Exit(server.RunExport(os.Args[2:]))
+		case "migrate":
+			os.Exit(server.RunMigrate(os.Args[2:]))
+		case "seed":
+			os.Exit(server.RunSeed(os.Args[2:]))
+		}
+	}
+
+	// the server returns once it is stopped by a signal
+	os.Exit(server.Run())
 }
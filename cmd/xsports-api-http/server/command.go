@@ -0,0 +1,373 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/migration"
+	"github.com/x-sports/internal/schedule"
+	"github.com/x-sports/internal/seed"
+)
+
+// RunImport imports games, teams, or matches from a CSV or
+// JSON file, see schedule.ImportRequest for its columns:
+//
+//	xsports-api-http import -kind matches [-format csv] [-dry-run] FILE
+//
+// The report of each record is printed to stdout. RunImport
+// returns a status code suitable for os.Exit() argument, it
+// fails if any record has a problem.
+func RunImport(args []string) int {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	kind := flags.String("kind", "", "kind of the records: games, teams, or matches")
+	format := flags.String("format", "", "format of the file: csv or json, taken from the file extension if not set")
+	dryRun := flags.Bool("dry-run", false, "only report the problems and the resolved names")
+	if err := flags.Parse(args); err != nil {
+		return CodeBadArgs
+	}
+	if flags.NArg() != 1 {
+		fmt.Fprintln(flags.Output(), "usage: xsports-api-http import -kind KIND [-format FORMAT] [-dry-run] FILE")
+		return CodeBadArgs
+	}
+
+	path := flags.Arg(0)
+	if *format == "" {
+		*format = strings.TrimPrefix(filepath.Ext(path), ".")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		log.Printf("[xsports-api-http][import] failed to open file: %s\n", err.Error())
+		return CodeBadArgs
+	}
+	defer file.Close()
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return CodeBadConfig
+	}
+
+	db, err := connectDatabase(cfg.Database)
+	if err != nil {
+		return CodeBadConfig
+	}
+	defer db.Close()
+
+	st, err := newPGStores(db, cfg.Database)
+	if err != nil {
+		return CodeBadConfig
+	}
+	svcs, err := newServices(st, cfg)
+	if err != nil {
+		return CodeBadConfig
+	}
+
+	report, err := svcs.schedule.Import(context.Background(), schedule.ImportRequest{
+		Kind:   schedule.Kind(*kind),
+		Format: schedule.Format(strings.ToLower(*format)),
+		Data:   file,
+		DryRun: *dryRun,
+	})
+	printImportReport(os.Stdout, report)
+	if err != nil {
+		log.Printf("[xsports-api-http][import] failed to import: %s\n", err.Error())
+		return CodeFailCommand
+	}
+
+	for _, rr := range report.Records {
+		if len(rr.Problems) > 0 {
+			return CodeFailCommand
+		}
+	}
+
+	return CodeSuccess
+}
+
+// RunExport exports matches as CSV into a file, or stdout if
+// the file is not set:
+//
+//	xsports-api-http export [-game-id ID] [-status STATUS] [-o FILE]
+//
+// RunExport returns a status code suitable for os.Exit()
+// argument.
+func RunExport(args []string) int {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	gameID := flags.Int64("game-id", 0, "only export matches of the game")
+	status := flags.String("status", "", "only export matches with the status: upcoming, ongoing, or completed")
+	output := flags.String("o", "", "file to write, stdout if not set")
+	if err := flags.Parse(args); err != nil {
+		return CodeBadArgs
+	}
+
+	filter := schedule.ExportFilter{
+		GameID: *gameID,
+	}
+	if *status != "" {
+		for s := range match.StatusList {
+			if s.String() == *status {
+				filter.Status = s
+			}
+		}
+		if filter.Status == match.StatusUnknown {
+			log.Printf("[xsports-api-http][export] invalid status: %s\n", *status)
+			return CodeBadArgs
+		}
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			log.Printf("[xsports-api-http][export] failed to create file: %s\n", err.Error())
+			return CodeBadArgs
+		}
+		defer file.Close()
+		w = file
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return CodeBadConfig
+	}
+
+	db, err := connectDatabase(cfg.Database)
+	if err != nil {
+		return CodeBadConfig
+	}
+	defer db.Close()
+
+	st, err := newPGStores(db, cfg.Database)
+	if err != nil {
+		return CodeBadConfig
+	}
+	svcs, err := newServices(st, cfg)
+	if err != nil {
+		return CodeBadConfig
+	}
+
+	err = svcs.schedule.ExportMatches(context.Background(), w, filter)
+	if err != nil {
+		log.Printf("[xsports-api-http][export] failed to export: %s\n", err.Error())
+		return CodeFailCommand
+	}
+
+	return CodeSuccess
+}
+
+// RunMigrate applies, reverts, or shows the status of the
+// database migrations:
+//
+//	xsports-api-http migrate up
+//	xsports-api-http migrate down [-steps N]
+//	xsports-api-http migrate status
+//
+// RunMigrate returns a status code suitable for os.Exit()
+// argument.
+func RunMigrate(args []string) int {
+	usage := "usage: xsports-api-http migrate up|down|status"
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, usage)
+		return CodeBadArgs
+	}
+
+	flags := flag.NewFlagSet("migrate "+args[0], flag.ContinueOnError)
+	steps := flags.Int("steps", 1, "number of the latest migrations to revert")
+	if err := flags.Parse(args[1:]); err != nil {
+		return CodeBadArgs
+	}
+	if flags.NArg() != 0 {
+		fmt.Fprintln(flags.Output(), usage)
+		return CodeBadArgs
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return CodeBadConfig
+	}
+
+	db, err := connectDatabase(cfg.Database)
+	if err != nil {
+		return CodeBadConfig
+	}
+	defer db.Close()
+
+	migrator, err := migration.New(db)
+	if err != nil {
+		log.Printf("[xsports-api-http][migrate] failed to load migrations: %s\n", err.Error())
+		return CodeBadConfig
+	}
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, mig := range applied {
+			fmt.Printf("applied %d_%s\n", mig.Version, mig.Name)
+		}
+		if err != nil {
+			log.Printf("[xsports-api-http][migrate] failed to apply migrations: %s\n", err.Error())
+			return CodeFailCommand
+		}
+		if len(applied) == 0 {
+			fmt.Println("no pending migration")
+		}
+	case "down":
+		if *steps <= 0 {
+			fmt.Fprintln(flags.Output(), "steps must be positive")
+			return CodeBadArgs
+		}
+
+		reverted, err := migrator.Down(ctx, *steps)
+		for _, mig := range reverted {
+			fmt.Printf("reverted %d_%s\n", mig.Version, mig.Name)
+		}
+		if err != nil {
+			log.Printf("[xsports-api-http][migrate] failed to revert migrations: %s\n", err.Error())
+			return CodeFailCommand
+		}
+		if len(reverted) == 0 {
+			fmt.Println("no applied migration")
+		}
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.Printf("[xsports-api-http][migrate] failed to get migration status: %s\n", err.Error())
+			return CodeFailCommand
+		}
+		printMigrationStatus(os.Stdout, statuses)
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		return CodeBadArgs
+	}
+
+	return CodeSuccess
+}
+
+// defaultSeedDate is the date the seeded match dates are
+// spread around when no date is given.
+const defaultSeedDate = "2026-01-01"
+
+// RunSeed populates an empty database with deterministic fake
+// data for local development and integration tests, the same
+// flags always create the same data, as the match dates are
+// spread around a fixed date unless another one is given:
+//
+//	xsports-api-http seed [-seed N] [-date YYYY-MM-DD] [-games N] [-teams N] [-matches N] [-news N] [-threads N]
+//
+// RunSeed returns a status code suitable for os.Exit()
+// argument.
+func RunSeed(args []string) int {
+	flags := flag.NewFlagSet("seed", flag.ContinueOnError)
+	seedValue := flags.Int64("seed", 1, "source of the randomness")
+	date := flags.String("date", defaultSeedDate, "date the match dates are spread around")
+	games := flags.Int("games", 3, "number of games")
+	teams := flags.Int("teams", 8, "number of teams of each game")
+	matches := flags.Int("matches", 12, "number of matches of each game")
+	newss := flags.Int("news", 5, "number of news of each game")
+	threads := flags.Int("threads", 5, "number of threads of each game")
+	if err := flags.Parse(args); err != nil {
+		return CodeBadArgs
+	}
+	if flags.NArg() != 0 {
+		fmt.Fprintln(flags.Output(), "usage: xsports-api-http seed [flags]")
+		return CodeBadArgs
+	}
+
+	// dates are at noon, so they stay in the same day in most
+	// time zones
+	baseDate, err := time.Parse("2006-01-02", *date)
+	if err != nil {
+		log.Printf("[xsports-api-http][seed] invalid date: %s\n", *date)
+		return CodeBadArgs
+	}
+	baseTime := baseDate.Add(12 * time.Hour)
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return CodeBadConfig
+	}
+
+	db, err := connectDatabase(cfg.Database)
+	if err != nil {
+		return CodeBadConfig
+	}
+	defer db.Close()
+
+	st, err := newPGStores(db, cfg.Database)
+	if err != nil {
+		return CodeBadConfig
+	}
+	svcs, err := newServices(st, cfg)
+	if err != nil {
+		return CodeBadConfig
+	}
+
+	report, err := svcs.seed.Seed(context.Background(), seed.Options{
+		Seed:           *seedValue,
+		BaseTime:       baseTime,
+		Games:          *games,
+		TeamsPerGame:   *teams,
+		MatchesPerGame: *matches,
+		NewsPerGame:    *newss,
+		ThreadsPerGame: *threads,
+	})
+	if err != nil {
+		log.Printf("[xsports-api-http][seed] failed to seed: %s\n", err.Error())
+		if errors.Is(err, seed.ErrInvalidSize) {
+			return CodeBadArgs
+		}
+		return CodeFailCommand
+	}
+	fmt.Printf("created %d games, %d teams, %d matches, %d news, %d threads\n", report.Games, report.Teams, report.Matches, report.News, report.Threads)
+
+	return CodeSuccess
+}
+
+// printImportReport prints the given import report, a line
+// for each record followed by its problems.
+func printImportReport(w io.Writer, report schedule.ImportReport) {
+	for _, rr := range report.Records {
+		fmt.Fprintf(w, "row %d:", rr.Row)
+		if rr.ID != 0 {
+			fmt.Fprintf(w, " id=%d", rr.ID)
+		}
+		for _, v := range rr.Resolutions {
+			fmt.Fprintf(w, " %s=%q(%d)", v.Column, v.Name, v.ID)
+		}
+		fmt.Fprintln(w)
+
+		for _, v := range rr.Problems {
+			fmt.Fprintf(w, "\t%s: %s %s\n", v.Column, v.Code, v.Message)
+		}
+	}
+
+	switch {
+	case report.Imported:
+		fmt.Fprintf(w, "imported %d records\n", len(report.Records))
+	case report.DryRun:
+		fmt.Fprintln(w, "dry run, nothing is imported")
+	default:
+		fmt.Fprintln(w, "nothing is imported")
+	}
+}
+
+// printMigrationStatus prints the given migration statuses, a
+// line for each migration.
+func printMigrationStatus(w io.Writer, statuses []migration.Status) {
+	for _, st := range statuses {
+		applied := "pending"
+		if st.Applied {
+			applied = "applied at " + st.ApplyTime.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%04d_%s\t%s\n", st.Version, st.Name, applied)
+	}
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/thread"
+)
+
+func TestCreateFollow(t *testing.T) {
+	ts := newTestServer(t)
+	gameID := ts.createGame("Dota 2")
+	teamID := ts.createTeam(gameID, "Team Liquid")
+
+	// concurrent requests follow the team only once
+	const requests = 5
+	statusCodes := make([]int, requests)
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res := ts.do(http.MethodPost, "/follows", map[string]interface{}{
+				"type":      "team",
+				"target_id": teamID,
+			}, nil)
+			statusCodes[i] = res.StatusCode
+		}(i)
+	}
+	wg.Wait()
+
+	created := 0
+	for _, code := range statusCodes {
+		if code == http.StatusOK {
+			created++
+		}
+	}
+	if created != 1 {
+		t.Errorf("created %d follows, want 1: %v", created, statusCodes)
+	}
+
+	res := ts.do(http.MethodPost, "/follows", map[string]interface{}{
+		"type":      "team",
+		"target_id": teamID,
+	}, nil)
+	if res.StatusCode != http.StatusBadRequest || !res.hasError("ALREADY_FOLLOWED") {
+		t.Errorf("got %d %v, want ALREADY_FOLLOWED", res.StatusCode, res.Errors)
+	}
+
+	// the target must exist
+	res = ts.do(http.MethodPost, "/follows", map[string]interface{}{
+		"type":      "game",
+		"target_id": gameID + 100,
+	}, nil)
+	if res.StatusCode != http.StatusBadRequest || !res.hasError("UNKNOWN_TARGET") {
+		t.Errorf("got %d %v, want UNKNOWN_TARGET", res.StatusCode, res.Errors)
+	}
+}
+
+// feedRefs returns the type and reference ID of the items of
+// the feed at the given path, and its next cursor.
+func (ts *testServer) feedRefs(path string) ([]string, string) {
+	ts.t.Helper()
+
+	res := ts.do(http.MethodGet, path, nil, nil)
+	if res.StatusCode != http.StatusOK {
+		ts.t.Fatalf("failed to get feed: %d %v", res.StatusCode, res.Errors)
+	}
+
+	var body struct {
+		Items []struct {
+			Type  string `json:"type"`
+			RefID int64  `json:"ref_id"`
+		} `json:"items"`
+		NextCursor string `json:"next_cursor"`
+	}
+	res.decode(ts.t, &body)
+
+	refs := make([]string, 0, len(body.Items))
+	for _, item := range body.Items {
+		refs = append(refs, fmt.Sprintf("%s:%d", item.Type, item.RefID))
+	}
+	return refs, body.NextCursor
+}
+
+func TestGetFeedUpcoming(t *testing.T) {
+	ts := newTestServer(t)
+	gameID := ts.createGame("Dota 2")
+	teamA := ts.createTeam(gameID, "Team Liquid")
+	teamB := ts.createTeam(gameID, "OG")
+	ts.followTeam(teamA)
+
+	now := time.Now()
+	createMatch := func(date time.Time) int64 {
+		id, err := ts.svcs.match.CreateMatch(context.Background(), match.Match{
+			TournamentNames: "Test Cup",
+			GameID:          gameID,
+			TeamAID:         teamA,
+			TeamBID:         teamB,
+			TeamAOdds:       1.5,
+			TeamBOdds:       2.5,
+			Date:            date,
+			MatchLink:       "https://x-sports.test/live",
+			Status:          match.StatusUpcoming,
+		})
+		if err != nil {
+			t.Fatalf("failed to create match: %s", err)
+		}
+		return id
+	}
+	nextYear := createMatch(now.AddDate(1, 0, 0))
+	tomorrow := createMatch(now.AddDate(0, 0, 1))
+	yesterday := createMatch(now.AddDate(0, 0, -1))
+	threadID, err := ts.svcs.thread.CreateThread(context.Background(), thread.Thread{
+		Title:       "Patch notes",
+		GameID:      gameID,
+		Description: "Patch notes description",
+		ImageThread: "https://x-sports.test/thread.png",
+		Date:        now.Add(-time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("failed to create thread: %s", err)
+	}
+
+	// the feed has the items until now, newest first, so the
+	// upcoming matches do not come before the recent items
+	refs, next := ts.feedRefs("/feed")
+	want := []string{fmt.Sprintf("thread:%d", threadID), fmt.Sprintf("match:%d", yesterday)}
+	if fmt.Sprint(refs) != fmt.Sprint(want) || next != "" {
+		t.Errorf("feed = %v %q, want %v", refs, next, want)
+	}
+
+	// the upcoming matches are soonest first, on their own
+	// pages
+	refs, next = ts.feedRefs("/feed?upcoming=true&limit=1")
+	if want := []string{fmt.Sprintf("match:%d", tomorrow)}; fmt.Sprint(refs) != fmt.Sprint(want) || next == "" {
+		t.Fatalf("upcoming = %v %q, want %v and a next cursor", refs, next, want)
+	}
+	refs, next = ts.feedRefs("/feed?upcoming=true&limit=1&cursor=" + next)
+	if want := []string{fmt.Sprintf("match:%d", nextYear)}; fmt.Sprint(refs) != fmt.Sprint(want) || next != "" {
+		t.Errorf("upcoming = %v %q, want %v", refs, next, want)
+	}
+
+	res := ts.do(http.MethodGet, "/feed?upcoming=soon", nil, nil)
+	if res.StatusCode != http.StatusBadRequest || !res.hasError("INVALID_UPCOMING") {
+		t.Errorf("got %d %v, want INVALID_UPCOMING", res.StatusCode, res.Errors)
+	}
+}
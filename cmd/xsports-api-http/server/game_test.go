@@ -0,0 +1,39 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestGameDetailVersion(t *testing.T) {
+	ts := newTestServer(t)
+	gameID := ts.createGame("Dota 2")
+	ts.createTeam(gameID, "Team Liquid")
+	path := fmt.Sprintf("/games/%d", gameID)
+
+	res := ts.do(http.MethodGet, path, nil, nil)
+	etag := res.Header.Get("ETag")
+	if etag == "" {
+		t.Fatal("missing ETag")
+	}
+
+	res = ts.do(http.MethodGet, path, nil, map[string]string{"If-None-Match": etag})
+	if res.StatusCode != http.StatusNotModified {
+		t.Fatalf("status code = %d, want %d", res.StatusCode, http.StatusNotModified)
+	}
+
+	// the detail is modified by its embedded teams
+	ts.createTeam(gameID, "OG")
+	res = ts.do(http.MethodGet, path, nil, map[string]string{"If-None-Match": etag})
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", res.StatusCode, http.StatusOK)
+	}
+	etag = res.Header.Get("ETag")
+
+	// the tag still has the version of the game to update
+	res = ts.do(http.MethodPatch, path, map[string]interface{}{"game_names": "Dota 2 Reborn"}, map[string]string{"If-Match": etag})
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("status code = %d, want %d: %v", res.StatusCode, http.StatusOK, res.Errors)
+	}
+}
@@ -0,0 +1,300 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/x-sports/cmd/xsports-api-http/config"
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/admin"
+	adminmemstore "github.com/x-sports/internal/admin/store/memory"
+	feedmemstore "github.com/x-sports/internal/feed/store/memory"
+	"github.com/x-sports/internal/game"
+	gamememstore "github.com/x-sports/internal/game/store/memory"
+	matchmemstore "github.com/x-sports/internal/match/store/memory"
+	"github.com/x-sports/internal/memory"
+	newsmemstore "github.com/x-sports/internal/news/store/memory"
+	notificationchannel "github.com/x-sports/internal/notification/channel"
+	notificationmemstore "github.com/x-sports/internal/notification/store/memory"
+	outboxmemstore "github.com/x-sports/internal/outbox/store/memory"
+	seedmemstore "github.com/x-sports/internal/seed/store/memory"
+	"github.com/x-sports/internal/team"
+	teammemstore "github.com/x-sports/internal/team/store/memory"
+	threadmemstore "github.com/x-sports/internal/thread/store/memory"
+	webhookmemstore "github.com/x-sports/internal/webhook/store/memory"
+)
+
+// Followings are the credentials of the admin created in the
+// test server.
+const (
+	testAdminEmail    = "admin@x-sports.test"
+	testAdminPassword = "secret"
+)
+
+// testServer is an HTTP server serving all handlers, with
+// services using in-memory stores instead of PostgreSQL.
+type testServer struct {
+	t     *testing.T
+	srv   *httptest.Server
+	db    *memory.DB
+	svcs  *services
+	token string
+
+	// channel is a notification channel used in addition to
+	// the in-app one.
+	channel *notificationchannel.Fake
+}
+
+// testResponse is a response of the test server, with its
+// body decoded from helper.ResponseEnvelope if it is JSON.
+type testResponse struct {
+	StatusCode  int
+	Header      http.Header
+	Body        []byte                      `json:"-"`
+	Data        json.RawMessage             `json:"data"`
+	Errors      []string                    `json:"errors"`
+	FieldErrors []helper.FieldErrorEnvelope `json:"field_errors"`
+}
+
+// newTestServer starts a new test server with an empty
+// database, except for an admin whose token is used by the
+// requests. The server is closed when the test ends.
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+
+	cfg, err := config.Default()
+	if err != nil {
+		t.Fatalf("failed to get default config: %s", err)
+	}
+	cfg.Auth.PasswordSalt = "test-salt"
+	cfg.Auth.TokenSecretKey = "test-secret"
+
+	db := memory.New()
+	err = db.NewClient(false).Do(func(data *memory.Data) error {
+		id := data.Admins.NextID()
+		data.Admins.Put(id, admin.Admin{
+			ID:         id,
+			Email:      testAdminEmail,
+			Password:   testAdminPassword,
+			CreateTime: time.Now(),
+		})
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to create admin: %s", err)
+	}
+
+	st, err := instrumentStores(newMemoryStores(t, db))
+	if err != nil {
+		t.Fatalf("failed to instrument stores: %s", err)
+	}
+
+	channel := notificationchannel.NewFake()
+	svcs, err := newServices(st, cfg, channel)
+	if err != nil {
+		t.Fatalf("failed to initialize services: %s", err)
+	}
+
+	handlers, err := newHandlers(svcs, cfg)
+	if err != nil {
+		t.Fatalf("failed to initialize handlers: %s", err)
+	}
+
+	router, err := newRouter(handlers, newProbe(nil), cfg.Server, cfg.CORS)
+	if err != nil {
+		t.Fatalf("failed to start handlers: %s", err)
+	}
+
+	ts := &testServer{
+		t:    t,
+		srv:  httptest.NewServer(router),
+		db:   db,
+		svcs: svcs,
+
+		channel: channel,
+	}
+	t.Cleanup(ts.srv.Close)
+
+	// login to get the token of the admin
+	res := ts.do(http.MethodPost, "/login", map[string]string{
+		"email":    testAdminEmail,
+		"password": testAdminPassword,
+	}, nil)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("failed to login: %d %v", res.StatusCode, res.Errors)
+	}
+
+	var login struct {
+		Token string `json:"token"`
+	}
+	res.decode(t, &login)
+	ts.token = login.Token
+
+	return ts
+}
+
+// newMemoryStores returns in-memory stores of all services
+// using the given database.
+func newMemoryStores(t *testing.T, db *memory.DB) *stores {
+	t.Helper()
+
+	st := &stores{}
+	var err error
+	if st.admin, err = adminmemstore.New(db); err != nil {
+		t.Fatalf("failed to initialize admin memory store: %s", err)
+	}
+	if st.game, err = gamememstore.New(db); err != nil {
+		t.Fatalf("failed to initialize game memory store: %s", err)
+	}
+	if st.team, err = teammemstore.New(db); err != nil {
+		t.Fatalf("failed to initialize team memory store: %s", err)
+	}
+	if st.notification, err = notificationmemstore.New(db); err != nil {
+		t.Fatalf("failed to initialize notification memory store: %s", err)
+	}
+	if st.webhook, err = webhookmemstore.New(db); err != nil {
+		t.Fatalf("failed to initialize webhook memory store: %s", err)
+	}
+	if st.match, err = matchmemstore.New(db); err != nil {
+		t.Fatalf("failed to initialize match memory store: %s", err)
+	}
+	if st.news, err = newsmemstore.New(db); err != nil {
+		t.Fatalf("failed to initialize news memory store: %s", err)
+	}
+	if st.thread, err = threadmemstore.New(db); err != nil {
+		t.Fatalf("failed to initialize thread memory store: %s", err)
+	}
+	if st.outbox, err = outboxmemstore.New(db); err != nil {
+		t.Fatalf("failed to initialize outbox memory store: %s", err)
+	}
+	if st.feed, err = feedmemstore.New(db); err != nil {
+		t.Fatalf("failed to initialize feed memory store: %s", err)
+	}
+	if st.seed, err = seedmemstore.New(db); err != nil {
+		t.Fatalf("failed to initialize seed memory store: %s", err)
+	}
+
+	return st
+}
+
+// do sends a request with the given method, path under
+// /api/v1, JSON body, and headers, authorized as the admin.
+func (ts *testServer) do(method, path string, body interface{}, header map[string]string) testResponse {
+	ts.t.Helper()
+
+	var reqBody bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&reqBody).Encode(body); err != nil {
+			ts.t.Fatalf("failed to encode request body: %s", err)
+		}
+	}
+
+	return ts.doRaw(method, path, "application/json", reqBody.Bytes(), header)
+}
+
+// doRaw sends a request the same way as do, with the given
+// body of the given content type.
+func (ts *testServer) doRaw(method, path, contentType string, body []byte, header map[string]string) testResponse {
+	ts.t.Helper()
+
+	req, err := http.NewRequest(method, ts.srv.URL+"/api/v1"+path, bytes.NewReader(body))
+	if err != nil {
+		ts.t.Fatalf("failed to create request: %s", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	if ts.token != "" {
+		req.Header.Set("Authorization", "Bearer "+ts.token)
+	}
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := ts.srv.Client().Do(req)
+	if err != nil {
+		ts.t.Fatalf("failed to send request: %s", err)
+	}
+	defer resp.Body.Close()
+
+	res := testResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+	}
+	res.Body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		ts.t.Fatalf("failed to read response body of %s %s: %s", method, path, err)
+	}
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		if err := json.Unmarshal(res.Body, &res); err != nil {
+			ts.t.Fatalf("failed to decode response body of %s %s: %s", method, path, err)
+		}
+	}
+
+	return res
+}
+
+// createGame creates a game directly through the service.
+func (ts *testServer) createGame(names string) int64 {
+	ts.t.Helper()
+
+	id, err := ts.svcs.game.CreateGame(context.Background(), game.Game{
+		GameNames: names,
+		GameIcons: "https://x-sports.test/" + names + ".png",
+	})
+	if err != nil {
+		ts.t.Fatalf("failed to create game %s: %s", names, err)
+	}
+	return id
+}
+
+// createTeam creates a team of the given game directly
+// through the service.
+func (ts *testServer) createTeam(gameID int64, names string) int64 {
+	ts.t.Helper()
+
+	id, err := ts.svcs.team.CreateTeam(context.Background(), team.Team{
+		TeamNames: names,
+		TeamIcons: "https://x-sports.test/" + names + ".png",
+		GameID:    gameID,
+	})
+	if err != nil {
+		ts.t.Fatalf("failed to create team %s: %s", names, err)
+	}
+	return id
+}
+
+// decode decodes the data of the response into v.
+func (res testResponse) decode(t *testing.T, v interface{}) {
+	t.Helper()
+
+	if err := json.Unmarshal(res.Data, v); err != nil {
+		t.Fatalf("failed to decode response data %s: %s", res.Data, err)
+	}
+}
+
+// hasError returns whether the response has the given error.
+func (res testResponse) hasError(err string) bool {
+	for _, e := range res.Errors {
+		if e == err {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldError returns the error of the given field, and false
+// if there is none.
+func (res testResponse) fieldError(field string) (helper.FieldErrorEnvelope, bool) {
+	for _, fe := range res.FieldErrors {
+		if fe.Field == field {
+			return fe, true
+		}
+	}
+	return helper.FieldErrorEnvelope{}, false
+}
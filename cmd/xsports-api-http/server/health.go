@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/x-sports/global/helper"
+)
+
+// checkTimeout is the maximum duration of each component
+// check of the readiness probe.
+const checkTimeout = 2 * time.Second
+
+// Followings are the status reported by the probes.
+const (
+	statusOK          = "ok"
+	statusUnavailable = "unavailable"
+	statusDraining    = "draining"
+)
+
+// healthCheck checks whether a component of the server is
+// able to serve requests.
+type healthCheck func(ctx context.Context) error
+
+// probe serves the liveness and readiness probes of the
+// server.
+type probe struct {
+	checks   map[string]healthCheck
+	draining atomic.Bool
+}
+
+// probeResponse is the body of the probe responses.
+type probeResponse struct {
+	Status     string                       `json:"status"`
+	Components map[string]componentResponse `json:"components,omitempty"`
+}
+
+// componentResponse is the status of a component in the
+// readiness probe response.
+type componentResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// newProbe returns a probe checking the given components,
+// keyed by their names, for readiness.
+func newProbe(checks map[string]healthCheck) *probe {
+	return &probe{
+		checks: checks,
+	}
+}
+
+// drain marks the server as shutting down, so that it is not
+// ready to receive new requests anymore.
+func (p *probe) drain() {
+	p.draining.Store(true)
+}
+
+// register registers the probe endpoints to the given router.
+func (p *probe) register(router *mux.Router) {
+	router.HandleFunc("/livez", p.handleLive).Methods(http.MethodGet, http.MethodHead)
+	router.HandleFunc("/readyz", p.handleReady).Methods(http.MethodGet, http.MethodHead)
+}
+
+// handleLive reports that the server process is running.
+func (p *probe) handleLive(w http.ResponseWriter, r *http.Request) {
+	writeProbeResponse(w, http.StatusOK, probeResponse{
+		Status: statusOK,
+	})
+}
+
+// handleReady reports whether all components are able to
+// serve requests. Components are checked concurrently.
+func (p *probe) handleReady(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), checkTimeout)
+	defer cancel()
+
+	type result struct {
+		name string
+		err  error
+	}
+	results := make(chan result, len(p.checks))
+	for name, check := range p.checks {
+		go func(name string, check healthCheck) {
+			results <- result{name: name, err: check(ctx)}
+		}(name, check)
+	}
+
+	resp := probeResponse{
+		Status:     statusOK,
+		Components: make(map[string]componentResponse, len(p.checks)),
+	}
+	for range p.checks {
+		res := <-results
+		if res.err != nil {
+			resp.Status = statusUnavailable
+			resp.Components[res.name] = componentResponse{
+				Status: statusUnavailable,
+				Error:  res.err.Error(),
+			}
+			continue
+		}
+		resp.Components[res.name] = componentResponse{
+			Status: statusOK,
+		}
+	}
+
+	// components may be reachable while shutting down, but
+	// new requests should go to the other instances
+	if p.draining.Load() {
+		resp.Status = statusDraining
+	}
+
+	statusCode := http.StatusOK
+	if resp.Status != statusOK {
+		statusCode = http.StatusServiceUnavailable
+	}
+	writeProbeResponse(w, statusCode, resp)
+}
+
+// writeProbeResponse writes the given probe response as JSON.
+func writeProbeResponse(w http.ResponseWriter, statusCode int, resp probeResponse) {
+	body, err := json.Marshal(resp)
+	if err != nil {
+		helper.WriteErrorResponse(w, http.StatusInternalServerError, []string{err.Error()})
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	helper.WriteResponse(w, body, statusCode, helper.JSONContentTypeDecorator)
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/x-sports/cmd/xsports-api-http/config"
+)
+
+// getProbe requests the given probe path and returns the
+// status code and the decoded body.
+func getProbe(t *testing.T, p *probe, path string) (int, probeResponse) {
+	t.Helper()
+
+	router, err := newRouter(nil, p, config.Server{}, config.CORS{AllowedOrigins: []string{"*"}})
+	if err != nil {
+		t.Fatalf("failed to create router: %s", err)
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+	var resp probeResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response %s: %s", rec.Body, err)
+	}
+	return rec.Code, resp
+}
+
+func TestProbe(t *testing.T) {
+	up := func(ctx context.Context) error { return nil }
+	down := func(ctx context.Context) error { return errors.New("connection refused") }
+
+	t.Run("live", func(t *testing.T) {
+		code, resp := getProbe(t, newProbe(map[string]healthCheck{"database": down}), "/livez")
+		if code != http.StatusOK || resp.Status != statusOK {
+			t.Errorf("livez = %d %s, want %d %s", code, resp.Status, http.StatusOK, statusOK)
+		}
+	})
+
+	t.Run("ready", func(t *testing.T) {
+		code, resp := getProbe(t, newProbe(map[string]healthCheck{"database": up}), "/readyz")
+		if code != http.StatusOK || resp.Status != statusOK {
+			t.Errorf("readyz = %d %s, want %d %s", code, resp.Status, http.StatusOK, statusOK)
+		}
+		if c := resp.Components["database"]; c.Status != statusOK {
+			t.Errorf("database status = %s, want %s", c.Status, statusOK)
+		}
+	})
+
+	t.Run("component unavailable", func(t *testing.T) {
+		code, resp := getProbe(t, newProbe(map[string]healthCheck{"database": down}), "/readyz")
+		if code != http.StatusServiceUnavailable || resp.Status != statusUnavailable {
+			t.Errorf("readyz = %d %s, want %d %s", code, resp.Status, http.StatusServiceUnavailable, statusUnavailable)
+		}
+		if c := resp.Components["database"]; c.Status != statusUnavailable || c.Error == "" {
+			t.Errorf("database = %+v, want %s with error", c, statusUnavailable)
+		}
+	})
+
+	t.Run("draining", func(t *testing.T) {
+		p := newProbe(map[string]healthCheck{"database": up})
+		p.drain()
+
+		code, resp := getProbe(t, p, "/readyz")
+		if code != http.StatusServiceUnavailable || resp.Status != statusDraining {
+			t.Errorf("readyz = %d %s, want %d %s", code, resp.Status, http.StatusServiceUnavailable, statusDraining)
+		}
+	})
+}
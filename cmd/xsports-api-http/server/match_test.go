@@ -0,0 +1,302 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/x-sports/internal/match"
+	matchmemstore "github.com/x-sports/internal/match/store/memory"
+)
+
+// createMatch creates an upcoming match between the given
+// teams through the HTTP handler.
+func (ts *testServer) createMatch(gameID, teamAID, teamBID int64) int64 {
+	ts.t.Helper()
+
+	res := ts.do(http.MethodPost, "/matchs", map[string]interface{}{
+		"tournament_names": "Test Cup",
+		"game_id":          gameID,
+		"team_a_id":        teamAID,
+		"team_b_id":        teamBID,
+		"team_a_odds":      1.5,
+		"team_b_odds":      2.5,
+		"date":             "2026-10-19 12:00:00 +07:00",
+		"match_link":       "https://x-sports.test/live",
+	}, nil)
+	if res.StatusCode != http.StatusOK {
+		ts.t.Fatalf("failed to create match: %d %v %v", res.StatusCode, res.Errors, res.FieldErrors)
+	}
+
+	var id int64
+	res.decode(ts.t, &id)
+	return id
+}
+
+func TestUpdateMatchWinner(t *testing.T) {
+	ts := newTestServer(t)
+	gameID := ts.createGame("Dota 2")
+	teamA := ts.createTeam(gameID, "Team Liquid")
+	teamB := ts.createTeam(gameID, "OG")
+	other := ts.createTeam(gameID, "Team Spirit")
+
+	tests := []struct {
+		name   string
+		winner interface{}
+	}{
+		{name: "missing winner", winner: nil},
+		{name: "winner not in match", winner: other},
+		{name: "invalid winner", winner: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matchID := ts.createMatch(gameID, teamA, teamB)
+
+			body := map[string]interface{}{"status": "completed"}
+			if tt.winner != nil {
+				body["winner"] = tt.winner
+			}
+			res := ts.do(http.MethodPatch, fmt.Sprintf("/matchs/%d", matchID), body, nil)
+			if res.StatusCode != http.StatusBadRequest {
+				t.Fatalf("status code = %d, want %d", res.StatusCode, http.StatusBadRequest)
+			}
+			if !res.hasError("INVALID_WINNER") {
+				t.Errorf("errors = %v, want INVALID_WINNER", res.Errors)
+			}
+			if fe, ok := res.fieldError("winner"); !ok || fe.Code != "INVALID_VALUE" {
+				t.Errorf("field errors = %v, want winner INVALID_VALUE", res.FieldErrors)
+			}
+
+			// the match is not updated
+			res = ts.do(http.MethodGet, fmt.Sprintf("/matchs/%d", matchID), nil, nil)
+			var m struct {
+				Status string `json:"status"`
+			}
+			res.decode(t, &m)
+			if m.Status != "upcoming" {
+				t.Errorf("status = %s, want upcoming", m.Status)
+			}
+		})
+	}
+
+	t.Run("winner in match", func(t *testing.T) {
+		matchID := ts.createMatch(gameID, teamA, teamB)
+
+		res := ts.do(http.MethodPatch, fmt.Sprintf("/matchs/%d", matchID), map[string]interface{}{
+			"status": "completed",
+			"winner": teamB,
+		}, nil)
+		if res.StatusCode != http.StatusOK {
+			t.Fatalf("status code = %d, want %d: %v", res.StatusCode, http.StatusOK, res.Errors)
+		}
+
+		res = ts.do(http.MethodGet, fmt.Sprintf("/matchs/%d", matchID), nil, nil)
+		var m struct {
+			Status string `json:"status"`
+			Winner int64  `json:"winner"`
+		}
+		res.decode(t, &m)
+		if m.Status != "completed" || m.Winner != teamB {
+			t.Errorf("status, winner = %s, %d, want completed, %d", m.Status, m.Winner, teamB)
+		}
+	})
+}
+
+func TestMatchNotFound(t *testing.T) {
+	ts := newTestServer(t)
+
+	for _, method := range []string{http.MethodGet, http.MethodPatch, http.MethodDelete} {
+		t.Run(method, func(t *testing.T) {
+			var body interface{}
+			if method == http.MethodPatch {
+				body = map[string]interface{}{"match_link": "https://x-sports.test/live"}
+			}
+
+			res := ts.do(method, "/matchs/404", body, nil)
+			if res.StatusCode != http.StatusNotFound {
+				t.Fatalf("status code = %d, want %d", res.StatusCode, http.StatusNotFound)
+			}
+			if !res.hasError("MATCH_NOT_FOUND") {
+				t.Errorf("errors = %v, want MATCH_NOT_FOUND", res.Errors)
+			}
+		})
+	}
+}
+
+func TestUpdateMatchVersionConflict(t *testing.T) {
+	ts := newTestServer(t)
+	gameID := ts.createGame("Valorant")
+	teamA := ts.createTeam(gameID, "Paper Rex")
+	teamB := ts.createTeam(gameID, "Fnatic")
+	matchID := ts.createMatch(gameID, teamA, teamB)
+	path := fmt.Sprintf("/matchs/%d", matchID)
+
+	res := ts.do(http.MethodGet, path, nil, nil)
+	etag := res.Header.Get("ETag")
+	if etag == "" {
+		t.Fatal("missing ETag")
+	}
+
+	// the first update is based on the current version
+	res = ts.do(http.MethodPatch, path, map[string]interface{}{"status": "ongoing"}, map[string]string{"If-Match": etag})
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("status code = %d, want %d: %v", res.StatusCode, http.StatusOK, res.Errors)
+	}
+	if got := res.Header.Get("ETag"); got == "" || got == etag {
+		t.Errorf("ETag = %s, want a new one", got)
+	}
+
+	// the second update is based on the stale version
+	res = ts.do(http.MethodPatch, path, map[string]interface{}{"status": "upcoming"}, map[string]string{"If-Match": etag})
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("status code = %d, want %d", res.StatusCode, http.StatusConflict)
+	}
+	if !res.hasError("VERSION_CONFLICT") {
+		t.Errorf("errors = %v, want VERSION_CONFLICT", res.Errors)
+	}
+}
+
+func TestUpdateMatchStore(t *testing.T) {
+	ts := newTestServer(t)
+	gameID := ts.createGame("Valorant")
+	matchID := ts.createMatch(gameID, ts.createTeam(gameID, "Paper Rex"), ts.createTeam(gameID, "Fnatic"))
+
+	store, err := matchmemstore.New(ts.db)
+	if err != nil {
+		t.Fatalf("failed to initialize match memory store: %s", err)
+	}
+	client, err := store.NewClient(false)
+	if err != nil {
+		t.Fatalf("failed to create store client: %s", err)
+	}
+
+	ctx := context.Background()
+	m, err := client.GetMatchByID(ctx, matchID)
+	if err != nil {
+		t.Fatalf("failed to get match: %s", err)
+	}
+	link := "https://x-sports.test/replay"
+
+	// an update based on a stale version conflicts, while the
+	// update of a deleted match is not found
+	err = client.UpdateMatch(ctx, match.MatchUpdate{ID: matchID, Version: m.UpdateTime.Add(-time.Second), MatchLink: &link}, time.Now())
+	if !errors.Is(err, match.ErrVersionConflict) {
+		t.Errorf("err = %v, want %v", err, match.ErrVersionConflict)
+	}
+	if err := client.DeleteMatchByID(ctx, matchID); err != nil {
+		t.Fatalf("failed to delete match: %s", err)
+	}
+	err = client.UpdateMatch(ctx, match.MatchUpdate{ID: matchID, Version: m.UpdateTime, MatchLink: &link}, time.Now())
+	if !errors.Is(err, match.ErrMatchNotFound) {
+		t.Errorf("err = %v, want %v", err, match.ErrMatchNotFound)
+	}
+}
+
+func TestCreateMatchValidation(t *testing.T) {
+	ts := newTestServer(t)
+	dota := ts.createGame("Dota 2")
+	valorant := ts.createGame("Valorant")
+	teamA := ts.createTeam(dota, "Team Liquid")
+	teamB := ts.createTeam(valorant, "Paper Rex")
+
+	res := ts.do(http.MethodPost, "/matchs", map[string]interface{}{
+		"game_id":     dota,
+		"team_a_id":   teamA,
+		"team_b_id":   teamB,
+		"team_a_odds": 0,
+		"team_b_odds": 1.5,
+		"date":        "2026-10-19 12:00:00 +07:00",
+		"match_link":  "https://x-sports.test/live",
+	}, nil)
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("status code = %d, want %d", res.StatusCode, http.StatusBadRequest)
+	}
+
+	// all violations are reported at once
+	for field, code := range map[string]string{
+		"tournament_names": "REQUIRED",
+		"team_a_odds":      "OUT_OF_RANGE",
+		"team_b_id":        "CONFLICT",
+	} {
+		if fe, ok := res.fieldError(field); !ok {
+			t.Errorf("missing field error of %s in %v", field, res.FieldErrors)
+		} else if fe.Code != code {
+			t.Errorf("code of %s = %s, want %s", field, fe.Code, code)
+		}
+	}
+}
+
+func TestCreateMatchUnknownGame(t *testing.T) {
+	ts := newTestServer(t)
+	dota := ts.createGame("Dota 2")
+	teamA := ts.createTeam(dota, "Team Liquid")
+	teamB := ts.createTeam(dota, "OG")
+
+	res := ts.do(http.MethodPost, "/matchs", map[string]interface{}{
+		"tournament_names": "Test Cup",
+		"game_id":          dota + 100,
+		"team_a_id":        teamA,
+		"team_b_id":        teamB,
+		"team_a_odds":      0,
+		"team_b_odds":      2.5,
+		"date":             "2026-10-19 12:00:00 +07:00",
+		"match_link":       "https://x-sports.test/live",
+	}, nil)
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("status code = %d, want %d", res.StatusCode, http.StatusBadRequest)
+	}
+
+	// unknown game is reported with the other violations
+	for field, code := range map[string]string{
+		"game_id":     "NOT_FOUND",
+		"team_a_odds": "OUT_OF_RANGE",
+	} {
+		if fe, ok := res.fieldError(field); !ok {
+			t.Errorf("missing field error of %s in %v", field, res.FieldErrors)
+		} else if fe.Code != code {
+			t.Errorf("code of %s = %s, want %s", field, fe.Code, code)
+		}
+	}
+}
+
+func TestCreateMatchesBulkAtomic(t *testing.T) {
+	ts := newTestServer(t)
+	gameID := ts.createGame("Dota 2")
+	teamA := ts.createTeam(gameID, "Team Liquid")
+	teamB := ts.createTeam(gameID, "OG")
+
+	valid := map[string]interface{}{
+		"tournament_names": "Test Cup",
+		"game_id":          gameID,
+		"team_a_id":        teamA,
+		"team_b_id":        teamB,
+		"team_a_odds":      1.5,
+		"team_b_odds":      2.5,
+		"date":             "2026-10-19 12:00:00 +07:00",
+		"match_link":       "https://x-sports.test/live",
+	}
+	invalid := map[string]interface{}{}
+	for k, v := range valid {
+		invalid[k] = v
+	}
+	invalid["team_b_id"] = teamA
+
+	res := ts.do(http.MethodPost, "/matchs/bulk", []interface{}{valid, invalid}, nil)
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("status code = %d, want %d", res.StatusCode, http.StatusBadRequest)
+	}
+	if !res.hasError("TEAMS_MUST_DIFFER") {
+		t.Errorf("errors = %v, want TEAMS_MUST_DIFFER", res.Errors)
+	}
+
+	// none of the matches is created
+	res = ts.do(http.MethodGet, "/matchs", nil, nil)
+	var matchs []struct{}
+	res.decode(t, &matchs)
+	if len(matchs) != 0 {
+		t.Errorf("got %d matches, want none", len(matchs))
+	}
+}
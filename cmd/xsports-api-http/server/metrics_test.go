@@ -0,0 +1,42 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestMetrics(t *testing.T) {
+	ts := newTestServer(t)
+	gameID := ts.createGame("Dota 2")
+	teamA := ts.createTeam(gameID, "Team Liquid")
+	teamB := ts.createTeam(gameID, "OG")
+	ts.createMatch(gameID, teamA, teamB)
+
+	resp, err := ts.srv.Client().Get(ts.srv.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("failed to get metrics: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read metrics: %s", err)
+	}
+
+	// metrics are global, so only their presence is checked
+	for _, metric := range []string{
+		`xsports_http_requests_total{code="200",handler="matchs",method="POST"}`,
+		`xsports_http_request_duration_seconds_count{handler="login",method="POST"}`,
+		`xsports_store_query_duration_seconds_count{method="CreateMatch",result="success",store="match"}`,
+		`xsports_domain_events_total{type="match.created"}`,
+	} {
+		if !strings.Contains(string(body), metric) {
+			t.Errorf("missing metric %s", metric)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/notification"
+)
+
+// followTeam follows the given team as the admin of the test
+// server.
+func (ts *testServer) followTeam(teamID int64) {
+	ts.t.Helper()
+
+	res := ts.do(http.MethodPost, "/follows", map[string]interface{}{
+		"type":      "team",
+		"target_id": teamID,
+	}, nil)
+	if res.StatusCode != http.StatusOK {
+		ts.t.Fatalf("failed to follow team: %d %v", res.StatusCode, res.Errors)
+	}
+}
+
+// updateMatch updates the given match through the HTTP
+// handler and dispatches the events it creates.
+func (ts *testServer) updateMatch(matchID int64, body map[string]interface{}) {
+	ts.t.Helper()
+
+	res := ts.do(http.MethodPatch, fmt.Sprintf("/matchs/%d", matchID), body, nil)
+	if res.StatusCode != http.StatusOK {
+		ts.t.Fatalf("failed to update match: %d %v", res.StatusCode, res.Errors)
+	}
+
+	if _, err := ts.svcs.outbox.Dispatch(context.Background()); err != nil {
+		ts.t.Fatalf("failed to dispatch events: %s", err)
+	}
+}
+
+// inbox returns type of the in-app notifications of the admin
+// of the test server.
+func (ts *testServer) inbox() []string {
+	ts.t.Helper()
+
+	res := ts.do(http.MethodGet, "/notifications", nil, nil)
+	if res.StatusCode != http.StatusOK {
+		ts.t.Fatalf("failed to get notifications: %d %v", res.StatusCode, res.Errors)
+	}
+
+	var notifications []struct {
+		Type string `json:"type"`
+	}
+	res.decode(ts.t, &notifications)
+
+	types := make([]string, 0, len(notifications))
+	for _, n := range notifications {
+		types = append(types, n.Type)
+	}
+	return types
+}
+
+func TestNotifyMatchUpdated(t *testing.T) {
+	ts := newTestServer(t)
+	gameID := ts.createGame("Dota 2")
+	teamA := ts.createTeam(gameID, "Team Liquid")
+	teamB := ts.createTeam(gameID, "OG")
+	matchID := ts.createMatch(gameID, teamA, teamB)
+	ts.followTeam(teamA)
+
+	ts.updateMatch(matchID, map[string]interface{}{"status": "ongoing"})
+
+	sent := ts.channel.Sent()
+	if len(sent) != 1 || sent[0].Type != notification.TypeMatchStart || sent[0].MatchID != matchID {
+		t.Fatalf("sent = %+v, want a match start of match %d", sent, matchID)
+	}
+	if got := ts.inbox(); len(got) != 1 || got[0] != "match_start" {
+		t.Fatalf("inbox = %v, want [match_start]", got)
+	}
+
+	// notifying the same transition again, e.g. when the event
+	// is retried, does not deliver it twice
+	previous := match.Match{ID: matchID, GameID: gameID, TeamAID: teamA, TeamBID: teamB, Status: match.StatusUpcoming}
+	current := previous
+	current.Status = match.StatusOngoing
+	err := ts.svcs.notification.NotifyMatchUpdated(context.Background(), previous, current)
+	if err != nil {
+		t.Fatalf("failed to notify: %s", err)
+	}
+	if sent := ts.channel.Sent(); len(sent) != 1 {
+		t.Errorf("sent %d notifications, want 1", len(sent))
+	}
+	if got := ts.inbox(); len(got) != 1 {
+		t.Errorf("inbox = %v, want 1 notification", got)
+	}
+}
+
+func TestNotifyMatchUpdatedSuppressed(t *testing.T) {
+	tests := []struct {
+		name       string
+		preference map[string]interface{}
+		disabled   bool
+		update     map[string]interface{}
+	}{
+		{
+			name:       "disabled preference",
+			preference: map[string]interface{}{"match_start": false},
+			update:     map[string]interface{}{"status": "ongoing"},
+		},
+		{
+			name:       "disabled channels",
+			preference: map[string]interface{}{"in_app": false},
+			disabled:   true,
+			update:     map[string]interface{}{"status": "ongoing"},
+		},
+		{
+			name:   "no status transition",
+			update: map[string]interface{}{"match_link": "https://x-sports.test/replay"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := newTestServer(t)
+			gameID := ts.createGame("Dota 2")
+			teamA := ts.createTeam(gameID, "Team Liquid")
+			teamB := ts.createTeam(gameID, "OG")
+			matchID := ts.createMatch(gameID, teamA, teamB)
+			ts.followTeam(teamA)
+
+			if tt.preference != nil {
+				res := ts.do(http.MethodPatch, "/notifications/preference", tt.preference, nil)
+				if res.StatusCode != http.StatusOK {
+					t.Fatalf("failed to update preference: %d %v", res.StatusCode, res.Errors)
+				}
+			}
+			ts.channel.SetEnabled(!tt.disabled)
+
+			ts.updateMatch(matchID, tt.update)
+
+			if sent := ts.channel.Sent(); len(sent) != 0 {
+				t.Errorf("sent = %+v, want none", sent)
+			}
+			if got := ts.inbox(); len(got) != 0 {
+				t.Errorf("inbox = %v, want none", got)
+			}
+		})
+	}
+}
+
+func TestNotifyMatchUpdatedChannelFailure(t *testing.T) {
+	ts := newTestServer(t)
+	gameID := ts.createGame("Dota 2")
+	teamA := ts.createTeam(gameID, "Team Liquid")
+	teamB := ts.createTeam(gameID, "OG")
+	ts.followTeam(teamB)
+
+	previous := match.Match{ID: 1, GameID: gameID, TeamAID: teamA, TeamBID: teamB, Status: match.StatusOngoing}
+	current := previous
+	current.Status = match.StatusCompleted
+	current.Winner = teamB
+
+	// the failing channel does not block the in-app one, nor
+	// fails the whole notification
+	ts.channel.SetError(errors.New("unavailable"))
+	err := ts.svcs.notification.NotifyMatchUpdated(context.Background(), previous, current)
+	if err != nil {
+		t.Fatalf("failed to notify: %s", err)
+	}
+	if got := ts.inbox(); len(got) != 1 || got[0] != "match_result" {
+		t.Fatalf("inbox = %v, want [match_result]", got)
+	}
+
+	// notifying again only delivers through the channel that
+	// failed
+	ts.channel.SetError(nil)
+	err = ts.svcs.notification.NotifyMatchUpdated(context.Background(), previous, current)
+	if err != nil {
+		t.Fatalf("failed to notify: %s", err)
+	}
+	if sent := ts.channel.Sent(); len(sent) != 1 || sent[0].Type != notification.TypeMatchResult {
+		t.Errorf("sent = %+v, want a match result", sent)
+	}
+	if got := ts.inbox(); len(got) != 1 {
+		t.Errorf("inbox = %v, want 1 notification", got)
+	}
+}
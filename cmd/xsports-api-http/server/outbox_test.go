@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/x-sports/internal/memory"
+	"github.com/x-sports/internal/outbox"
+	outboxservice "github.com/x-sports/internal/outbox/service"
+	outboxmemstore "github.com/x-sports/internal/outbox/store/memory"
+)
+
+// countingSubscriber counts the events it handles, and fails
+// them with its error if any.
+type countingSubscriber struct {
+	count int
+	err   error
+}
+
+func (s *countingSubscriber) HandleEvent(ctx context.Context, event outbox.Event) error {
+	s.count++
+	return s.err
+}
+
+func TestOutboxDispatch(t *testing.T) {
+	db := memory.New()
+	st, err := outboxmemstore.New(db)
+	if err != nil {
+		t.Fatalf("failed to initialize outbox memory store: %s", err)
+	}
+
+	// retry immediately to not wait for the backoff
+	svc, err := outboxservice.New(st, outboxservice.WithConfig(outboxservice.Config{
+		InitialBackoff: time.Nanosecond,
+		MaxBackoff:     time.Nanosecond,
+		MaxAttempts:    3,
+	}))
+	if err != nil {
+		t.Fatalf("failed to initialize outbox service: %s", err)
+	}
+
+	succeeding := &countingSubscriber{}
+	failing := &countingSubscriber{err: errors.New("unavailable")}
+	svc.Subscribe("test.created", "succeeding", succeeding)
+	svc.Subscribe("test.created", "failing", failing)
+
+	var eventID int64
+	err = db.NewClient(false).Do(func(data *memory.Data) error {
+		event, err := outbox.NewEvent("test.created", struct{}{}, time.Now().Add(-time.Second))
+		if err != nil {
+			return err
+		}
+		eventID = outboxmemstore.CreateEvent(data, event)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to create event: %s", err)
+	}
+
+	for i := 0; i < 5; i++ {
+		if _, err := svc.Dispatch(context.Background()); err != nil {
+			t.Fatalf("failed to dispatch: %s", err)
+		}
+	}
+
+	// the succeeding subscriber does not receive the retries
+	// of the failing one
+	if succeeding.count != 1 {
+		t.Errorf("succeeding subscriber got %d events, want 1", succeeding.count)
+	}
+	if failing.count != 3 {
+		t.Errorf("failing subscriber got %d events, want 3", failing.count)
+	}
+
+	var event outbox.Event
+	err = db.NewClient(false).Do(func(data *memory.Data) error {
+		event, _ = data.Events.Get(eventID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to get event: %s", err)
+	}
+	if event.FailTime.IsZero() || !event.DispatchTime.IsZero() {
+		t.Errorf("fail time, dispatch time = %v, %v, want failed event", event.FailTime, event.DispatchTime)
+	}
+}
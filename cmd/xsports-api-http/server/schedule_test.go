@@ -0,0 +1,321 @@
+package server
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/x-sports/internal/match"
+)
+
+// createTournamentMatch creates an upcoming match of the given
+// tournament between the given teams, directly through the
+// service.
+func (ts *testServer) createTournamentMatch(tournament string, gameID, teamA, teamB int64) int64 {
+	ts.t.Helper()
+
+	id, err := ts.svcs.match.CreateMatch(context.Background(), match.Match{
+		TournamentNames: tournament,
+		GameID:          gameID,
+		TeamAID:         teamA,
+		TeamBID:         teamB,
+		TeamAOdds:       1.5,
+		TeamBOdds:       2.5,
+		Date:            time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC),
+		MatchLink:       "https://x-sports.test/live",
+		Status:          match.StatusUpcoming,
+	})
+	if err != nil {
+		ts.t.Fatalf("failed to create match: %s", err)
+	}
+	return id
+}
+
+// calendar returns the properties of the events in the
+// calendar feed with the given query, checking that its lines
+// are folded.
+func (ts *testServer) calendar(query string) []map[string]string {
+	ts.t.Helper()
+
+	res := ts.do(http.MethodGet, "/schedules/calendar.ics"+query, nil, nil)
+	if res.StatusCode != http.StatusOK {
+		ts.t.Fatalf("failed to get calendar: %d %s", res.StatusCode, res.Body)
+	}
+	if ct := res.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/calendar") {
+		ts.t.Fatalf("Content-Type = %s, want text/calendar", ct)
+	}
+
+	body := string(res.Body)
+	for _, line := range strings.Split(strings.TrimSuffix(body, "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			ts.t.Errorf("line of %d octets is not folded: %q", len(line), line)
+		}
+	}
+
+	var events []map[string]string
+	var event map[string]string
+	for _, line := range strings.Split(strings.ReplaceAll(body, "\r\n ", ""), "\r\n") {
+		name, value, _ := strings.Cut(line, ":")
+		switch {
+		case line == "BEGIN:VEVENT":
+			event = make(map[string]string)
+		case line == "END:VEVENT":
+			events = append(events, event)
+			event = nil
+		case event != nil:
+			event[name] = value
+		}
+	}
+	return events
+}
+
+func TestCalendar(t *testing.T) {
+	ts := newTestServer(t)
+	dota := ts.createGame("Dota 2")
+	valorant := ts.createGame("Valorant")
+	liquid := ts.createTeam(dota, "Team Liquid")
+	spirit := ts.createTeam(dota, "Team Spirit")
+	long := ts.createTeam(dota, "Team With A Name Long Enough To Be Folded Into Continuation Lines")
+	ti := ts.createTournamentMatch("The International", dota, liquid, long)
+	riyadh := ts.createTournamentMatch("Riyadh Masters", dota, liquid, spirit)
+
+	uid := func(id int64) string {
+		return fmt.Sprintf("match-%d@x-sports", id)
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{name: "no filter", query: "", want: []string{uid(ti), uid(riyadh)}},
+		{name: "tournament", query: "?tournament=the%20international", want: []string{uid(ti)}},
+		{name: "team", query: fmt.Sprintf("?team_id=%d", spirit), want: []string{uid(riyadh)}},
+		{name: "game", query: fmt.Sprintf("?game_id=%d", valorant), want: nil},
+		{name: "game and tournament", query: fmt.Sprintf("?game_id=%d&tournament=Riyadh%%20Masters", dota), want: []string{uid(riyadh)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, event := range ts.calendar(tt.query) {
+				got = append(got, event["UID"])
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("UIDs = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("folded summary", func(t *testing.T) {
+		events := ts.calendar("?tournament=The%20International")
+		want := "Team Liquid vs Team With A Name Long Enough To Be Folded Into Continuation Lines"
+		if len(events) != 1 || events[0]["SUMMARY"] != want {
+			t.Errorf("events = %v, want SUMMARY %s", events, want)
+		}
+	})
+
+	t.Run("updated event", func(t *testing.T) {
+		// updates keep the UID and increase the sequence, even
+		// within the same second
+		for i, status := range []string{"ongoing", "completed"} {
+			body := map[string]interface{}{"status": status}
+			if status == "completed" {
+				body["winner"] = spirit
+			}
+			res := ts.do(http.MethodPatch, fmt.Sprintf("/matchs/%d", riyadh), body, nil)
+			if res.StatusCode != http.StatusOK {
+				t.Fatalf("failed to update match: %d %v", res.StatusCode, res.Errors)
+			}
+
+			events := ts.calendar(fmt.Sprintf("?team_id=%d", spirit))
+			if len(events) != 1 || events[0]["UID"] != uid(riyadh) {
+				t.Fatalf("events = %v, want UID %s", events, uid(riyadh))
+			}
+			if want := fmt.Sprint(i + 1); events[0]["SEQUENCE"] != want {
+				t.Errorf("SEQUENCE = %s, want %s", events[0]["SEQUENCE"], want)
+			}
+		}
+	})
+}
+
+// importReport is the response of an import.
+type importReport struct {
+	DryRun   bool `json:"dry_run"`
+	Imported bool `json:"imported"`
+	Records  []struct {
+		Row         int   `json:"row"`
+		ID          int64 `json:"id"`
+		Resolutions []struct {
+			Column string `json:"column"`
+			Name   string `json:"name"`
+			ID     int64  `json:"id"`
+		} `json:"resolutions"`
+		Problems []struct {
+			Column string `json:"column"`
+			Code   string `json:"code"`
+		} `json:"problems"`
+	} `json:"records"`
+}
+
+// importRecords imports the given data of the given kind
+// and content type, and returns the report.
+func (ts *testServer) importRecords(query, contentType, data string) importReport {
+	ts.t.Helper()
+
+	res := ts.doRaw(http.MethodPost, "/schedules/import"+query, contentType, []byte(data), nil)
+	if res.StatusCode != http.StatusOK {
+		ts.t.Fatalf("failed to import: %d %v", res.StatusCode, res.Errors)
+	}
+
+	var report importReport
+	res.decode(ts.t, &report)
+	return report
+}
+
+// matchCount returns the number of matches.
+func (ts *testServer) matchCount() int {
+	ts.t.Helper()
+
+	matches, err := ts.svcs.match.GetAllMatchs(context.Background(), match.Filter{})
+	if err != nil {
+		ts.t.Fatalf("failed to get matches: %s", err)
+	}
+	return len(matches)
+}
+
+const importMatchesCSV = `tournament_names,game,team_a,team_b,team_a_odds,team_b_odds,date,match_link,status,winner
+The International,dota 2,team liquid,OG,1.5,2.5,2026-10-19 12:00:00 +07:00,https://x-sports.test/live,upcoming,
+The International,Dota 2,OG,Team Liquid,1.8,2.1,2026-10-18 12:00:00 +07:00,https://x-sports.test/live,completed,og
+`
+
+func TestImportMatchesCSV(t *testing.T) {
+	ts := newTestServer(t)
+	gameID := ts.createGame("Dota 2")
+	liquid := ts.createTeam(gameID, "Team Liquid")
+	og := ts.createTeam(gameID, "OG")
+
+	t.Run("dry run", func(t *testing.T) {
+		report := ts.importRecords("?kind=matches&dry_run=true", "text/csv", importMatchesCSV)
+		if !report.DryRun || report.Imported {
+			t.Errorf("dry run, imported = %t, %t, want true, false", report.DryRun, report.Imported)
+		}
+		if len(report.Records) != 2 || report.Records[0].ID != 0 {
+			t.Errorf("records = %+v, want 2 records without ID", report.Records)
+		}
+		if n := ts.matchCount(); n != 0 {
+			t.Errorf("got %d matches, want none", n)
+		}
+	})
+
+	t.Run("import", func(t *testing.T) {
+		report := ts.importRecords("?kind=matches", "text/csv; charset=utf-8", importMatchesCSV)
+		if report.DryRun || !report.Imported {
+			t.Fatalf("dry run, imported = %t, %t, want false, true", report.DryRun, report.Imported)
+		}
+
+		// names are resolved case insensitive
+		resolved := make(map[string]int64)
+		for _, r := range report.Records[0].Resolutions {
+			resolved[r.Column] = r.ID
+		}
+		want := map[string]int64{"game": gameID, "team_a": liquid, "team_b": og}
+		if !reflect.DeepEqual(resolved, want) {
+			t.Errorf("resolutions = %v, want %v", resolved, want)
+		}
+
+		for _, rr := range report.Records {
+			if rr.ID == 0 || len(rr.Problems) != 0 {
+				t.Errorf("record %d = %+v, want imported without problems", rr.Row, rr)
+			}
+		}
+		if n := ts.matchCount(); n != 2 {
+			t.Errorf("got %d matches, want 2", n)
+		}
+	})
+}
+
+func TestImportJSON(t *testing.T) {
+	ts := newTestServer(t)
+
+	report := ts.importRecords("?kind=games&format=json", "application/json", `[
+		{"game_names": "Dota 2", "game_icons": "https://x-sports.test/dota.png"},
+		{"game_names": "Valorant", "game_icons": "https://x-sports.test/valorant.png"}
+	]`)
+	if !report.Imported || len(report.Records) != 2 {
+		t.Fatalf("report = %+v, want 2 imported records", report)
+	}
+
+	games, err := ts.svcs.game.GetAllGames(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get games: %s", err)
+	}
+	if len(games) != 2 || games[0].GameNames != "Dota 2" || games[1].GameNames != "Valorant" {
+		t.Errorf("games = %+v, want Dota 2 and Valorant", games)
+	}
+}
+
+func TestImportAllOrNothing(t *testing.T) {
+	ts := newTestServer(t)
+	gameID := ts.createGame("Dota 2")
+	ts.createTeam(gameID, "Team Liquid")
+	ts.createTeam(gameID, "OG")
+
+	// the second record refers to an unknown team, so the
+	// valid first record is not imported either
+	report := ts.importRecords("?kind=matches", "application/json", `[
+		{"tournament_names": "TI", "game": "Dota 2", "team_a": "Team Liquid", "team_b": "OG", "team_a_odds": 1.5, "team_b_odds": 2.5, "date": "2026-10-19 12:00:00 +07:00", "match_link": "https://x-sports.test/live", "status": "upcoming"},
+		{"tournament_names": "TI", "game": "Dota 2", "team_a": "Team Liquid", "team_b": "Team Spirit", "team_a_odds": 1.5, "team_b_odds": 2.5, "date": "2026-10-19 12:00:00 +07:00", "match_link": "https://x-sports.test/live", "status": "upcoming"}
+	]`)
+	if report.Imported {
+		t.Errorf("imported = true, want false")
+	}
+	if len(report.Records) != 2 || len(report.Records[0].Problems) != 0 {
+		t.Fatalf("records = %+v, want the first record without problems", report.Records)
+	}
+	if p := report.Records[1].Problems; len(p) != 1 || p[0].Column != "team_b" || p[0].Code != "NOT_FOUND" {
+		t.Errorf("problems = %+v, want team_b NOT_FOUND", p)
+	}
+	if n := ts.matchCount(); n != 0 {
+		t.Errorf("got %d matches, want none", n)
+	}
+}
+
+func TestExportMatches(t *testing.T) {
+	ts := newTestServer(t)
+	dota := ts.createGame("Dota 2")
+	valorant := ts.createGame("Valorant")
+	liquid := ts.createTeam(dota, "Team Liquid")
+	og := ts.createTeam(dota, "OG")
+	prx := ts.createTeam(valorant, "Paper Rex")
+	fnatic := ts.createTeam(valorant, "Fnatic")
+	ts.createCompletedMatch(dota, liquid, og, og, 18)
+	ts.createCompletedMatch(valorant, prx, fnatic, prx, 18)
+
+	res := ts.do(http.MethodGet, fmt.Sprintf("/schedules/export?game_id=%d&status=completed", dota), nil, nil)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", res.StatusCode, http.StatusOK)
+	}
+	if ct := res.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/csv") {
+		t.Errorf("Content-Type = %s, want text/csv", ct)
+	}
+	if cd := res.Header.Get("Content-Disposition"); !strings.Contains(cd, "attachment") {
+		t.Errorf("Content-Disposition = %s, want attachment", cd)
+	}
+
+	rows, err := csv.NewReader(strings.NewReader(string(res.Body))).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read CSV: %s", err)
+	}
+	want := [][]string{
+		{"id", "tournament_names", "game", "team_a", "team_b", "team_a_odds", "team_b_odds", "date", "match_link", "status", "winner"},
+		{"1", "Test Cup", "Dota 2", "Team Liquid", "OG", "1.5", "2.5", "2026-10-18 12:00:00 +00:00", "https://x-sports.test/live", "completed", "OG"},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("rows = %q, want %q", rows, want)
+	}
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/x-sports/internal/game"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/memory"
+	"github.com/x-sports/internal/news"
+	"github.com/x-sports/internal/seed"
+	"github.com/x-sports/internal/team"
+	"github.com/x-sports/internal/thread"
+)
+
+// seededData denotes the records created by a seed, without
+// their create and update times.
+type seededData struct {
+	Games   []game.Game
+	Teams   []team.Team
+	Matches []match.Match
+	News    []news.News
+	Threads []thread.Thread
+	Events  int
+}
+
+// seededData returns the records of the database of the test
+// server.
+func (ts *testServer) seededData() seededData {
+	ts.t.Helper()
+
+	var result seededData
+	err := ts.db.NewClient(false).Do(func(data *memory.Data) error {
+		for _, g := range data.Games.All() {
+			g.CreateTime, g.UpdateTime = time.Time{}, time.Time{}
+			result.Games = append(result.Games, g)
+		}
+		for _, t := range data.Teams.All() {
+			t.CreateTime, t.UpdateTime = time.Time{}, time.Time{}
+			result.Teams = append(result.Teams, t)
+		}
+		for _, m := range data.Matches.All() {
+			m.CreateTime, m.UpdateTime = time.Time{}, time.Time{}
+			result.Matches = append(result.Matches, m)
+		}
+		for _, n := range data.News.All() {
+			n.CreateTime, n.UpdateTime = time.Time{}, time.Time{}
+			result.News = append(result.News, n)
+		}
+		for _, t := range data.Threads.All() {
+			t.CreateTime, t.UpdateTime = time.Time{}, time.Time{}
+			result.Threads = append(result.Threads, t)
+		}
+		result.Events = len(data.Events.All())
+		return nil
+	})
+	if err != nil {
+		ts.t.Fatalf("failed to read seeded data: %s", err)
+	}
+	return result
+}
+
+func TestSeed(t *testing.T) {
+	opts := seed.Options{
+		Seed:           7,
+		BaseTime:       time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC),
+		Games:          2,
+		TeamsPerGame:   4,
+		MatchesPerGame: 6,
+		NewsPerGame:    3,
+		ThreadsPerGame: 3,
+	}
+	want := seed.Report{Games: 2, Teams: 8, Matches: 12, News: 6, Threads: 6}
+
+	var data []seededData
+	for i := 0; i < 2; i++ {
+		ts := newTestServer(t)
+		report, err := ts.svcs.seed.Seed(context.Background(), opts)
+		if err != nil {
+			t.Fatalf("failed to seed: %s", err)
+		}
+		if report != want {
+			t.Errorf("report = %+v, want %+v", report, want)
+		}
+		data = append(data, ts.seededData())
+	}
+
+	// the same options create the same data, without events
+	if !reflect.DeepEqual(data[0], data[1]) {
+		t.Errorf("seeded data differ:\n%+v\n%+v", data[0], data[1])
+	}
+	if got := data[0]; len(got.Games) != want.Games || len(got.Matches) != want.Matches || got.Events != 0 {
+		t.Errorf("got %d games, %d matches, %d events, want %d, %d, 0", len(got.Games), len(got.Matches), got.Events, want.Games, want.Matches)
+	}
+
+	// completed matches have their winner
+	for _, m := range data[0].Matches {
+		if m.Status == match.StatusCompleted && m.Winner != m.TeamAID && m.Winner != m.TeamBID {
+			t.Errorf("completed match %d has winner %d", m.ID, m.Winner)
+		}
+	}
+}
+
+func TestSeedNotEmpty(t *testing.T) {
+	ts := newTestServer(t)
+	ts.createGame("Dota 2")
+	before := ts.seededData()
+
+	_, err := ts.svcs.seed.Seed(context.Background(), seed.Options{
+		Seed:     1,
+		BaseTime: time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC),
+		Games:    1,
+	})
+	if !errors.Is(err, seed.ErrNotEmpty) {
+		t.Fatalf("err = %v, want %v", err, seed.ErrNotEmpty)
+	}
+
+	if after := ts.seededData(); !reflect.DeepEqual(before, after) {
+		t.Errorf("data changed:\n%+v\n%+v", before, after)
+	}
+}
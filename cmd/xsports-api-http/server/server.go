@@ -1,56 +1,59 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
-	"time"
+	"os/signal"
+	"sync"
+	"syscall"
 
 	"github.com/gorilla/mux"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 	"github.com/x-sports/cmd/xsports-api-http/config"
-	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/global/logging"
+	"github.com/x-sports/global/metrics"
+	"github.com/x-sports/global/tracing"
 	adminhttphandler "github.com/x-sports/internal/admin/handler/http"
-	adminservice "github.com/x-sports/internal/admin/service"
-	adminpgstore "github.com/x-sports/internal/admin/store/postgresql"
-	"github.com/x-sports/internal/game"
+	feedhttphandler "github.com/x-sports/internal/feed/handler/http"
 	gamehttphandler "github.com/x-sports/internal/game/handler/http"
-	gameservice "github.com/x-sports/internal/game/service"
-	gamepgstore "github.com/x-sports/internal/game/store/postgresql"
-	"github.com/x-sports/internal/match"
 	matchhttphandler "github.com/x-sports/internal/match/handler/http"
-	matchservice "github.com/x-sports/internal/match/service"
-	matchpgstore "github.com/x-sports/internal/match/store/postgresql"
-	"github.com/x-sports/internal/news"
 	newshttphandler "github.com/x-sports/internal/news/handler/http"
-	newsservice "github.com/x-sports/internal/news/service"
-	newspgstore "github.com/x-sports/internal/news/store/postgresql"
-	"github.com/x-sports/internal/team"
+	notificationhttphandler "github.com/x-sports/internal/notification/handler/http"
+	"github.com/x-sports/internal/outbox"
+	schedulehttphandler "github.com/x-sports/internal/schedule/handler/http"
+	syndicationhttphandler "github.com/x-sports/internal/syndication/handler/http"
 	teamhttphandler "github.com/x-sports/internal/team/handler/http"
-	teamservice "github.com/x-sports/internal/team/service"
-	teampgstore "github.com/x-sports/internal/team/store/postgresql"
-	"github.com/x-sports/internal/thread"
 	threadhttphandler "github.com/x-sports/internal/thread/handler/http"
-	threadservice "github.com/x-sports/internal/thread/service"
-	threadpgstore "github.com/x-sports/internal/thread/store/postgresql"
 	uploadhttphandler "github.com/x-sports/internal/upload/handler/http"
+	"github.com/x-sports/internal/webhook"
+	webhookhttphandler "github.com/x-sports/internal/webhook/handler/http"
 )
 
 // Following constants are the possible exit code returned
-// when running a server.
+// when running a server or a command.
 const (
 	CodeSuccess = iota
 	CodeBadConfig
 	CodeFailServeHTTP
+	CodeBadArgs
+	CodeFailCommand
 )
 
 // Run creates a server and starts the server.
 //
 // Run returns a status code suitable for os.Exit() argument.
 func Run() int {
-	s, err := new()
+	cfg, err := loadConfig()
+	if err != nil {
+		return CodeBadConfig
+	}
+
+	s, err := new(cfg)
 	if err != nil {
 		return CodeBadConfig
 	}
@@ -60,8 +63,16 @@ func Run() int {
 
 // server is the long-runnning application.
 type server struct {
+	cfg      config.Config
 	srv      *http.Server
+	db       *sqlx.DB
+	probe    *probe
 	handlers []handler
+	outbox   outbox.Service
+	webhook  webhook.Service
+
+	// shutdownTracing flushes the pending spans.
+	shutdownTracing func(ctx context.Context) error
 }
 
 // handler provides mechanism to start HTTP handler. All HTTP
@@ -70,123 +81,80 @@ type handler interface {
 	Start(multiplexer *mux.Router) error
 }
 
-// new creates and returns a new server.
-func new() (*server, error) {
+// new creates and returns a new server with the given
+// configuration.
+func new(cfg config.Config) (*server, error) {
 	s := &server{
+		cfg: cfg,
 		srv: &http.Server{
-			ReadTimeout:  10 * time.Second,
-			WriteTimeout: 10 * time.Second,
+			Addr:         cfg.Server.Addr(),
+			ReadTimeout:  cfg.Server.ReadTimeout,
+			WriteTimeout: cfg.Server.WriteTimeout,
 		},
 	}
 
-	// connect to dabatabase
-	db, err := sqlx.Connect("postgres", config.BaseConfig())
+	// export spans as configured
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Config{
+		ServiceName: cfg.Tracing.ServiceName,
+		Exporter:    cfg.Tracing.Exporter,
+		Endpoint:    cfg.Tracing.Endpoint,
+		Insecure:    cfg.Tracing.Insecure,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	})
 	if err != nil {
-		log.Printf("[xsports-api-http] failed to connect database: %s\n", err.Error())
-		return nil, fmt.Errorf("failed to connect database: %s", err.Error())
+		log.Printf("[xsports-api-http] failed to set up tracing: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to set up tracing: %s", err.Error())
 	}
+	s.shutdownTracing = shutdownTracing
 
-	// initialize admin service
-	var adminSvc admin.Service
-	{
-		pgStore, err := adminpgstore.New(db)
-		if err != nil {
-			log.Printf("[admin-api-http] failed to initialize admin postgresql store: %s\n", err.Error())
-			return nil, fmt.Errorf("failed to initialize admin postgresql store: %s", err.Error())
-		}
-
-		svcOptions := []adminservice.Option{}
-		svcOptions = append(svcOptions, adminservice.WithConfig(adminservice.Config{
-			PasswordSalt:   os.Getenv("PasswordSalt"),
-			TokenSecretKey: os.Getenv("TokenSecretKey"),
-		}))
-
-		adminSvc, err = adminservice.New(pgStore, svcOptions...)
-		if err != nil {
-			log.Printf("[tenant-api-http] failed to initialize admin service: %s\n", err.Error())
-			return nil, fmt.Errorf("failed to initialize admin service: %s", err.Error())
-		}
+	// connect to dabatabase
+	db, err := connectDatabase(cfg.Database)
+	if err != nil {
+		return nil, err
 	}
-
-	// initialize game service
-	var gameSvc game.Service
-	{
-		pgStore, err := gamepgstore.New(db)
-		if err != nil {
-			log.Printf("[game-api-http] failed to initialize game postgresql store: %s\n", err.Error())
-			return nil, fmt.Errorf("failed to initialize game postgresql store: %s", err.Error())
-		}
-
-		gameSvc, err = gameservice.New(pgStore)
-		if err != nil {
-			log.Printf("[game-api-http] failed to initialize game service: %s\n", err.Error())
-			return nil, fmt.Errorf("failed to initialize game service: %s", err.Error())
-		}
+	s.db = db
+	err = metrics.RegisterDB(cfg.Database.Name, db.DB)
+	if err != nil {
+		log.Printf("[xsports-api-http] failed to register database metrics: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to register database metrics: %s", err.Error())
 	}
+	s.probe = newProbe(map[string]healthCheck{
+		"database": db.PingContext,
+	})
 
-	// initialize team service
-	var teamSvc team.Service
-	{
-		pgStore, err := teampgstore.New(db)
-		if err != nil {
-			log.Printf("[team-api-http] failed to initialize team postgresql store: %s\n", err.Error())
-			return nil, fmt.Errorf("failed to initialize team postgresql store: %s", err.Error())
-		}
-
-		teamSvc, err = teamservice.New(pgStore)
+	// apply pending migrations if enabled
+	if cfg.Database.AutoMigrate {
+		err = migrateDatabase(db)
 		if err != nil {
-			log.Printf("[team-api-http] failed to initialize team service: %s\n", err.Error())
-			return nil, fmt.Errorf("failed to initialize team service: %s", err.Error())
+			return nil, err
 		}
 	}
 
-	// initialize match service
-	var matchSvc match.Service
-	{
-		pgStore, err := matchpgstore.New(db)
-		if err != nil {
-			log.Printf("[match-api-http] failed to initialize match postgresql store: %s\n", err.Error())
-			return nil, fmt.Errorf("failed to initialize match postgresql store: %s", err.Error())
-		}
-
-		matchSvc, err = matchservice.New(pgStore)
-		if err != nil {
-			log.Printf("[match-api-http] failed to initialize match service: %s\n", err.Error())
-			return nil, fmt.Errorf("failed to initialize match service: %s", err.Error())
-		}
+	// initialize services
+	st, err := newPGStores(db, cfg.Database)
+	if err != nil {
+		return nil, err
 	}
+	svcs, err := newServices(st, cfg)
+	if err != nil {
+		return nil, err
+	}
+	s.outbox = svcs.outbox
+	s.webhook = svcs.webhook
 
-	// initialize news service
-	var newsSvc news.Service
-	{
-		pgStore, err := newspgstore.New(db)
-		if err != nil {
-			log.Printf("[news-api-http] failed to initialize news postgresql store: %s\n", err.Error())
-			return nil, fmt.Errorf("failed to initialize news postgresql store: %s", err.Error())
-		}
-
-		newsSvc, err = newsservice.New(pgStore)
-		if err != nil {
-			log.Printf("[news-api-http] failed to initialize news service: %s\n", err.Error())
-			return nil, fmt.Errorf("failed to initialize news service: %s", err.Error())
-		}
+	// initialize HTTP handlers
+	s.handlers, err = newHandlers(svcs, cfg)
+	if err != nil {
+		return nil, err
 	}
 
-	// initialize thread service
-	var threadSvc thread.Service
-	{
-		pgStore, err := threadpgstore.New(db)
-		if err != nil {
-			log.Printf("[thread-api-http] failed to initialize thread postgresql store: %s\n", err.Error())
-			return nil, fmt.Errorf("failed to initialize thread postgresql store: %s", err.Error())
-		}
+	return s, nil
+}
 
-		threadSvc, err = threadservice.New(pgStore)
-		if err != nil {
-			log.Printf("[thread-api-http] failed to initialize thread service: %s\n", err.Error())
-			return nil, fmt.Errorf("failed to initialize thread service: %s", err.Error())
-		}
-	}
+// newHandlers creates and returns HTTP handlers of the given
+// services and configuration.
+func newHandlers(svcs *services, cfg config.Config) ([]handler, error) {
+	var handlers []handler
 
 	// initialize admin HTTP handler
 	{
@@ -194,13 +162,13 @@ func new() (*server, error) {
 			adminhttphandler.HandlerLogin,
 		}
 
-		adminHTTP, err := adminhttphandler.New(adminSvc, identities)
+		adminHTTP, err := adminhttphandler.New(svcs.admin, identities)
 		if err != nil {
 			log.Printf("[admin-api-http] failed to initialize admin http handlers: %s\n", err.Error())
 			return nil, fmt.Errorf("failed to initialize admin http handlers: %s", err.Error())
 		}
 
-		s.handlers = append(s.handlers, adminHTTP)
+		handlers = append(handlers, adminHTTP)
 	}
 
 	// initialize game HTTP handler
@@ -210,13 +178,13 @@ func new() (*server, error) {
 			gamehttphandler.HandlerGames,
 		}
 
-		gameHTTP, err := gamehttphandler.New(gameSvc, adminSvc, identities)
+		gameHTTP, err := gamehttphandler.New(svcs.game, svcs.team, svcs.match, svcs.news, svcs.admin, identities)
 		if err != nil {
 			log.Printf("[game-api-http] failed to initialize game http handlers: %s\n", err.Error())
 			return nil, fmt.Errorf("failed to initialize game http handlers: %s", err.Error())
 		}
 
-		s.handlers = append(s.handlers, gameHTTP)
+		handlers = append(handlers, gameHTTP)
 	}
 
 	// initialize team HTTP handler
@@ -226,13 +194,13 @@ func new() (*server, error) {
 			teamhttphandler.HandlerTeams,
 		}
 
-		teamHTTP, err := teamhttphandler.New(teamSvc, adminSvc, identities)
+		teamHTTP, err := teamhttphandler.New(svcs.team, svcs.match, svcs.admin, identities)
 		if err != nil {
 			log.Printf("[team-api-http] failed to initialize team http handlers: %s\n", err.Error())
 			return nil, fmt.Errorf("failed to initialize team http handlers: %s", err.Error())
 		}
 
-		s.handlers = append(s.handlers, teamHTTP)
+		handlers = append(handlers, teamHTTP)
 	}
 
 	// initialize match HTTP handler
@@ -240,15 +208,16 @@ func new() (*server, error) {
 		identities := []matchhttphandler.HandlerIdentity{
 			matchhttphandler.HandlerMatch,
 			matchhttphandler.HandlerMatchs,
+			matchhttphandler.HandlerMatchsBulk,
 		}
 
-		matchHTTP, err := matchhttphandler.New(matchSvc, adminSvc, identities)
+		matchHTTP, err := matchhttphandler.New(svcs.match, svcs.admin, identities)
 		if err != nil {
 			log.Printf("[match-api-http] failed to initialize match http handlers: %s\n", err.Error())
 			return nil, fmt.Errorf("failed to initialize match http handlers: %s", err.Error())
 		}
 
-		s.handlers = append(s.handlers, matchHTTP)
+		handlers = append(handlers, matchHTTP)
 	}
 
 	// initialize news HTTP handler
@@ -258,13 +227,13 @@ func new() (*server, error) {
 			newshttphandler.HandlerNewss,
 		}
 
-		newsHTTP, err := newshttphandler.New(newsSvc, adminSvc, identities)
+		newsHTTP, err := newshttphandler.New(svcs.news, svcs.admin, identities)
 		if err != nil {
 			log.Printf("[news-api-http] failed to initialize news http handlers: %s\n", err.Error())
 			return nil, fmt.Errorf("failed to initialize news http handlers: %s", err.Error())
 		}
 
-		s.handlers = append(s.handlers, newsHTTP)
+		handlers = append(handlers, newsHTTP)
 	}
 
 	// initialize upload HTTP handler
@@ -273,13 +242,18 @@ func new() (*server, error) {
 			uploadhttphandler.HandlerUpload,
 		}
 
-		uploadHTTP, err := uploadhttphandler.New(adminSvc, identities)
+		uploadHTTP, err := uploadhttphandler.New(svcs.admin, uploadhttphandler.Config{
+			CloudinaryName:   cfg.Upload.CloudinaryName,
+			CloudinaryKey:    cfg.Upload.CloudinaryKey,
+			CloudinarySecret: cfg.Upload.CloudinarySecret,
+			MaxFileSize:      cfg.Upload.MaxFileSize,
+		}, identities)
 		if err != nil {
 			log.Printf("[upload-api-http] failed to initialize upload http handlers: %s\n", err.Error())
 			return nil, fmt.Errorf("failed to initialize upload http handlers: %s", err.Error())
 		}
 
-		s.handlers = append(s.handlers, uploadHTTP)
+		handlers = append(handlers, uploadHTTP)
 	}
 
 	// initialize thread HTTP handler
@@ -289,59 +263,244 @@ func new() (*server, error) {
 			threadhttphandler.HandlerThreads,
 		}
 
-		threadHTTP, err := threadhttphandler.New(threadSvc, adminSvc, identities)
+		threadHTTP, err := threadhttphandler.New(svcs.thread, svcs.admin, identities)
 		if err != nil {
 			log.Printf("[thread-api-http] failed to initialize thread http handlers: %s\n", err.Error())
 			return nil, fmt.Errorf("failed to initialize thread http handlers: %s", err.Error())
 		}
 
-		s.handlers = append(s.handlers, threadHTTP)
+		handlers = append(handlers, threadHTTP)
 	}
 
-	return s, nil
+	// initialize feed HTTP handler
+	{
+		identities := []feedhttphandler.HandlerIdentity{
+			feedhttphandler.HandlerFollow,
+			feedhttphandler.HandlerFollows,
+			feedhttphandler.HandlerFeed,
+		}
+
+		feedHTTP, err := feedhttphandler.New(svcs.feed, svcs.admin, identities)
+		if err != nil {
+			log.Printf("[feed-api-http] failed to initialize feed http handlers: %s\n", err.Error())
+			return nil, fmt.Errorf("failed to initialize feed http handlers: %s", err.Error())
+		}
+
+		handlers = append(handlers, feedHTTP)
+	}
+
+	// initialize notification HTTP handler
+	{
+		identities := []notificationhttphandler.HandlerIdentity{
+			notificationhttphandler.HandlerNotification,
+			notificationhttphandler.HandlerNotifications,
+			notificationhttphandler.HandlerPreference,
+		}
+
+		notificationHTTP, err := notificationhttphandler.New(svcs.notification, svcs.admin, identities)
+		if err != nil {
+			log.Printf("[notification-api-http] failed to initialize notification http handlers: %s\n", err.Error())
+			return nil, fmt.Errorf("failed to initialize notification http handlers: %s", err.Error())
+		}
+
+		handlers = append(handlers, notificationHTTP)
+	}
+
+	// initialize webhook HTTP handler
+	{
+		identities := []webhookhttphandler.HandlerIdentity{
+			webhookhttphandler.HandlerWebhook,
+			webhookhttphandler.HandlerWebhooks,
+			webhookhttphandler.HandlerDeliveries,
+			webhookhttphandler.HandlerReplay,
+		}
+
+		webhookHTTP, err := webhookhttphandler.New(svcs.webhook, svcs.admin, identities)
+		if err != nil {
+			log.Printf("[webhook-api-http] failed to initialize webhook http handlers: %s\n", err.Error())
+			return nil, fmt.Errorf("failed to initialize webhook http handlers: %s", err.Error())
+		}
+
+		handlers = append(handlers, webhookHTTP)
+	}
+
+	// initialize schedule HTTP handler
+	{
+		identities := []schedulehttphandler.HandlerIdentity{
+			schedulehttphandler.HandlerImport,
+			schedulehttphandler.HandlerExport,
+			schedulehttphandler.HandlerCalendar,
+		}
+
+		scheduleHTTP, err := schedulehttphandler.New(svcs.schedule, svcs.admin, identities)
+		if err != nil {
+			log.Printf("[schedule-api-http] failed to initialize schedule http handlers: %s\n", err.Error())
+			return nil, fmt.Errorf("failed to initialize schedule http handlers: %s", err.Error())
+		}
+
+		handlers = append(handlers, scheduleHTTP)
+	}
+
+	// initialize syndication HTTP handler
+	{
+		identities := []syndicationhttphandler.HandlerIdentity{
+			syndicationhttphandler.HandlerRSS,
+			syndicationhttphandler.HandlerAtom,
+		}
+
+		syndicationHTTP, err := syndicationhttphandler.New(svcs.syndication, cfg.SiteURL, identities)
+		if err != nil {
+			log.Printf("[syndication-api-http] failed to initialize syndication http handlers: %s\n", err.Error())
+			return nil, fmt.Errorf("failed to initialize syndication http handlers: %s", err.Error())
+		}
+
+		handlers = append(handlers, syndicationHTTP)
+	}
+
+	return handlers, nil
 }
 
-// start starts the given server.
+// start starts the given server and blocks until it is
+// stopped by SIGINT or SIGTERM. On stop, in-flight requests
+// are drained before the database is closed.
 func (s *server) start() int {
 	log.Println("[xsports-api-http] starting server...")
 
 	// create multiplexer object
+	rootMux, err := newRouter(s.handlers, s.probe, s.cfg.Server, s.cfg.CORS)
+	if err != nil {
+		log.Printf("[xsports-api-http] failed to start handler: %s\n", err.Error())
+		return CodeFailServeHTTP
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// dispatch domain events and send webhook deliveries in
+	// background until stopped
+	var workers sync.WaitGroup
+	for _, run := range []func(context.Context){s.outbox.Run, s.webhook.Run} {
+		workers.Add(1)
+		go func(run func(context.Context)) {
+			defer workers.Done()
+			run(ctx)
+		}(run)
+	}
+
+	// listen and serve
+	s.srv.Handler = rootMux
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- s.srv.ListenAndServe()
+	}()
+	log.Printf("[xsports-api-http] Server is running at %s", s.srv.Addr)
+
+	code := CodeSuccess
+	select {
+	case err := <-serveErr:
+		log.Printf("[xsports-api-http] failed to serve HTTP: %s\n", err.Error())
+		stop()
+		code = CodeFailServeHTTP
+	case <-ctx.Done():
+		log.Println("[xsports-api-http] shutting down server...")
+	}
+
+	// stop accepting new requests and wait for the in-flight
+	// ones within the shutdown timeout
+	s.probe.drain()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.cfg.Server.ShutdownTimeout)
+	defer cancel()
+	if err := s.srv.Shutdown(shutdownCtx); err != nil {
+		log.Printf("[xsports-api-http] failed to drain connections: %s\n", err.Error())
+		code = CodeFailServeHTTP
+	}
+
+	workersDone := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(workersDone)
+	}()
+	select {
+	case <-workersDone:
+	case <-shutdownCtx.Done():
+		log.Println("[xsports-api-http] background workers did not stop in time")
+	}
+
+	if err := s.db.Close(); err != nil {
+		log.Printf("[xsports-api-http] failed to close database: %s\n", err.Error())
+	}
+
+	if err := s.shutdownTracing(shutdownCtx); err != nil {
+		log.Printf("[xsports-api-http] failed to flush spans: %s\n", err.Error())
+	}
+
+	log.Println("[xsports-api-http] server stopped")
+	return code
+}
+
+// newRouter creates and returns the multiplexer serving the
+// given handlers under /api/v1 with the given server
+// configuration, and the probes of the given probe and the
+// metrics at the root.
+func newRouter(handlers []handler, p *probe, cfg config.Server, cors config.CORS) (*mux.Router, error) {
 	rootMux := mux.NewRouter()
+	p.register(rootMux)
+	rootMux.Handle("/metrics", metrics.Handler()).Methods(http.MethodGet)
 	appMux := rootMux.PathPrefix("/api/v1").Subrouter()
 
 	// starts handlers
-	for _, h := range s.handlers {
+	for _, h := range handlers {
 		if err := h.Start(appMux); err != nil {
-			log.Printf("[xsports-api-http] failed to start handler: %s\n", err.Error())
-			return CodeFailServeHTTP
+			return nil, err
 		}
 	}
 
 	// endpoint checker
 	appMux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprint(w, "Hello world! Auto Deploy On @xsports")
-	})
+	}).Name("health")
 
 	// use middlewares to app mux only
-	appMux.Use(corsMiddleware)
+	appMux.Use(logging.Middleware)
+	appMux.Use(tracing.Middleware)
+	appMux.Use(metrics.Middleware)
+	appMux.Use(newCORSMiddleware(cors))
+	appMux.Use(helper.HandlerTimeout(cfg.HandlerTimeout))
 
-	// listen and serve
-	log.Printf("[xsports-api-http] Server is running at %s:%s", os.Getenv("ADDRESS"), os.Getenv("PORT"))
-	log.Fatal(http.ListenAndServe(fmt.Sprintf("%s:%s", os.Getenv("ADDRESS"), os.Getenv("PORT")), rootMux))
-
-	return CodeSuccess
+	return rootMux, nil
 }
 
-func corsMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Add("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
-		w.Header().Add("Access-Control-Allow-Credentials", "true")
-		w.Header().Add("Access-Control-Allow-Methods", "POST, HEAD, PATCH, OPTIONS, GET, PUT, DELETE")
-		if r.Method == "OPTIONS" {
-			w.WriteHeader(http.StatusNoContent)
-			return
-		}
-		next.ServeHTTP(w, r)
-	})
+// newCORSMiddleware returns a middleware allowing the
+// configured origins to access the API.
+func newCORSMiddleware(cfg config.CORS) mux.MiddlewareFunc {
+	allowAny := false
+	allowed := make(map[string]bool, len(cfg.AllowedOrigins))
+	for _, origin := range cfg.AllowedOrigins {
+		allowAny = allowAny || origin == "*"
+		allowed[origin] = true
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// the allowed origin depends on the request origin,
+			// unless any origin is allowed
+			if allowAny {
+				w.Header().Set("Access-Control-Allow-Origin", "*")
+			} else {
+				w.Header().Add("Vary", "Origin")
+				if origin := r.Header.Get("Origin"); allowed[origin] {
+					w.Header().Set("Access-Control-Allow-Origin", origin)
+				}
+			}
+			w.Header().Add("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With, If-Match, If-None-Match, X-Request-ID")
+			w.Header().Add("Access-Control-Expose-Headers", "ETag, Last-Modified, X-Request-ID")
+			w.Header().Add("Access-Control-Allow-Credentials", "true")
+			w.Header().Add("Access-Control-Allow-Methods", "POST, HEAD, PATCH, OPTIONS, GET, PUT, DELETE")
+			if r.Method == "OPTIONS" {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
 }
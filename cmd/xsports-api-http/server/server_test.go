@@ -0,0 +1,33 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/x-sports/cmd/xsports-api-http/config"
+	"github.com/x-sports/global/logging"
+)
+
+func TestCORSRequestID(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := newCORSMiddleware(config.CORS{AllowedOrigins: []string{"https://x-sports.test"}})(next)
+
+	// browsers may send the request ID of the client, and read
+	// the one of the response
+	for _, method := range []string{http.MethodOptions, http.MethodGet} {
+		req := httptest.NewRequest(method, "/api/v1/matchs", nil)
+		req.Header.Set("Origin", "https://x-sports.test")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		for _, header := range []string{"Access-Control-Allow-Headers", "Access-Control-Expose-Headers"} {
+			if got := rec.Header().Get(header); !strings.Contains(got, logging.HeaderRequestID) {
+				t.Errorf("%s %s = %q, want %s", method, header, got, logging.HeaderRequestID)
+			}
+		}
+	}
+}
@@ -0,0 +1,499 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"net"
+	"net/smtp"
+	"os"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/x-sports/cmd/xsports-api-http/config"
+	"github.com/x-sports/global/logging"
+	"github.com/x-sports/global/tracing"
+	"github.com/x-sports/internal/admin"
+	adminservice "github.com/x-sports/internal/admin/service"
+	admininstrumentedstore "github.com/x-sports/internal/admin/store/instrumented"
+	adminpgstore "github.com/x-sports/internal/admin/store/postgresql"
+	"github.com/x-sports/internal/feed"
+	feedservice "github.com/x-sports/internal/feed/service"
+	feedinstrumentedstore "github.com/x-sports/internal/feed/store/instrumented"
+	feedpgstore "github.com/x-sports/internal/feed/store/postgresql"
+	"github.com/x-sports/internal/game"
+	gameservice "github.com/x-sports/internal/game/service"
+	gameinstrumentedstore "github.com/x-sports/internal/game/store/instrumented"
+	gamepgstore "github.com/x-sports/internal/game/store/postgresql"
+	"github.com/x-sports/internal/match"
+	matchservice "github.com/x-sports/internal/match/service"
+	matchinstrumentedstore "github.com/x-sports/internal/match/store/instrumented"
+	matchpgstore "github.com/x-sports/internal/match/store/postgresql"
+	"github.com/x-sports/internal/migration"
+	"github.com/x-sports/internal/news"
+	newsservice "github.com/x-sports/internal/news/service"
+	newsinstrumentedstore "github.com/x-sports/internal/news/store/instrumented"
+	newspgstore "github.com/x-sports/internal/news/store/postgresql"
+	"github.com/x-sports/internal/notification"
+	notificationchannel "github.com/x-sports/internal/notification/channel"
+	notificationservice "github.com/x-sports/internal/notification/service"
+	notificationinstrumentedstore "github.com/x-sports/internal/notification/store/instrumented"
+	notificationpgstore "github.com/x-sports/internal/notification/store/postgresql"
+	"github.com/x-sports/internal/outbox"
+	outboxservice "github.com/x-sports/internal/outbox/service"
+	outboxinstrumentedstore "github.com/x-sports/internal/outbox/store/instrumented"
+	outboxpgstore "github.com/x-sports/internal/outbox/store/postgresql"
+	"github.com/x-sports/internal/schedule"
+	scheduleservice "github.com/x-sports/internal/schedule/service"
+	"github.com/x-sports/internal/seed"
+	seedservice "github.com/x-sports/internal/seed/service"
+	seedinstrumentedstore "github.com/x-sports/internal/seed/store/instrumented"
+	seedpgstore "github.com/x-sports/internal/seed/store/postgresql"
+	"github.com/x-sports/internal/syndication"
+	syndicationservice "github.com/x-sports/internal/syndication/service"
+	"github.com/x-sports/internal/team"
+	teamservice "github.com/x-sports/internal/team/service"
+	teaminstrumentedstore "github.com/x-sports/internal/team/store/instrumented"
+	teampgstore "github.com/x-sports/internal/team/store/postgresql"
+	"github.com/x-sports/internal/thread"
+	threadservice "github.com/x-sports/internal/thread/service"
+	threadinstrumentedstore "github.com/x-sports/internal/thread/store/instrumented"
+	threadpgstore "github.com/x-sports/internal/thread/store/postgresql"
+	"github.com/x-sports/internal/webhook"
+	webhookservice "github.com/x-sports/internal/webhook/service"
+	webhookinstrumentedstore "github.com/x-sports/internal/webhook/store/instrumented"
+	webhookpgstore "github.com/x-sports/internal/webhook/store/postgresql"
+)
+
+// services contains all services of the application, shared
+// by the HTTP server and the commands.
+type services struct {
+	admin        admin.Service
+	game         game.Service
+	team         team.Service
+	notification notification.Service
+	webhook      webhook.Service
+	match        match.Service
+	news         news.Service
+	thread       thread.Service
+	outbox       outbox.Service
+	feed         feed.Service
+	schedule     schedule.Service
+	syndication  syndication.Service
+	seed         seed.Service
+}
+
+// loadConfig reads and validates the configuration, and sets
+// the default logger based on it.
+func loadConfig() (config.Config, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		log.Printf("[xsports-api-http] failed to load config: %s\n", err.Error())
+		return config.Config{}, err
+	}
+
+	// use the configured logger, including for the log
+	// package
+	logger, err := logging.New(os.Stderr, cfg.Log.Level, cfg.Log.Format)
+	if err != nil {
+		log.Printf("[xsports-api-http] failed to initialize logger: %s\n", err.Error())
+		return config.Config{}, err
+	}
+	slog.SetDefault(logger)
+
+	return cfg, nil
+}
+
+// connectDatabase connects to the configured database.
+func connectDatabase(cfg config.Database) (*sqlx.DB, error) {
+	// each statement is traced by the wrapping driver
+	db, err := sqlx.Connect(tracing.PostgresDriverName, cfg.DSN())
+	if err != nil {
+		log.Printf("[xsports-api-http] failed to connect database: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to connect database: %s", err.Error())
+	}
+
+	// configure connection pool
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+
+	return db, nil
+}
+
+// migrateDatabase applies the pending migrations to the given
+// database.
+func migrateDatabase(db *sqlx.DB) error {
+	migrator, err := migration.New(db)
+	if err != nil {
+		log.Printf("[xsports-api-http] failed to load migrations: %s\n", err.Error())
+		return fmt.Errorf("failed to load migrations: %s", err.Error())
+	}
+
+	applied, err := migrator.Up(context.Background())
+	for _, mig := range applied {
+		log.Printf("[xsports-api-http] applied migration %d_%s\n", mig.Version, mig.Name)
+	}
+	if err != nil {
+		log.Printf("[xsports-api-http] failed to migrate database: %s\n", err.Error())
+		return fmt.Errorf("failed to migrate database: %s", err.Error())
+	}
+
+	return nil
+}
+
+// stores contains the stores of all services, so that the
+// services can be created with stores other than PostgreSQL.
+type stores struct {
+	admin        adminservice.PGStore
+	game         gameservice.PGStore
+	team         teamservice.PGStore
+	notification notificationservice.PGStore
+	webhook      webhookservice.PGStore
+	match        matchservice.PGStore
+	news         newsservice.PGStore
+	thread       threadservice.PGStore
+	outbox       outboxservice.PGStore
+	feed         feedservice.PGStore
+	seed         seedservice.PGStore
+}
+
+// newPGStores creates and returns PostgreSQL stores of all
+// services using the given database configuration.
+func newPGStores(db *sqlx.DB, cfg config.Database) (*stores, error) {
+	adminStore, err := adminpgstore.New(db, cfg.QueryTimeout)
+	if err != nil {
+		log.Printf("[admin-api-http] failed to initialize admin postgresql store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize admin postgresql store: %s", err.Error())
+	}
+
+	gameStore, err := gamepgstore.New(db, cfg.QueryTimeout)
+	if err != nil {
+		log.Printf("[game-api-http] failed to initialize game postgresql store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize game postgresql store: %s", err.Error())
+	}
+
+	teamStore, err := teampgstore.New(db, cfg.QueryTimeout)
+	if err != nil {
+		log.Printf("[team-api-http] failed to initialize team postgresql store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize team postgresql store: %s", err.Error())
+	}
+
+	notificationStore, err := notificationpgstore.New(db, cfg.QueryTimeout)
+	if err != nil {
+		log.Printf("[notification-api-http] failed to initialize notification postgresql store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize notification postgresql store: %s", err.Error())
+	}
+
+	webhookStore, err := webhookpgstore.New(db, cfg.QueryTimeout)
+	if err != nil {
+		log.Printf("[webhook-api-http] failed to initialize webhook postgresql store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize webhook postgresql store: %s", err.Error())
+	}
+
+	matchStore, err := matchpgstore.New(db, cfg.QueryTimeout)
+	if err != nil {
+		log.Printf("[match-api-http] failed to initialize match postgresql store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize match postgresql store: %s", err.Error())
+	}
+
+	newsStore, err := newspgstore.New(db, cfg.QueryTimeout)
+	if err != nil {
+		log.Printf("[news-api-http] failed to initialize news postgresql store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize news postgresql store: %s", err.Error())
+	}
+
+	threadStore, err := threadpgstore.New(db, cfg.QueryTimeout)
+	if err != nil {
+		log.Printf("[thread-api-http] failed to initialize thread postgresql store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize thread postgresql store: %s", err.Error())
+	}
+
+	outboxStore, err := outboxpgstore.New(db, cfg.QueryTimeout)
+	if err != nil {
+		log.Printf("[outbox-api-http] failed to initialize outbox postgresql store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize outbox postgresql store: %s", err.Error())
+	}
+
+	feedStore, err := feedpgstore.New(db, cfg.QueryTimeout)
+	if err != nil {
+		log.Printf("[feed-api-http] failed to initialize feed postgresql store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize feed postgresql store: %s", err.Error())
+	}
+
+	seedStore, err := seedpgstore.New(db, cfg.QueryTimeout)
+	if err != nil {
+		log.Printf("[seed-api-http] failed to initialize seed postgresql store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize seed postgresql store: %s", err.Error())
+	}
+
+	return instrumentStores(&stores{
+		admin:        adminStore,
+		game:         gameStore,
+		team:         teamStore,
+		notification: notificationStore,
+		webhook:      webhookStore,
+		match:        matchStore,
+		news:         newsStore,
+		thread:       threadStore,
+		outbox:       outboxStore,
+		feed:         feedStore,
+		seed:         seedStore,
+	})
+}
+
+// instrumentStores returns the given stores wrapped to record
+// their metrics.
+func instrumentStores(st *stores) (*stores, error) {
+	var err error
+	if st.admin, err = admininstrumentedstore.New(st.admin); err != nil {
+		log.Printf("[admin-api-http] failed to initialize admin instrumented store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize admin instrumented store: %s", err.Error())
+	}
+	if st.game, err = gameinstrumentedstore.New(st.game); err != nil {
+		log.Printf("[game-api-http] failed to initialize game instrumented store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize game instrumented store: %s", err.Error())
+	}
+	if st.team, err = teaminstrumentedstore.New(st.team); err != nil {
+		log.Printf("[team-api-http] failed to initialize team instrumented store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize team instrumented store: %s", err.Error())
+	}
+	if st.notification, err = notificationinstrumentedstore.New(st.notification); err != nil {
+		log.Printf("[notification-api-http] failed to initialize notification instrumented store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize notification instrumented store: %s", err.Error())
+	}
+	if st.webhook, err = webhookinstrumentedstore.New(st.webhook); err != nil {
+		log.Printf("[webhook-api-http] failed to initialize webhook instrumented store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize webhook instrumented store: %s", err.Error())
+	}
+	if st.match, err = matchinstrumentedstore.New(st.match); err != nil {
+		log.Printf("[match-api-http] failed to initialize match instrumented store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize match instrumented store: %s", err.Error())
+	}
+	if st.news, err = newsinstrumentedstore.New(st.news); err != nil {
+		log.Printf("[news-api-http] failed to initialize news instrumented store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize news instrumented store: %s", err.Error())
+	}
+	if st.thread, err = threadinstrumentedstore.New(st.thread); err != nil {
+		log.Printf("[thread-api-http] failed to initialize thread instrumented store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize thread instrumented store: %s", err.Error())
+	}
+	if st.outbox, err = outboxinstrumentedstore.New(st.outbox); err != nil {
+		log.Printf("[outbox-api-http] failed to initialize outbox instrumented store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize outbox instrumented store: %s", err.Error())
+	}
+	if st.feed, err = feedinstrumentedstore.New(st.feed); err != nil {
+		log.Printf("[feed-api-http] failed to initialize feed instrumented store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize feed instrumented store: %s", err.Error())
+	}
+	if st.seed, err = seedinstrumentedstore.New(st.seed); err != nil {
+		log.Printf("[seed-api-http] failed to initialize seed instrumented store: %s\n", err.Error())
+		return nil, fmt.Errorf("failed to initialize seed instrumented store: %s", err.Error())
+	}
+
+	return st, nil
+}
+
+// newServices creates and returns all services using the
+// given stores and configuration. The given channels are used
+// by the notification service in addition to the configured
+// ones, e.g. fake channels in tests.
+func newServices(st *stores, cfg config.Config, channels ...notificationservice.Channel) (*services, error) {
+	// initialize admin service
+	var adminSvc admin.Service
+	{
+		var err error
+		svcOptions := []adminservice.Option{}
+		svcOptions = append(svcOptions, adminservice.WithConfig(adminservice.Config{
+			PasswordSalt:    cfg.Auth.PasswordSalt,
+			TokenExpiration: cfg.Auth.TokenExpiration,
+			TokenSecretKey:  cfg.Auth.TokenSecretKey,
+		}))
+
+		adminSvc, err = adminservice.New(st.admin, svcOptions...)
+		if err != nil {
+			log.Printf("[tenant-api-http] failed to initialize admin service: %s\n", err.Error())
+			return nil, fmt.Errorf("failed to initialize admin service: %s", err.Error())
+		}
+	}
+
+	// initialize game service
+	var gameSvc game.Service
+	{
+		var err error
+		gameSvc, err = gameservice.New(st.game)
+		if err != nil {
+			log.Printf("[game-api-http] failed to initialize game service: %s\n", err.Error())
+			return nil, fmt.Errorf("failed to initialize game service: %s", err.Error())
+		}
+	}
+
+	// initialize team service
+	var teamSvc team.Service
+	{
+		var err error
+		teamSvc, err = teamservice.New(st.team)
+		if err != nil {
+			log.Printf("[team-api-http] failed to initialize team service: %s\n", err.Error())
+			return nil, fmt.Errorf("failed to initialize team service: %s", err.Error())
+		}
+	}
+
+	// initialize notification service
+	var notificationSvc notification.Service
+	{
+		var err error
+		svcOptions := []notificationservice.Option{}
+		svcOptions = append(svcOptions, notificationservice.WithChannel(notificationchannel.NewWebhook(nil)))
+
+		// email channel is only enabled when SMTP server is
+		// configured
+		if cfg.SMTP.Address != "" {
+			smtpHost, _, _ := net.SplitHostPort(cfg.SMTP.Address)
+			sender := notificationchannel.SMTPSender{
+				Addr: cfg.SMTP.Address,
+				Auth: smtp.PlainAuth("", cfg.SMTP.Username, cfg.SMTP.Password, smtpHost),
+			}
+			svcOptions = append(svcOptions, notificationservice.WithChannel(notificationchannel.NewEmail(sender, cfg.SMTP.From)))
+		}
+
+		for _, channel := range channels {
+			svcOptions = append(svcOptions, notificationservice.WithChannel(channel))
+		}
+
+		notificationSvc, err = notificationservice.New(st.notification, svcOptions...)
+		if err != nil {
+			log.Printf("[notification-api-http] failed to initialize notification service: %s\n", err.Error())
+			return nil, fmt.Errorf("failed to initialize notification service: %s", err.Error())
+		}
+	}
+
+	// initialize webhook service
+	var webhookSvc webhook.Service
+	{
+		var err error
+		webhookSvc, err = webhookservice.New(st.webhook)
+		if err != nil {
+			log.Printf("[webhook-api-http] failed to initialize webhook service: %s\n", err.Error())
+			return nil, fmt.Errorf("failed to initialize webhook service: %s", err.Error())
+		}
+	}
+
+	// initialize match service
+	var matchSvc match.Service
+	{
+		var err error
+		matchSvc, err = matchservice.New(st.match, gameSvc, teamSvc)
+		if err != nil {
+			log.Printf("[match-api-http] failed to initialize match service: %s\n", err.Error())
+			return nil, fmt.Errorf("failed to initialize match service: %s", err.Error())
+		}
+	}
+
+	// initialize news service
+	var newsSvc news.Service
+	{
+		var err error
+		newsSvc, err = newsservice.New(st.news)
+		if err != nil {
+			log.Printf("[news-api-http] failed to initialize news service: %s\n", err.Error())
+			return nil, fmt.Errorf("failed to initialize news service: %s", err.Error())
+		}
+	}
+
+	// initialize thread service
+	var threadSvc thread.Service
+	{
+		var err error
+		threadSvc, err = threadservice.New(st.thread)
+		if err != nil {
+			log.Printf("[thread-api-http] failed to initialize thread service: %s\n", err.Error())
+			return nil, fmt.Errorf("failed to initialize thread service: %s", err.Error())
+		}
+	}
+
+	// initialize outbox service
+	var outboxSvc outbox.Service
+	{
+		var err error
+		outboxSvc, err = outboxservice.New(st.outbox)
+		if err != nil {
+			log.Printf("[outbox-api-http] failed to initialize outbox service: %s\n", err.Error())
+			return nil, fmt.Errorf("failed to initialize outbox service: %s", err.Error())
+		}
+
+		// subscribe services reacting to domain events
+		outboxSvc.Subscribe(match.EventTypeUpdated, "notification", notificationSvc)
+		for _, eventType := range []string{
+			match.EventTypeCreated,
+			match.EventTypeUpdated,
+			match.EventTypeCompleted,
+			news.EventTypeCreated,
+			news.EventTypeUpdated,
+			thread.EventTypeCreated,
+			thread.EventTypeUpdated,
+		} {
+			outboxSvc.Subscribe(eventType, "webhook", webhookSvc)
+		}
+	}
+
+	// initialize feed service
+	var feedSvc feed.Service
+	{
+		var err error
+		feedSvc, err = feedservice.New(st.feed, gameSvc, teamSvc)
+		if err != nil {
+			log.Printf("[feed-api-http] failed to initialize feed service: %s\n", err.Error())
+			return nil, fmt.Errorf("failed to initialize feed service: %s", err.Error())
+		}
+	}
+
+	// initialize schedule service
+	var scheduleSvc schedule.Service
+	{
+		var err error
+		scheduleSvc, err = scheduleservice.New(gameSvc, teamSvc, matchSvc)
+		if err != nil {
+			log.Printf("[schedule-api-http] failed to initialize schedule service: %s\n", err.Error())
+			return nil, fmt.Errorf("failed to initialize schedule service: %s", err.Error())
+		}
+	}
+
+	// initialize syndication service
+	var syndicationSvc syndication.Service
+	{
+		var err error
+		syndicationSvc, err = syndicationservice.New(gameSvc, newsSvc, threadSvc)
+		if err != nil {
+			log.Printf("[syndication-api-http] failed to initialize syndication service: %s\n", err.Error())
+			return nil, fmt.Errorf("failed to initialize syndication service: %s", err.Error())
+		}
+	}
+
+	// initialize seed service
+	var seedSvc seed.Service
+	{
+		var err error
+		seedSvc, err = seedservice.New(st.seed)
+		if err != nil {
+			log.Printf("[seed-api-http] failed to initialize seed service: %s\n", err.Error())
+			return nil, fmt.Errorf("failed to initialize seed service: %s", err.Error())
+		}
+	}
+
+	return &services{
+		admin:        adminSvc,
+		game:         gameSvc,
+		team:         teamSvc,
+		notification: notificationSvc,
+		webhook:      webhookSvc,
+		match:        matchSvc,
+		news:         newsSvc,
+		thread:       threadSvc,
+		outbox:       outboxSvc,
+		feed:         feedSvc,
+		schedule:     scheduleSvc,
+		syndication:  syndicationSvc,
+		seed:         seedSvc,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/x-sports/internal/thread"
+)
+
+func TestFeedVersion(t *testing.T) {
+	ts := newTestServer(t)
+	dota := ts.createGame("Dota 2")
+	valorant := ts.createGame("Valorant")
+	// the thread to move is created first, so that the latest
+	// update time of the feed stays the same after the move
+	moved := ts.createThread(dota, "Moved thread", 2)
+	ts.createThread(dota, "Dota thread", 1)
+	ts.createThread(valorant, "Valorant thread", 3)
+	path := fmt.Sprintf("/feeds/threads/rss?game_id=%d", dota)
+
+	res := ts.do(http.MethodGet, path, nil, nil)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", res.StatusCode, http.StatusOK)
+	}
+	etag := res.Header.Get("ETag")
+	if etag == "" {
+		t.Fatal("missing ETag")
+	}
+
+	res = ts.do(http.MethodGet, path, nil, map[string]string{"If-None-Match": etag})
+	if res.StatusCode != http.StatusNotModified {
+		t.Fatalf("status code = %d, want %d", res.StatusCode, http.StatusNotModified)
+	}
+
+	// the feed is modified when a thread leaves it, even if
+	// none of its remaining threads is modified
+	_, err := ts.svcs.thread.UpdateThread(context.Background(), thread.ThreadUpdate{
+		ID:     moved,
+		GameID: &valorant,
+	})
+	if err != nil {
+		t.Fatalf("failed to move thread: %s", err)
+	}
+
+	res = ts.do(http.MethodGet, path, nil, map[string]string{"If-None-Match": etag})
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", res.StatusCode, http.StatusOK)
+	}
+	body := string(res.Body)
+	if !strings.Contains(body, "Dota thread") || strings.Contains(body, "Moved thread") || strings.Contains(body, "Valorant thread") {
+		t.Errorf("feed of game %d has the wrong threads: %s", dota, body)
+	}
+}
+
+// createThread creates a thread of the given game on the
+// given day of October 2026 directly through the service.
+func (ts *testServer) createThread(gameID int64, title string, day int) int64 {
+	ts.t.Helper()
+
+	id, err := ts.svcs.thread.CreateThread(context.Background(), thread.Thread{
+		Title:       title,
+		GameID:      gameID,
+		Description: title + " description",
+		ImageThread: "https://x-sports.test/thread.png",
+		Date:        time.Date(2026, time.October, day, 12, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		ts.t.Fatalf("failed to create thread %s: %s", title, err)
+	}
+	return id
+}
@@ -0,0 +1,164 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/x-sports/internal/match"
+)
+
+func TestTeamDetail(t *testing.T) {
+	ts := newTestServer(t)
+	gameID := ts.createGame("Dota 2")
+	teamA := ts.createTeam(gameID, "Team Liquid")
+	teamB := ts.createTeam(gameID, "OG")
+	teamC := ts.createTeam(gameID, "Tundra")
+
+	// teamA plays 7 completed matches and wins 4 of them,
+	// also one match of other teams and one upcoming match
+	for day := 1; day <= 7; day++ {
+		winner := teamB
+		if day%2 == 1 {
+			winner = teamA
+		}
+		ts.createCompletedMatch(gameID, teamA, teamB, winner, day)
+	}
+	ts.createCompletedMatch(gameID, teamB, teamC, teamC, 8)
+	res := ts.do(http.MethodPost, "/matchs", map[string]interface{}{
+		"tournament_names": "Test Cup",
+		"game_id":          gameID,
+		"team_a_id":        teamA,
+		"team_b_id":        teamC,
+		"team_a_odds":      1.5,
+		"team_b_odds":      2.5,
+		"date":             "2026-10-20 12:00:00 +07:00",
+		"match_link":       "https://x-sports.test/live",
+	}, nil)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("status code = %d, want %d: %v", res.StatusCode, http.StatusOK, res.Errors)
+	}
+
+	res = ts.do(http.MethodGet, fmt.Sprintf("/teams/%d", teamA), nil, nil)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("status code = %d, want %d: %v", res.StatusCode, http.StatusOK, res.Errors)
+	}
+
+	var detail struct {
+		RecentMatches []struct {
+			Date   string `json:"date"`
+			Result string `json:"result"`
+		} `json:"recent_matches"`
+		Record struct {
+			Played int `json:"played"`
+			Wins   int `json:"wins"`
+			Losses int `json:"losses"`
+		} `json:"record"`
+	}
+	res.decode(t, &detail)
+
+	if detail.Record.Played != 7 || detail.Record.Wins != 4 || detail.Record.Losses != 3 {
+		t.Errorf("record = %+v, want 7 played, 4 wins, 3 losses", detail.Record)
+	}
+
+	// only the most recent matches are embedded, latest first
+	if len(detail.RecentMatches) != 5 {
+		t.Fatalf("got %d recent matches, want 5", len(detail.RecentMatches))
+	}
+	for i := 1; i < len(detail.RecentMatches); i++ {
+		if detail.RecentMatches[i-1].Date <= detail.RecentMatches[i].Date {
+			t.Errorf("recent matches are not sorted from the latest: %+v", detail.RecentMatches)
+			break
+		}
+	}
+	if detail.RecentMatches[0].Result != "win" {
+		t.Errorf("result of the latest match = %s, want win", detail.RecentMatches[0].Result)
+	}
+}
+
+// createCompletedMatch creates a completed match of the given
+// teams won by the given winner on the given day of October
+// 2026, directly through the service. The match is completed
+// by an update, as the way an admin records its result.
+func (ts *testServer) createCompletedMatch(gameID, teamA, teamB, winner int64, day int) {
+	ts.t.Helper()
+
+	id, err := ts.svcs.match.CreateMatch(context.Background(), match.Match{
+		TournamentNames: "Test Cup",
+		GameID:          gameID,
+		TeamAID:         teamA,
+		TeamBID:         teamB,
+		TeamAOdds:       1.5,
+		TeamBOdds:       2.5,
+		Date:            time.Date(2026, time.October, day, 12, 0, 0, 0, time.UTC),
+		MatchLink:       "https://x-sports.test/live",
+		Status:          match.StatusUpcoming,
+	})
+	if err != nil {
+		ts.t.Fatalf("failed to create match: %s", err)
+	}
+
+	status := match.StatusCompleted
+	_, err = ts.svcs.match.UpdateMatch(context.Background(), match.MatchUpdate{
+		ID:     id,
+		Status: &status,
+		Winner: &winner,
+	})
+	if err != nil {
+		ts.t.Fatalf("failed to complete match: %s", err)
+	}
+}
+
+func TestGetAllTeamsPagination(t *testing.T) {
+	ts := newTestServer(t)
+	gameID := ts.createGame("Dota 2")
+	for i := 0; i < 25; i++ {
+		ts.createTeam(gameID, fmt.Sprintf("Team %02d", i))
+	}
+
+	type teamPage struct {
+		Items []struct {
+			TeamNames string `json:"team_names"`
+		} `json:"items"`
+		Page  int `json:"page"`
+		Limit int `json:"limit"`
+		Total int `json:"total"`
+	}
+
+	tests := []struct {
+		query string
+		page  int
+		limit int
+		items int
+		first string
+	}{
+		{query: "", page: 1, limit: 20, items: 20, first: "Team 00"},
+		{query: "?page=2", page: 2, limit: 20, items: 5, first: "Team 20"},
+		{query: "?page=3&limit=10", page: 3, limit: 10, items: 5, first: "Team 20"},
+		{query: "?page=4&limit=10", page: 4, limit: 10, items: 0},
+	}
+	for _, tt := range tests {
+		res := ts.do(http.MethodGet, "/teams"+tt.query, nil, nil)
+		if res.StatusCode != http.StatusOK {
+			t.Fatalf("%s: status code = %d, want %d", tt.query, res.StatusCode, http.StatusOK)
+		}
+
+		var got teamPage
+		res.decode(t, &got)
+		if got.Page != tt.page || got.Limit != tt.limit || got.Total != 25 || len(got.Items) != tt.items {
+			t.Errorf("%s: page, limit, total, items = %d, %d, %d, %d, want %d, %d, 25, %d", tt.query, got.Page, got.Limit, got.Total, len(got.Items), tt.page, tt.limit, tt.items)
+		}
+		if len(got.Items) > 0 && got.Items[0].TeamNames != tt.first {
+			t.Errorf("%s: first team = %s, want %s", tt.query, got.Items[0].TeamNames, tt.first)
+		}
+	}
+
+	for _, query := range []string{"?limit=0", "?limit=101"} {
+		res := ts.do(http.MethodGet, "/teams"+query, nil, nil)
+		if res.StatusCode != http.StatusBadRequest || !res.hasError("INVALID_LIMIT") {
+			t.Errorf("%s: got %d %v, want INVALID_LIMIT", query, res.StatusCode, res.Errors)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/x-sports/internal/match"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	ts := newTestServer(t)
+	res := ts.do(http.MethodGet, "/matchs", nil, nil)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", res.StatusCode, http.StatusOK)
+	}
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+
+	root, ok := spans["GET /api/v1/matchs"]
+	if !ok {
+		t.Fatalf("missing request span in %v", spans)
+	}
+
+	// each span is a child of the previous one
+	parent := root
+	for _, name := range []string{
+		"match.Service.GetAllMatchs",
+		"match.PGStoreClient.GetAllMatchs",
+	} {
+		span, ok := spans[name]
+		if !ok {
+			t.Fatalf("missing span %s", name)
+		}
+		if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("parent of %s = %s, want %s", name, span.Parent().SpanID(), parent.Name())
+		}
+		parent = span
+	}
+
+	// the authentication and the response encoding are
+	// children of the request span
+	for _, name := range []string{"admin.Service.ValidateToken", "helper.Serve.WriteResponse"} {
+		span, ok := spans[name]
+		if !ok {
+			t.Fatalf("missing span %s", name)
+		}
+		if span.Parent().SpanID() != root.SpanContext().SpanID() {
+			t.Errorf("%s is not a child of the request span", name)
+		}
+	}
+}
+
+func TestTracingOutbox(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	ts := newTestServer(t)
+	gameID := ts.createGame("Dota 2")
+	ts.createMatch(gameID, ts.createTeam(gameID, "Team Liquid"), ts.createTeam(gameID, "OG"))
+
+	if _, err := ts.svcs.outbox.Dispatch(context.Background()); err != nil {
+		t.Fatalf("failed to dispatch events: %s", err)
+	}
+
+	var dispatch sdktrace.ReadOnlySpan
+	var deliveries []sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		switch span.Name() {
+		case "outbox.Service.Dispatch":
+			dispatch = span
+		case "outbox.Service.deliver":
+			deliveries = append(deliveries, span)
+		}
+	}
+	if dispatch == nil {
+		t.Fatal("missing span outbox.Service.Dispatch")
+	}
+
+	// each delivered event has its own span, child of the
+	// dispatch one
+	if len(deliveries) != 1 {
+		t.Fatalf("got %d delivery spans, want 1", len(deliveries))
+	}
+	span := deliveries[0]
+	if span.Parent().SpanID() != dispatch.SpanContext().SpanID() {
+		t.Errorf("delivery span is not a child of the dispatch span")
+	}
+	var eventType string
+	for _, attr := range span.Attributes() {
+		if attr.Key == "outbox.event_type" {
+			eventType = attr.Value.AsString()
+		}
+	}
+	if eventType != match.EventTypeCreated {
+		t.Errorf("event type = %q, want %q", eventType, match.EventTypeCreated)
+	}
+}
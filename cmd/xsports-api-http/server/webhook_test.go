@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/memory"
+	"github.com/x-sports/internal/webhook"
+	webhookservice "github.com/x-sports/internal/webhook/service"
+	webhookmemstore "github.com/x-sports/internal/webhook/store/memory"
+)
+
+func TestWebhookDeliverPending(t *testing.T) {
+	// the receiver fails the first attempt only
+	attempts := 0
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(receiver.Close)
+
+	st, err := webhookmemstore.New(memory.New())
+	if err != nil {
+		t.Fatalf("failed to initialize webhook memory store: %s", err)
+	}
+
+	// retry immediately to not wait for the backoff
+	svc, err := webhookservice.New(st, webhookservice.WithConfig(webhookservice.Config{
+		InitialBackoff: time.Nanosecond,
+		MaxBackoff:     time.Nanosecond,
+	}))
+	if err != nil {
+		t.Fatalf("failed to initialize webhook service: %s", err)
+	}
+
+	ctx := context.Background()
+	subscriptionID, err := svc.CreateSubscription(ctx, webhook.Subscription{
+		URL:        receiver.URL,
+		Secret:     "secret",
+		EventTypes: []webhook.EventType{webhook.EventTypeMatchCreated},
+		IsActive:   true,
+	})
+	if err != nil {
+		t.Fatalf("failed to create subscription: %s", err)
+	}
+
+	// the delivery is only recorded when it is published
+	err = svc.Publish(ctx, match.EventTypeCreated, match.Match{ID: 1})
+	if err != nil {
+		t.Fatalf("failed to publish: %s", err)
+	}
+	if attempts != 0 {
+		t.Fatalf("got %d attempts before sending, want none", attempts)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := svc.DeliverPending(ctx); err != nil {
+			t.Fatalf("failed to send deliveries: %s", err)
+		}
+	}
+
+	deliveries, err := svc.GetDeliveries(ctx, subscriptionID)
+	if err != nil {
+		t.Fatalf("failed to get deliveries: %s", err)
+	}
+	if len(deliveries) != 1 {
+		t.Fatalf("got %d deliveries, want 1", len(deliveries))
+	}
+	if d := deliveries[0]; d.Status != webhook.DeliveryStatusSuccess || d.Attempts != 2 {
+		t.Errorf("status, attempts = %s, %d, want success, 2", d.Status, d.Attempts)
+	}
+}
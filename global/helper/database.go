@@ -0,0 +1,53 @@
+package helper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lib/pq"
+)
+
+// Followings are the PostgreSQL error codes handled by the
+// stores.
+const (
+	// pqQueryCanceled is the error code of a query canceled
+	// because of a timeout or a cancellation request.
+	pqQueryCanceled = "57014"
+
+	// pqUniqueViolation is the error code of a query
+	// violating a unique constraint.
+	pqUniqueViolation = "23505"
+)
+
+// ErrQueryTimeout is returned when a database query is
+// canceled because it has reached its timeout limit.
+var ErrQueryTimeout = errors.New("query timeout")
+
+// ParseQueryError returns ErrQueryTimeout if the given error
+// is caused by a canceled database query, otherwise it
+// returns the given error as is.
+//
+// Canceling the context of a query makes the driver to
+// cancel the query in PostgreSQL, so the error may be either
+// the context error or the PostgreSQL cancellation error.
+func ParseQueryError(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrQueryTimeout
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == pqQueryCanceled {
+		return ErrQueryTimeout
+	}
+
+	return err
+}
+
+// IsUniqueViolation returns whether the given error is
+// caused by a query violating a unique constraint, so that
+// stores can map it into their domain error instead of
+// checking the existing rows first.
+func IsUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == pqUniqueViolation
+}
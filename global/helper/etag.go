@@ -0,0 +1,153 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// errHTTPInvalidIfMatch is returned when the given If-Match
+// header is invalid.
+var errHTTPInvalidIfMatch = errors.New("INVALID_IF_MATCH")
+
+// Versioned is a result of Serve processing function whose
+// version is written as ETag and Last-Modified response
+// headers, while only its data is written as response body.
+type Versioned[T any] struct {
+	Data T
+
+	// Version is the update time of the record the data is
+	// based on, or the latest one if it is based on multiple
+	// records. Zero version is not written.
+	Version time.Time
+
+	// Embedded identifies the state of the other records
+	// embedded in the data, e.g. their update times, or their
+	// count if only an aggregate of them is embedded. It only
+	// changes the ETag, which still has the version to update
+	// the record with If-Match.
+	Embedded []interface{}
+}
+
+// versioned is implemented by Versioned of any data type.
+type versioned interface {
+	value() interface{}
+	version() time.Time
+	embedded() []interface{}
+}
+
+func (v Versioned[T]) value() interface{} {
+	return v.Data
+}
+
+func (v Versioned[T]) version() time.Time {
+	return v.Version
+}
+
+func (v Versioned[T]) embedded() []interface{} {
+	return v.Embedded
+}
+
+// ETag returns the entity tag of the given version, i.e. the
+// update time of a record, and the state of the records
+// embedded along with it, if any.
+//
+// The tag has microsecond precision, following the precision
+// of PostgreSQL timestamp.
+func ETag(version time.Time, embedded ...interface{}) string {
+	tag := strconv.FormatInt(version.UnixMicro(), 10)
+	if len(embedded) == 0 {
+		return `"` + tag + `"`
+	}
+
+	// the embedded state is only compared as a whole, so
+	// its digest is enough
+	h := fnv.New64a()
+	for _, v := range embedded {
+		if t, ok := v.(time.Time); ok {
+			v = t.UnixMicro()
+		}
+		fmt.Fprintf(h, "%v;", v)
+	}
+
+	return `"` + tag + "." + strconv.FormatUint(h.Sum64(), 16) + `"`
+}
+
+// lastModified returns the latest of the given version and
+// the embedded update times.
+func lastModified(version time.Time, embedded []interface{}) time.Time {
+	for _, v := range embedded {
+		if t, ok := v.(time.Time); ok && t.After(version) {
+			version = t
+		}
+	}
+	return version
+}
+
+// ParseIfMatch returns the version from If-Match header of
+// the given request.
+//
+// Zero time is returned if there is no precondition, i.e.
+// the header is empty or "*". Bad request HTTPError is
+// returned if the header is not an entity tag returned by
+// ETag. The state of the embedded records in the tag is
+// ignored, as only the record itself is updated.
+func ParseIfMatch(r *http.Request) (time.Time, error) {
+	tag := strings.TrimSpace(r.Header.Get("If-Match"))
+	if tag == "" || tag == "*" {
+		return time.Time{}, nil
+	}
+
+	// weak comparison is enough as the tag is only derived
+	// from the version
+	tag = strings.TrimPrefix(tag, "W/")
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return time.Time{}, NewHTTPError(http.StatusBadRequest, errHTTPInvalidIfMatch)
+	}
+
+	tag, _, _ = strings.Cut(tag[1:len(tag)-1], ".")
+	micro, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil {
+		return time.Time{}, NewHTTPError(http.StatusBadRequest, errHTTPInvalidIfMatch)
+	}
+
+	return time.UnixMicro(micro), nil
+}
+
+// isNotModified returns whether the given request already has
+// the given entity tag, based on its If-None-Match header, or
+// the given modification time, based on its If-Modified-Since
+// header if there is no If-None-Match.
+//
+// Only GET and HEAD requests are checked, as the headers are
+// only meaningful for them.
+func isNotModified(r *http.Request, etag string, modified time.Time) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, tag := range strings.Split(inm, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == "*" || tag == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" {
+		t, err := http.ParseTime(ims)
+		if err != nil {
+			return false
+		}
+		// HTTP date only has second precision
+		return !modified.Truncate(time.Second).After(t)
+	}
+
+	return false
+}
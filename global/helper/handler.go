@@ -0,0 +1,374 @@
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/x-sports/global/logging"
+	"github.com/x-sports/global/tracing"
+)
+
+// defaultHandlerTimeout is the processing time limit of an
+// HTTP handler when none is set by Endpoint or HandlerTimeout.
+const defaultHandlerTimeout = 3000 * time.Millisecond
+
+// statusClientClosedRequest is the non-standard status code
+// of a request canceled by the client before its response is
+// written, so that it is not reported as a timeout.
+const statusClientClosedRequest = 499
+
+// handlerTimeoutKey is the context key of the processing time
+// limit set by HandlerTimeout.
+type handlerTimeoutKey struct{}
+
+// HandlerTimeout returns a middleware setting the processing
+// time limit of the handlers it wraps, unless the handler sets
+// its own in Endpoint.
+func HandlerTimeout(timeout time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), handlerTimeoutKey{}, timeout)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// Followings are the generic errors written by HTTP handlers.
+var (
+	// errHTTPBadRequest is returned when the given request is
+	// bad/invalid.
+	errHTTPBadRequest = errors.New("BAD_REQUEST")
+
+	// errHTTPInternalServer is returned when there is an
+	// unexpected error encountered when processing a request.
+	errHTTPInternalServer = errors.New("INTERNAL_SERVER_ERROR")
+
+	// errHTTPInvalidToken is returned when the given token is
+	// invalid.
+	errHTTPInvalidToken = errors.New("INVALID_TOKEN")
+
+	// errHTTPRequestTimeout is returned when processing time
+	// has reached the timeout limit.
+	errHTTPRequestTimeout = errors.New("REQUEST_TIMEOUT")
+
+	// errHTTPRequestCanceled is returned when the request is
+	// canceled by the client before it is processed.
+	errHTTPRequestCanceled = errors.New("REQUEST_CANCELED")
+
+	// errHTTPUnauthorizedAccess is returned when the request
+	// is unauthorized.
+	errHTTPUnauthorizedAccess = errors.New("UNAUTHORIZED_ACCESS")
+)
+
+// RawResponse is a result of Serve processing function that
+// is written as is instead of inside ResponseEnvelope, e.g. an
+// exported CSV file.
+type RawResponse struct {
+	// ContentType is the media type of the body.
+	ContentType string
+
+	// Filename is the name of the file to save the body as,
+	// the body is displayed inline if it is not set.
+	Filename string
+
+	Body []byte
+}
+
+// HTTPError is an error written as HTTP response with the
+// given status code as is.
+type HTTPError struct {
+	StatusCode int
+	Err        error
+}
+
+// NewHTTPError returns an error written as HTTP response with
+// the given status code.
+func NewHTTPError(statusCode int, err error) error {
+	return &HTTPError{
+		StatusCode: statusCode,
+		Err:        err,
+	}
+}
+
+// Error returns the message of the underlying error.
+func (e *HTTPError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *HTTPError) Unwrap() error {
+	return e.Err
+}
+
+// Endpoint describes how an HTTP handler processes a request
+// and writes its response.
+type Endpoint struct {
+	// Name is the name of the handler used as log prefix,
+	// e.g. "[Game HTTP][handleGetAllGames]".
+	Name string
+
+	// Timeout is the processing time limit, the one set by
+	// HandlerTimeout is used if it is not set.
+	Timeout time.Duration
+
+	// StatusCode is the status code of a success response,
+	// http.StatusOK is used if it is not set.
+	StatusCode int
+
+	// MapError maps service error into HTTP error that
+	// categorize as bad request error, unless its status code
+	// is set in MapStatus.
+	MapError map[error]error
+
+	// MapStatus maps HTTP error into its status code when it
+	// is not a bad request error.
+	MapStatus map[error]int
+}
+
+// Serve processes the given request using fn and writes its
+// result as HTTP response.
+//
+// fn is run in its own go routine and communicates only
+// through its return values, so it must not write to w. The
+// returned data is written inside ResponseEnvelope, or as is
+// if it is RawResponse. If it is Versioned, its version is
+// written as ETag and Last-Modified, and not modified response
+// is written instead if the request already has the version
+// based on If-None-Match or If-Modified-Since.
+//
+// Gateway timeout is written once the processing time limit
+// is reached, without waiting for fn. Serve still waits for
+// fn to return before returning itself, since fn may still
+// read the request, e.g. its body, which is not allowed once
+// the handler returns.
+//
+// The returned error is mapped into HTTP error as follows:
+//   - HTTPError is written with its status code.
+//   - ValidationError is written as bad request with all of
+//     its violations.
+//   - Error found in MapError is written as bad request,
+//     unless its status code is set in MapStatus.
+//   - Otherwise, it is written as internal server error.
+func Serve[T any](w http.ResponseWriter, r *http.Request, e Endpoint, fn func(ctx context.Context) (T, error)) {
+	// add timeout to context
+	timeout := e.Timeout
+	if timeout <= 0 {
+		timeout, _ = r.Context().Value(handlerTimeoutKey{}).(time.Duration)
+	}
+	if timeout <= 0 {
+		timeout = defaultHandlerTimeout
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+
+	// prepare channel for main go routine
+	type result struct {
+		data T
+		err  error
+	}
+	resChan := make(chan result, 1)
+
+	go func() {
+		// recover panic as internal error, so that it does not
+		// crash the server
+		defer func() {
+			if p := recover(); p != nil {
+				resChan <- result{err: fmt.Errorf("panic: %v", p)}
+			}
+		}()
+
+		data, err := fn(ctx)
+		resChan <- result{data: data, err: err}
+	}()
+
+	// wait and handle main go routine
+	select {
+	case <-ctx.Done():
+		// fn is waited for after the error response is
+		// flushed, so that the client does not wait for it
+		defer func() { <-resChan }()
+
+		// the context is also done when the client cancels the
+		// request, which is not a timeout
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			e.writeError(ctx, w, errHTTPRequestTimeout)
+		} else {
+			e.writeError(ctx, w, errHTTPRequestCanceled)
+		}
+		_ = http.NewResponseController(w).Flush()
+	case res := <-resChan:
+		if res.err != nil {
+			e.writeError(ctx, w, res.err)
+			return
+		}
+
+		// unwrap versioned data, its version is written as ETag
+		// and Last-Modified
+		var data interface{} = res.data
+		var version time.Time
+		var embedded []interface{}
+		if v, ok := data.(versioned); ok {
+			data, version, embedded = v.value(), v.version(), v.embedded()
+		}
+
+		statusCode := e.StatusCode
+		if statusCode == 0 {
+			statusCode = http.StatusOK
+		}
+
+		// trace encoding and writing, which may be slow for
+		// large responses
+		_, span := tracing.Start(ctx, "helper.Serve.WriteResponse")
+		defer span.End()
+
+		// raw data is written as is, without ResponseEnvelope
+		var body []byte
+		decorator := JSONContentTypeDecorator
+		if raw, ok := data.(RawResponse); ok {
+			body, decorator = raw.Body, NewContentTypeDecorator(raw.ContentType)
+			if raw.Filename != "" {
+				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", raw.Filename))
+			}
+		} else {
+			var err error
+			body, err = json.Marshal(ResponseEnvelope{
+				Data: data,
+			})
+			if err != nil {
+				e.writeError(ctx, w, err)
+				return
+			}
+		}
+
+		if !version.IsZero() {
+			etag, modified := ETag(version, embedded...), lastModified(version, embedded)
+			w.Header().Set("ETag", etag)
+			w.Header().Set("Last-Modified", modified.UTC().Format(http.TimeFormat))
+
+			// the client already has this version
+			if isNotModified(r, etag, modified) {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+		}
+
+		WriteResponse(w, body, statusCode, decorator)
+	}
+}
+
+// writeError maps the given error into HTTP error and writes
+// it as HTTP response, logging it with the request fields in
+// the given context.
+func (e Endpoint) writeError(ctx context.Context, w http.ResponseWriter, err error) {
+	statusCode, parsedErr := e.mapError(err)
+
+	// log and trace the actual error if its internal error
+	if statusCode == http.StatusInternalServerError {
+		tracing.RecordError(ctx, err)
+		slog.ErrorContext(ctx, "internal error", slog.String("handler", e.Name), slog.String("error", err.Error()))
+	}
+	slog.InfoContext(ctx, "failed to process request", slog.String("handler", e.Name), slog.Int("status", statusCode), slog.String("error", parsedErr.Error()))
+
+	var verr *ValidationError
+	if errors.As(parsedErr, &verr) {
+		WriteValidationErrorResponse(w, statusCode, verr, e.MapError)
+		return
+	}
+
+	WriteErrorResponse(w, statusCode, []string{parsedErr.Error()})
+}
+
+// mapError returns HTTP error and its status code from the
+// given error.
+func (e Endpoint) mapError(err error) (int, error) {
+	if errors.Is(err, errHTTPRequestTimeout) {
+		return http.StatusGatewayTimeout, err
+	}
+
+	// fn may also fail with the cancellation of its context
+	// before the request cancellation is handled
+	if errors.Is(err, errHTTPRequestCanceled) || errors.Is(err, context.Canceled) {
+		return statusClientClosedRequest, errHTTPRequestCanceled
+	}
+
+	var herr *HTTPError
+	if errors.As(err, &herr) {
+		return herr.StatusCode, herr.Err
+	}
+
+	var verr *ValidationError
+	if errors.As(err, &verr) {
+		return http.StatusBadRequest, verr
+	}
+
+	// only comparable errors can be used as map key
+	err = ParseQueryError(err)
+	if reflect.TypeOf(err).Comparable() {
+		if v, ok := e.MapError[err]; ok {
+			if code, ok := e.MapStatus[v]; ok {
+				return code, v
+			}
+			return http.StatusBadRequest, v
+		}
+	}
+
+	return http.StatusInternalServerError, errHTTPInternalServer
+}
+
+// DecodeJSON reads JSON body of the given request and stores
+// it in the value pointed to by v.
+//
+// Bad request HTTPError is returned if the body cannot be
+// read or decoded.
+func DecodeJSON(r *http.Request, v interface{}) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return NewHTTPError(http.StatusBadRequest, errHTTPBadRequest)
+	}
+
+	err = json.Unmarshal(body, v)
+	if err != nil {
+		return NewHTTPError(http.StatusBadRequest, errHTTPBadRequest)
+	}
+
+	return nil
+}
+
+// Authenticate validates bearer token of the given request
+// using validate, and returns the validation result, e.g.
+// admin.Service.ValidateToken returning the token data.
+//
+// Bad request HTTPError is returned if there is no token,
+// while unauthorized HTTPError is returned if the token is
+// invalid.
+func Authenticate[T any](ctx context.Context, r *http.Request, validate func(ctx context.Context, token string) (T, error)) (T, error) {
+	var empty T
+
+	// get token from header
+	token, err := GetBearerTokenFromHeader(r)
+	if err != nil {
+		return empty, NewHTTPError(http.StatusBadRequest, errHTTPInvalidToken)
+	}
+
+	// check access token
+	data, err := validate(ctx, token)
+	if err != nil {
+		slog.InfoContext(ctx, "unauthorized token", slog.String("error", err.Error()))
+		return empty, NewHTTPError(http.StatusUnauthorized, errHTTPUnauthorizedAccess)
+	}
+
+	// identify the rest of the request logs with the fields
+	// of the token data, e.g. the admin ID
+	if v, ok := any(data).(slog.LogValuer); ok {
+		logging.AddAttrs(ctx, slog.Any("", v))
+	}
+
+	return data, nil
+}
@@ -0,0 +1,57 @@
+package helper
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServeTimeout(t *testing.T) {
+	returned := make(chan struct{})
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+
+	Serve(rec, req, Endpoint{Name: "test", Timeout: 10 * time.Millisecond}, func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+
+		// fn is still using the request after the timeout
+		time.Sleep(10 * time.Millisecond)
+		close(returned)
+		return 0, ctx.Err()
+	})
+
+	// Serve returns only after fn returns
+	select {
+	case <-returned:
+	default:
+		t.Fatal("Serve returned before fn")
+	}
+
+	if rec.Code != http.StatusGatewayTimeout {
+		t.Errorf("status code = %d, want %d", rec.Code, http.StatusGatewayTimeout)
+	}
+	if !strings.Contains(rec.Body.String(), errHTTPRequestTimeout.Error()) {
+		t.Errorf("body = %s, want %s", rec.Body.String(), errHTTPRequestTimeout)
+	}
+}
+
+func TestServeCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+
+	Serve(rec, req, Endpoint{Name: "test"}, func(ctx context.Context) (int, error) {
+		// the client cancels the request while it is processed
+		cancel()
+		<-ctx.Done()
+		time.Sleep(10 * time.Millisecond)
+		return 0, ctx.Err()
+	})
+
+	if rec.Code != statusClientClosedRequest {
+		t.Errorf("status code = %d, want %d", rec.Code, statusClientClosedRequest)
+	}
+}
@@ -11,9 +11,10 @@ import (
 // ResponseEnvelope is Sisva standard JSON object for HTTP
 // response.
 type ResponseEnvelope struct {
-	Data   interface{} `json:"data,omitempty"`
-	Errors []string    `json:"errors,omitempty"`
-	Status string      `json:"status,omitempty"`
+	Data        interface{}          `json:"data,omitempty"`
+	Errors      []string             `json:"errors,omitempty"`
+	FieldErrors []FieldErrorEnvelope `json:"field_errors,omitempty"`
+	Status      string               `json:"status,omitempty"`
 }
 
 // ResponseDecorator is a HTTP respose decorator.
@@ -76,12 +77,15 @@ func WriteResponse(w http.ResponseWriter, body []byte, statusCode int, decorator
 //   - statusCode: HTTP status code.
 //   - errs: List of errror messages.
 func WriteErrorResponse(w http.ResponseWriter, statusCode int, errs []string) {
-	// construct response object
-	response := ResponseEnvelope{
+	writeErrorEnvelope(w, statusCode, ResponseEnvelope{
 		Errors: errs,
 		Status: http.StatusText(statusCode),
-	}
+	})
+}
 
+// writeErrorEnvelope writes the given error response object
+// as HTTP response.
+func writeErrorEnvelope(w http.ResponseWriter, statusCode int, response ResponseEnvelope) {
 	// marshal json
 	json, err := json.Marshal(response)
 	if err != nil {
@@ -0,0 +1,57 @@
+package helper
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// TxClient is a store client whose queries run in a
+// transaction. Every PGStoreClient of the services
+// implements it.
+type TxClient interface {
+	// Commit commits the transaction.
+	Commit() error
+
+	// Rollback aborts the transaction.
+	Rollback() error
+}
+
+// WithTransaction creates a store client using transaction
+// with the given constructor, usually PGStore.NewClient, and
+// calls fn with it.
+//
+// The transaction is committed when fn returns nil, and is
+// rolled back when fn returns an error or panics. The error
+// returned by fn is returned as is, so that callers can
+// still compare it with the known errors, and the panic is
+// propagated after the rollback.
+func WithTransaction[C TxClient](newClient func(useTx bool) (C, error), fn func(client C) error) (err error) {
+	client, err := newClient(true)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			rollback(client, fmt.Errorf("panic: %v", p))
+			panic(p)
+		}
+	}()
+
+	err = fn(client)
+	if err != nil {
+		rollback(client, err)
+		return err
+	}
+
+	return client.Commit()
+}
+
+// rollback aborts the transaction of the given client. A
+// rollback failure is only logged since the cause has to be
+// returned to the caller instead.
+func rollback(client TxClient, cause error) {
+	if err := client.Rollback(); err != nil {
+		slog.Error("failed to rollback transaction", slog.String("cause", cause.Error()), slog.String("error", err.Error()))
+	}
+}
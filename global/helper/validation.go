@@ -0,0 +1,145 @@
+package helper
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Followings are the stable codes of a field error. The codes
+// are shared across handlers, so clients can rely on them to
+// handle validation errors regardless of the resource.
+const (
+	// CodeRequired is used when the field is missing or
+	// empty.
+	CodeRequired = "REQUIRED"
+
+	// CodeInvalidFormat is used when the field cannot be
+	// parsed, e.g. malformed date.
+	CodeInvalidFormat = "INVALID_FORMAT"
+
+	// CodeInvalidValue is used when the field is not one of
+	// the allowed values.
+	CodeInvalidValue = "INVALID_VALUE"
+
+	// CodeOutOfRange is used when the field is outside of
+	// the allowed range.
+	CodeOutOfRange = "OUT_OF_RANGE"
+
+	// CodeNotFound is used when the field refers to a
+	// resource that does not exist.
+	CodeNotFound = "NOT_FOUND"
+
+	// CodeConflict is used when the field conflicts with
+	// another field or resource.
+	CodeConflict = "CONFLICT"
+)
+
+// FieldError denotes a violation of a field.
+type FieldError struct {
+	// Field is the name of the field in the HTTP request.
+	Field string
+
+	// Code is the stable code of the violation.
+	Code string
+
+	// Err is the domain error of the violation.
+	Err error
+}
+
+// ValidationError collects all field violations found when
+// validating an object.
+//
+// ValidationError unwraps into the domain errors of its
+// fields, so errors.Is can still be used to check whether a
+// specific violation is found.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// Add adds a violation of the given field.
+func (v *ValidationError) Add(field, code string, err error) {
+	v.Fields = append(v.Fields, FieldError{
+		Field: field,
+		Code:  code,
+		Err:   err,
+	})
+}
+
+// Merge adds all violations of the given validation error
+// with the given prefix prepended to their fields, e.g. the
+// index of an item in a bulk request.
+func (v *ValidationError) Merge(prefix string, other *ValidationError) {
+	for _, fe := range other.Fields {
+		v.Add(prefix+fe.Field, fe.Code, fe.Err)
+	}
+}
+
+// Err returns the validation error, or nil if there is no
+// violation found.
+func (v *ValidationError) Err() error {
+	if len(v.Fields) == 0 {
+		return nil
+	}
+	return v
+}
+
+// Error returns the messages of all violations.
+func (v *ValidationError) Error() string {
+	msgs := make([]string, 0, len(v.Fields))
+	for _, fe := range v.Fields {
+		msgs = append(msgs, fe.Field+": "+fe.Err.Error())
+	}
+	return strings.Join(msgs, ", ")
+}
+
+// Unwrap returns the domain errors of all violations.
+func (v *ValidationError) Unwrap() []error {
+	errs := make([]error, 0, len(v.Fields))
+	for _, fe := range v.Fields {
+		errs = append(errs, fe.Err)
+	}
+	return errs
+}
+
+// FieldErrorEnvelope is the JSON object of a field error in
+// HTTP response.
+type FieldErrorEnvelope struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// WriteValidationErrorResponse writes HTTP response of the
+// given validation error based on the given arguments:
+//   - w: Response writer object.
+//   - statusCode: HTTP status code.
+//   - verr: Validation error to write.
+//   - mapErr: Map of domain error into HTTP error, used to
+//     keep the flat list of error messages.
+func WriteValidationErrorResponse(w http.ResponseWriter, statusCode int, verr *ValidationError, mapErr map[error]error) {
+	errs := make([]string, 0, len(verr.Fields))
+	fields := make([]FieldErrorEnvelope, 0, len(verr.Fields))
+	seen := make(map[string]struct{})
+	for _, fe := range verr.Fields {
+		fields = append(fields, FieldErrorEnvelope{
+			Field:   fe.Field,
+			Code:    fe.Code,
+			Message: fe.Err.Error(),
+		})
+
+		msg := fe.Code
+		if v, ok := mapErr[fe.Err]; ok {
+			msg = v.Error()
+		}
+		if _, ok := seen[msg]; !ok {
+			seen[msg] = struct{}{}
+			errs = append(errs, msg)
+		}
+	}
+
+	writeErrorEnvelope(w, statusCode, ResponseEnvelope{
+		Errors:      errs,
+		FieldErrors: fields,
+		Status:      http.StatusText(statusCode),
+	})
+}
@@ -0,0 +1,73 @@
+package logging
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/x-sports/global/recorder"
+)
+
+// HeaderRequestID is the header of the request ID in the HTTP
+// request and response.
+const HeaderRequestID = "X-Request-ID"
+
+// maxRequestIDLength is the maximum length of a request ID
+// given by the client, longer ones are replaced.
+const maxRequestIDLength = 128
+
+// Middleware adds the request ID to the context of the
+// requests and logs each of them once served. The request ID
+// is taken from the X-Request-ID header, or generated if it is
+// missing or invalid, and written back in the response.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(HeaderRequestID)
+		if !validRequestID(requestID) {
+			requestID = newRequestID()
+		}
+		w.Header().Set(HeaderRequestID, requestID)
+
+		ctx := WithRequestID(r.Context(), requestID)
+		start := time.Now()
+		rec := recorder.New(w)
+		next.ServeHTTP(rec, r.WithContext(ctx))
+
+		level := slog.LevelInfo
+		if rec.StatusCode() >= http.StatusInternalServerError {
+			level = slog.LevelError
+		}
+		slog.Log(ctx, level, "request served",
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.Int("status", rec.StatusCode()),
+			slog.Float64("duration_ms", float64(time.Since(start).Microseconds())/1000),
+		)
+	})
+}
+
+// validRequestID returns whether the given request ID given
+// by the client can be used, i.e. it is not empty, not too
+// long, and only has printable ASCII characters.
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(requestID); i++ {
+		if requestID[i] < '!' || requestID[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// newRequestID returns a random request ID.
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
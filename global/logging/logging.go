@@ -0,0 +1,144 @@
+// Package logging provides the structured logger of the
+// application, whose records include the request ID and the
+// other fields of the request found in the context.
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+)
+
+// Followings are the supported log formats.
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// ErrInvalidFormat is returned when the given log format is
+// not supported.
+var ErrInvalidFormat = errors.New("invalid log format")
+
+// New returns a logger writing records at or above the given
+// level, e.g. "info", to w in the given format.
+func New(w io.Writer, level, format string) (*slog.Logger, error) {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return nil, err
+	}
+
+	opts := &slog.HandlerOptions{
+		Level: lvl,
+	}
+
+	var h slog.Handler
+	switch strings.ToLower(format) {
+	case FormatJSON:
+		h = slog.NewJSONHandler(w, opts)
+	case FormatText:
+		h = slog.NewTextHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidFormat, format)
+	}
+
+	return slog.New(contextHandler{h}), nil
+}
+
+// contextHandler is a slog.Handler adding the fields of the
+// request in the context to each record.
+type contextHandler struct {
+	slog.Handler
+}
+
+// Handle adds the fields of the request in the given context
+// to the given record and handles it.
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if f := fieldsFromContext(ctx); f != nil {
+		r.AddAttrs(f.attrs()...)
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+// WithAttrs returns a handler having the given attributes.
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup returns a handler having the given group.
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
+
+// fieldsKey is the context key of the request fields.
+type fieldsKey struct{}
+
+// fields are the fields of a request added to its records.
+// They are shared by the contexts derived from the request
+// context, so that fields added while processing the request,
+// e.g. the authenticated admin, are also in the access log.
+type fields struct {
+	mu        sync.Mutex
+	requestID string
+	extra     []slog.Attr
+}
+
+// attrs returns the fields as attributes.
+func (f *fields) attrs() []slog.Attr {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	attrs := make([]slog.Attr, 0, len(f.extra)+1)
+	attrs = append(attrs, slog.String("request_id", f.requestID))
+	return append(attrs, f.extra...)
+}
+
+// fieldsFromContext returns the request fields in the given
+// context, or nil if there is none.
+func fieldsFromContext(ctx context.Context) *fields {
+	if ctx == nil {
+		return nil
+	}
+	f, _ := ctx.Value(fieldsKey{}).(*fields)
+	return f
+}
+
+// WithRequestID returns a copy of the given context with the
+// given request ID, which is added to the records logged with
+// the context.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, fieldsKey{}, &fields{
+		requestID: requestID,
+	})
+}
+
+// RequestID returns the request ID in the given context, or
+// empty string if there is none.
+func RequestID(ctx context.Context) string {
+	f := fieldsFromContext(ctx)
+	if f == nil {
+		return ""
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requestID
+}
+
+// AddAttrs adds the given attributes to the records of the
+// request in the given context, including the ones logged
+// with its parent contexts afterwards. It does nothing if
+// the context has no request.
+func AddAttrs(ctx context.Context, attrs ...slog.Attr) {
+	f := fieldsFromContext(ctx)
+	if f == nil {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.extra = append(f.extra, attrs...)
+}
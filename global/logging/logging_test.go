@@ -0,0 +1,108 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// tokenData is a token data logged with its ID only.
+type tokenData struct {
+	AdminID int64
+}
+
+func (d tokenData) LogValue() slog.Value {
+	return slog.GroupValue(slog.Int64("admin_id", d.AdminID))
+}
+
+// useLogger sets the default logger to write JSON records to
+// the returned buffer until the test ends.
+func useLogger(t *testing.T) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	logger, err := New(&buf, "debug", FormatJSON)
+	if err != nil {
+		t.Fatalf("failed to create logger: %s", err)
+	}
+
+	prev := slog.Default()
+	slog.SetDefault(logger)
+	t.Cleanup(func() { slog.SetDefault(prev) })
+
+	return &buf
+}
+
+// records decodes the JSON records in the given buffer.
+func records(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+
+	var recs []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var rec map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			t.Fatalf("failed to decode record %s: %s", line, err)
+		}
+		recs = append(recs, rec)
+	}
+	return recs
+}
+
+func TestMiddleware(t *testing.T) {
+	buf := useLogger(t)
+
+	h := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		AddAttrs(r.Context(), slog.Any("", tokenData{AdminID: 7}))
+		slog.InfoContext(r.Context(), "processing")
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/matchs", nil)
+	req.Header.Set(HeaderRequestID, "req-1")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get(HeaderRequestID); got != "req-1" {
+		t.Errorf("response request ID = %s, want req-1", got)
+	}
+
+	recs := records(t, buf)
+	if len(recs) != 2 {
+		t.Fatalf("got %d records, want 2: %s", len(recs), buf)
+	}
+	for _, r := range recs {
+		if r["request_id"] != "req-1" || r["admin_id"] != float64(7) {
+			t.Errorf("record %v, want request_id req-1 and admin_id 7", r)
+		}
+	}
+	if access := recs[1]; access["status"] != float64(http.StatusCreated) || access["method"] != http.MethodPost {
+		t.Errorf("access record %v, want status 201 and method POST", access)
+	}
+}
+
+func TestMiddlewareGeneratesRequestID(t *testing.T) {
+	useLogger(t)
+
+	for _, requestID := range []string{"", "has space", strings.Repeat("a", maxRequestIDLength+1)} {
+		var got string
+		h := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = RequestID(r.Context())
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/games", nil)
+		req.Header.Set(HeaderRequestID, requestID)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		if got == "" || got == requestID {
+			t.Errorf("request ID of %q = %q, want a generated one", requestID, got)
+		}
+		if rec.Header().Get(HeaderRequestID) != got {
+			t.Errorf("response request ID = %s, want %s", rec.Header().Get(HeaderRequestID), got)
+		}
+	}
+}
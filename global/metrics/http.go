@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/x-sports/global/recorder"
+)
+
+// unknownHandler is the handler label of requests whose route
+// has no name.
+const unknownHandler = "unknown"
+
+// Middleware records the count and latency of the requests.
+// Requests are labeled by the name of their matched route,
+// which is the HandlerIdentity.Name of the serving handler.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler := unknownHandler
+		if route := mux.CurrentRoute(r); route != nil && route.GetName() != "" {
+			handler = route.GetName()
+		}
+
+		start := time.Now()
+		rec := recorder.New(w)
+		next.ServeHTTP(rec, r)
+
+		httpRequestsTotal.WithLabelValues(handler, r.Method, strconv.Itoa(rec.StatusCode())).Inc()
+		httpRequestDuration.WithLabelValues(handler, r.Method).Observe(time.Since(start).Seconds())
+	})
+}
@@ -0,0 +1,124 @@
+// Package metrics provides the Prometheus metrics of the
+// application and the helpers to record them.
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace is the prefix of all metric names.
+const namespace = "xsports"
+
+// Followings are the values of the result label.
+const (
+	resultSuccess = "success"
+	resultError   = "error"
+)
+
+// registry is the registry of all metrics, it is used instead
+// of the default registry so that only the metrics of the
+// application are exposed.
+var registry = prometheus.NewRegistry()
+
+// Followings are the collected metrics.
+var (
+	httpRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "Number of HTTP requests by handler, method, and status code.",
+	}, []string{"handler", "method", "code"})
+
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of HTTP requests by handler and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"handler", "method"})
+
+	storeQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "store_query_duration_seconds",
+		Help:      "Latency of store methods by store, method, and result.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2},
+	}, []string{"store", "method", "result"})
+
+	domainEventsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "domain_events_total",
+		Help:      "Number of committed domain events by type, e.g. match.created and match.completed.",
+	}, []string{"type"})
+
+	uploadsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "uploads_total",
+		Help:      "Number of file uploads by result.",
+	}, []string{"result"})
+
+	uploadBytesTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "upload_bytes_total",
+		Help:      "Size of the successfully uploaded files in bytes.",
+	})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestsTotal,
+		httpRequestDuration,
+		storeQueryDuration,
+		domainEventsTotal,
+		uploadsTotal,
+		uploadBytesTotal,
+	)
+}
+
+// Handler returns the HTTP handler exposing the metrics in
+// Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// RegisterDB registers the connection pool statistics of the
+// given database with the given name.
+func RegisterDB(name string, db *sql.DB) error {
+	return registry.Register(collectors.NewDBStatsCollector(db, name))
+}
+
+// ObserveQuery records the latency of the given store method
+// started at the given time, ended with the given error.
+func ObserveQuery(store, method string, start time.Time, err error) {
+	storeQueryDuration.WithLabelValues(store, method, result(err)).Observe(time.Since(start).Seconds())
+}
+
+// CountEvents counts the committed domain events of the given
+// types.
+func CountEvents(eventTypes ...string) {
+	for _, eventType := range eventTypes {
+		domainEventsTotal.WithLabelValues(eventType).Inc()
+	}
+}
+
+// CountUpload counts an upload of the given size ended with
+// the given error.
+func CountUpload(size int64, err error) {
+	uploadsTotal.WithLabelValues(result(err)).Inc()
+	if err == nil {
+		uploadBytesTotal.Add(float64(size))
+	}
+}
+
+// result returns the result label of the given error.
+func result(err error) string {
+	if err != nil {
+		return resultError
+	}
+	return resultSuccess
+}
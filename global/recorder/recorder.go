@@ -0,0 +1,54 @@
+// Package recorder provides the HTTP response writer recording
+// the written status code, used by the middlewares reporting
+// the served requests.
+package recorder
+
+import "net/http"
+
+// StatusRecorder records the status code written to the
+// wrapped response writer.
+type StatusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+// New returns a StatusRecorder wrapping the given response
+// writer.
+func New(w http.ResponseWriter) *StatusRecorder {
+	return &StatusRecorder{
+		ResponseWriter: w,
+	}
+}
+
+// WriteHeader records the given status code and writes it.
+func (r *StatusRecorder) WriteHeader(statusCode int) {
+	if r.statusCode == 0 {
+		r.statusCode = statusCode
+	}
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write records the implicit http.StatusOK if no status code
+// is written yet, then writes the given body.
+func (r *StatusRecorder) Write(b []byte) (int, error) {
+	if r.statusCode == 0 {
+		r.statusCode = http.StatusOK
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the wrapped response writer, so that
+// http.ResponseController can reach its optional interfaces,
+// e.g. http.Flusher.
+func (r *StatusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// StatusCode returns the written status code, which is
+// http.StatusOK if nothing is written.
+func (r *StatusRecorder) StatusCode() int {
+	if r.statusCode == 0 {
+		return http.StatusOK
+	}
+	return r.statusCode
+}
@@ -0,0 +1,118 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// PostgresDriverName is the name of the PostgreSQL driver
+// creating a span for each SQL statement, to be used instead
+// of "postgres" in sql.Open.
+const PostgresDriverName = "postgres-traced"
+
+func init() {
+	sql.Register(PostgresDriverName, postgresDriver{})
+
+	// sqlx rebinds the queries based on the driver name
+	sqlx.BindDriver(PostgresDriverName, sqlx.DOLLAR)
+}
+
+// postgresDriver wraps lib/pq driver to trace its connections.
+type postgresDriver struct{}
+
+// Open opens a traced connection to the database.
+func (postgresDriver) Open(name string) (driver.Conn, error) {
+	c, err := pq.Driver{}.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &conn{c}, nil
+}
+
+// conn is a lib/pq connection whose statements are traced. It
+// implements the driver interfaces implemented by lib/pq, so
+// that database/sql uses it the same way.
+type conn struct {
+	driver.Conn
+}
+
+// Interfaces implemented by conn.
+var (
+	_ driver.QueryerContext     = (*conn)(nil)
+	_ driver.ExecerContext      = (*conn)(nil)
+	_ driver.ConnPrepareContext = (*conn)(nil)
+	_ driver.ConnBeginTx        = (*conn)(nil)
+	_ driver.Pinger             = (*conn)(nil)
+	_ driver.SessionResetter    = (*conn)(nil)
+	_ driver.Validator          = (*conn)(nil)
+)
+
+// QueryContext runs the given query in a span.
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	ctx, span := startStatement(ctx, query)
+	rows, err := c.Conn.(driver.QueryerContext).QueryContext(ctx, query, args)
+	End(span, err)
+	return rows, err
+}
+
+// ExecContext runs the given statement in a span.
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	ctx, span := startStatement(ctx, query)
+	res, err := c.Conn.(driver.ExecerContext).ExecContext(ctx, query, args)
+	End(span, err)
+	return res, err
+}
+
+// PrepareContext prepares the given statement.
+func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return c.Conn.(driver.ConnPrepareContext).PrepareContext(ctx, query)
+}
+
+// BeginTx starts a transaction in a span.
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	ctx, span := startStatement(ctx, "BEGIN")
+	tx, err := c.Conn.(driver.ConnBeginTx).BeginTx(ctx, opts)
+	End(span, err)
+	return tx, err
+}
+
+// Ping checks the connection.
+func (c *conn) Ping(ctx context.Context) error {
+	return c.Conn.(driver.Pinger).Ping(ctx)
+}
+
+// ResetSession resets the connection before it is reused.
+func (c *conn) ResetSession(ctx context.Context) error {
+	return c.Conn.(driver.SessionResetter).ResetSession(ctx)
+}
+
+// IsValid returns whether the connection can be reused.
+func (c *conn) IsValid() bool {
+	return c.Conn.(driver.Validator).IsValid()
+}
+
+// startStatement starts a client span of the given SQL
+// statement, named by its operation, e.g. SELECT.
+func startStatement(ctx context.Context, query string) (context.Context, trace.Span) {
+	operation := strings.TrimSpace(query)
+	if i := strings.IndexAny(operation, " \t\n"); i > 0 {
+		operation = operation[:i]
+	}
+	operation = strings.ToUpper(operation)
+
+	return tracer().Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationName(operation),
+			semconv.DBQueryText(query),
+		),
+	)
+}
@@ -0,0 +1,144 @@
+// Package tracing provides the OpenTelemetry tracing of the
+// application and the helpers to create spans.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the name of the tracer of the
+// application.
+const instrumentationName = "github.com/x-sports"
+
+// Followings are the supported span exporters.
+const (
+	// ExporterNone disables tracing.
+	ExporterNone = "none"
+
+	// ExporterStdout writes the spans to the standard output,
+	// for local runs.
+	ExporterStdout = "stdout"
+
+	// ExporterOTLP sends the spans to an OTLP collector over
+	// HTTP.
+	ExporterOTLP = "otlp"
+)
+
+// ErrInvalidExporter is returned when the given exporter is
+// not supported.
+var ErrInvalidExporter = errors.New("invalid tracing exporter")
+
+// Config denotes the tracing configuration.
+type Config struct {
+	// ServiceName is the service.name of the spans.
+	ServiceName string
+
+	// Exporter is one of ExporterNone, ExporterStdout, or
+	// ExporterOTLP.
+	Exporter string
+
+	// Endpoint is the host:port of the OTLP collector, the
+	// OTEL_EXPORTER_OTLP_ENDPOINT environment variable or
+	// localhost:4318 is used if it is empty.
+	Endpoint string
+
+	// Insecure sends the spans to the OTLP collector without
+	// TLS.
+	Insecure bool
+
+	// SampleRatio is the ratio of the sampled traces, unless
+	// the parent span is sampled by the caller.
+	SampleRatio float64
+}
+
+// Setup sets the global tracer provider exporting the spans
+// as configured, and the W3C trace context propagator. The
+// returned function flushes the pending spans and stops the
+// provider.
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.Exporter {
+	case ExporterNone, "":
+		// keep the default no-op tracer provider
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case ExporterOTLP:
+		var opts []otlptracehttp.Option
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		exporter, err = otlptracehttp.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExporter, cfg.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s exporter: %s", cfg.Exporter, err.Error())
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(cfg.ServiceName),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// tracer returns the tracer of the application from the
+// global tracer provider.
+func tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Start starts a span with the given name and attributes as
+// a child of the span in the given context, and returns the
+// context having the started span.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records the given error, if any, in the given span and
+// ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// RecordError records the given error in the span of the
+// given context, if any.
+func RecordError(ctx context.Context, err error) {
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -2,6 +2,7 @@ package admin
 
 import (
 	"context"
+	"log/slog"
 	"time"
 )
 
@@ -30,3 +31,10 @@ type TokenData struct {
 	AdminID int64
 	Email   string
 }
+
+// LogValue returns the fields of the token data added to the
+// logs of the authenticated requests. Email is left out as it
+// is personal data.
+func (d TokenData) LogValue() slog.Value {
+	return slog.GroupValue(slog.Int64("admin_id", d.AdminID))
+}
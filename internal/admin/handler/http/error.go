@@ -2,24 +2,18 @@ package http
 
 import (
 	"errors"
+	"net/http"
 
+	"github.com/x-sports/global/helper"
 	"github.com/x-sports/internal/admin"
 )
 
 // Followings are the known errors from User HTTP handlers.
 var (
-	// errBadRequest is returned when the given request is
-	// bad/invalid.
-	errBadRequest = errors.New("BAD_REQUEST")
-
 	// errExpiredToken is returned when the given token is
 	// expired.
 	errExpiredToken = errors.New("EXPIRED_TOKEN")
 
-	// errInternalServer is returned when there is an
-	// unexpected error encountered when processing a request.
-	errInternalServer = errors.New("INTERNAL_SERVER_ERROR")
-
 	// errInvalidPassword is returned when the given password
 	// is invalid.
 	errInvalidPassword = errors.New("INVALID_PASSWORD")
@@ -36,14 +30,15 @@ var (
 	// allowed HTTP method.
 	errMethodNotAllowed = errors.New("METHOD_NOT_ALLOWED")
 
-	// errRequestTimeout is returned when processing time has
-	// reached the timeout limit.
-	errRequestTimeout = errors.New("REQUEST_TIMEOUT")
+	// errQueryTimeout is returned when a database query has
+	// reached its timeout limit.
+	errQueryTimeout = errors.New("QUERY_TIMEOUT")
 )
 
 var (
 	// mapHTTPError maps service error into HTTP error that
-	// categorize as bad request error.
+	// categorize as bad request error, unless its status code
+	// is set in mapHTTPStatus.
 	//
 	// Internal server error-related should not be mapped here,
 	// and the handler should just return `errInternal` as the
@@ -53,5 +48,22 @@ var (
 		admin.ErrExpiredToken:    errExpiredToken,
 		admin.ErrInvalidPassword: errInvalidPassword,
 		admin.ErrInvalidToken:    errInvalidToken,
+		helper.ErrQueryTimeout:   errQueryTimeout,
+	}
+
+	// mapHTTPStatus maps HTTP error into its status code when
+	// it is not a bad request error.
+	mapHTTPStatus = map[error]int{
+		errQueryTimeout: http.StatusGatewayTimeout,
 	}
 )
+
+// newEndpoint returns helper.Endpoint of the handler with the
+// given name.
+func newEndpoint(name string) helper.Endpoint {
+	return helper.Endpoint{
+		Name:      "[Admin HTTP][" + name + "]",
+		MapError:  mapHTTPError,
+		MapStatus: mapHTTPStatus,
+	}
+}
@@ -86,7 +86,7 @@ func (h *Handler) createHTTPHandler(configName string) (http.Handler, error) {
 // Start starts all HTTP handlers.
 func (h *Handler) Start(multiplexer *mux.Router) error {
 	for _, handler := range h.handlers {
-		multiplexer.Handle(handler.identity.URL, handler.h)
+		multiplexer.Handle(handler.identity.URL, handler.h).Name(handler.identity.Name)
 	}
 	return nil
 }
@@ -2,11 +2,7 @@ package http
 
 import (
 	"context"
-	"encoding/json"
-	"io/ioutil"
-	"log"
 	"net/http"
-	"time"
 
 	"github.com/x-sports/global/helper"
 	"github.com/x-sports/internal/admin"
@@ -40,87 +36,24 @@ func (h *loginHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *loginHandler) handleLogin(w http.ResponseWriter, r *http.Request) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
-		if err != nil {
-			log.Printf("[Admin HTTP][handleLogin] Failed to login. Err: %s\n", err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
-		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
-
-	// prepare channels for main go routine
-	resChan := make(chan loginResponseData, 1)
-	errChan := make(chan error, 1)
-
-	go func() {
-		// read request body
-		body, err := ioutil.ReadAll(r.Body)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
-		}
-
-		// unmarshall body
+	helper.Serve(w, r, newEndpoint("handleLogin"), func(ctx context.Context) (loginResponseData, error) {
+		// decode request body
 		var data loginRequestData
-		err = json.Unmarshal(body, &data)
+		err := helper.DecodeJSON(r, &data)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
+			return loginResponseData{}, err
 		}
 
 		// login
 		token, tokenData, err := h.admin.LoginBasic(ctx, data.Email, data.Password)
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Admin HTTP][handleLogin] Internal error from LoginBasic. Err: %s\n", err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return loginResponseData{}, err
 		}
 
-		resChan <- loginResponseData{
+		return loginResponseData{
 			AdminID: tokenData.AdminID,
 			Email:   tokenData.Email,
 			Token:   token,
-		}
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case resData := <-resChan:
-		res := helper.ResponseEnvelope{
-			Data: resData,
-		}
-		resBody, err = json.Marshal(res)
-	}
+		}, nil
+	})
 }
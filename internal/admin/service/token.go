@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/x-sports/global/tracing"
 	"github.com/x-sports/internal/admin"
 )
 
@@ -31,6 +32,9 @@ func formatTokenData(data admin.TokenData) jwtClaims {
 }
 
 func (s *service) LoginBasic(ctx context.Context, email string, password string) (string, admin.TokenData, error) {
+	ctx, span := tracing.Start(ctx, "admin.Service.LoginBasic")
+	defer span.End()
+
 	// validate the given values
 	if email == "" {
 		return "", admin.TokenData{}, admin.ErrInvalidEmail
@@ -95,6 +99,9 @@ func (s *service) generateToken(ctx context.Context, data admin.TokenData) (stri
 
 // TODO: check for expired token error from internal JWT library
 func (s *service) ValidateToken(ctx context.Context, token string) (admin.TokenData, error) {
+	ctx, span := tracing.Start(ctx, "admin.Service.ValidateToken")
+	defer span.End()
+
 	if token == "" {
 		return admin.TokenData{}, admin.ErrInvalidToken
 	}
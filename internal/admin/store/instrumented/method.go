@@ -0,0 +1,14 @@
+package instrumented
+
+import (
+	"context"
+
+	"github.com/x-sports/internal/admin"
+)
+
+func (sc *storeClient) GetUserByEmail(ctx context.Context, email string) (admin.Admin, error) {
+	ctx, done := observe(ctx, "GetUserByEmail")
+	result, err := sc.next.GetUserByEmail(ctx, email)
+	done(err)
+	return result, err
+}
@@ -0,0 +1,29 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/memory"
+)
+
+func (sc *storeClient) GetUserByEmail(ctx context.Context, email string) (admin.Admin, error) {
+	var result admin.Admin
+	err := sc.c.Do(func(data *memory.Data) error {
+		for _, a := range data.Admins.All() {
+			if a.Email == email {
+				result = a
+				return nil
+			}
+		}
+
+		// the same as querying a single row
+		return sql.ErrNoRows
+	})
+	if err != nil {
+		return admin.Admin{}, err
+	}
+
+	return result, nil
+}
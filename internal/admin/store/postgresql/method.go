@@ -7,9 +7,13 @@ import (
 )
 
 func (sc *storeClient) GetUserByEmail(ctx context.Context, email string) (admin.Admin, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	// query single row
 	var adb adminDB
-	err := sc.q.QueryRowx(queryGetUserByEmail, email).StructScan(&adb)
+	err := sc.q.QueryRowxContext(ctx, queryGetUserByEmail, email).StructScan(&adb)
 	if err != nil {
 		return admin.Admin{}, err
 	}
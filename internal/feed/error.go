@@ -0,0 +1,41 @@
+package feed
+
+import "errors"
+
+var (
+	// ErrInvalidFollowID is returned when the given follow id
+	// is invalid.
+	ErrInvalidFollowID = errors.New("invalid follow id")
+
+	// ErrInvalidUserID is returned when the given user id is
+	// invalid.
+	ErrInvalidUserID = errors.New("invalid user id")
+
+	// ErrInvalidFollowType is returned when the given follow
+	// type is invalid.
+	ErrInvalidFollowType = errors.New("invalid follow type")
+
+	// ErrInvalidTargetID is returned when the given target id
+	// is invalid.
+	ErrInvalidTargetID = errors.New("invalid target id")
+
+	// ErrAlreadyFollowed is returned when the given target is
+	// already followed by the user.
+	ErrAlreadyFollowed = errors.New("already followed")
+
+	// ErrUnknownTarget is returned when the given target
+	// does not exist.
+	ErrUnknownTarget = errors.New("unknown target")
+
+	// ErrInvalidCursor is returned when the given cursor is
+	// invalid.
+	ErrInvalidCursor = errors.New("invalid cursor")
+
+	// ErrInvalidLimit is returned when the given limit is
+	// invalid.
+	ErrInvalidLimit = errors.New("invalid limit")
+
+	// ErrFollowNotFound is returned when the
+	// follow with the given ID does not exist.
+	ErrFollowNotFound = errors.New("follow not found")
+)
@@ -0,0 +1,140 @@
+package feed
+
+import (
+	"context"
+	"time"
+)
+
+type Service interface {
+	// CreateFollow creates a new follow relationship and
+	// return the created follow ID.
+	CreateFollow(ctx context.Context, follow Follow) (int64, error)
+
+	// GetFollows returns all follows of the given user.
+	GetFollows(ctx context.Context, userID int64) ([]Follow, error)
+
+	// DeleteFollowByID deletes a follow with the given
+	// follow ID owned by the given user.
+	DeleteFollowByID(ctx context.Context, userID int64, followID int64) error
+
+	// GetFeed returns items relevant to the follows of the
+	// given user until now ordered by time, newest first, or
+	// the upcoming ones ordered by time, soonest first. It
+	// also returns the cursor to get the next page, which is
+	// empty if there is no more items.
+	GetFeed(ctx context.Context, userID int64, filter Filter) ([]Item, string, error)
+}
+
+// Follow denotes a user following a team or a game.
+type Follow struct {
+	ID          int64
+	UserID      int64
+	Type        FollowType
+	TargetID    int64
+	TargetNames string // derived
+	TargetIcons string // derived
+	CreateTime  time.Time
+}
+
+// Filter denotes the filter used to get the feed.
+type Filter struct {
+	// Cursor is the cursor returned from the previous page,
+	// empty to get the first page.
+	Cursor string
+
+	// Limit is the maximum number of items returned.
+	Limit int
+
+	// Upcoming is true to get the items after now, such as
+	// scheduled matches, instead of the items until now.
+	Upcoming bool
+}
+
+// Item denotes an entry of a feed. An item refers to a
+// match, a news or a thread.
+type Item struct {
+	Type        ItemType
+	RefID       int64
+	Title       string
+	Description string
+	Image       string
+	GameID      int64
+	GameNames   string
+	GameIcons   string
+	Time        time.Time
+}
+
+// Cursor denotes the position of an item in a feed.
+type Cursor struct {
+	Time  time.Time
+	Type  ItemType
+	RefID int64
+}
+
+// FollowType denotes type of a follow target.
+type FollowType int
+
+// Followings are the known follow types.
+const (
+	FollowTypeUnknown FollowType = 0
+	FollowTypeTeam    FollowType = 1
+	FollowTypeGame    FollowType = 2
+)
+
+var (
+	// FollowTypeList is a list of valid follow types.
+	FollowTypeList = map[FollowType]struct{}{
+		FollowTypeTeam: {},
+		FollowTypeGame: {},
+	}
+
+	// followTypeName maps follow type to it's string
+	// representation.
+	followTypeName = map[FollowType]string{
+		FollowTypeTeam: "team",
+		FollowTypeGame: "game",
+	}
+)
+
+// Value returns int value of a follow type.
+func (ft FollowType) Value() int {
+	return int(ft)
+}
+
+// String returns string representaion of a follow type.
+func (ft FollowType) String() string {
+	return followTypeName[ft]
+}
+
+// ItemType denotes type of a feed item.
+type ItemType int
+
+// Followings are the known item types.
+const (
+	ItemTypeUnknown ItemType = 0
+	ItemTypeMatch   ItemType = 1
+	ItemTypeResult  ItemType = 2
+	ItemTypeNews    ItemType = 3
+	ItemTypeThread  ItemType = 4
+)
+
+var (
+	// itemTypeName maps item type to it's string
+	// representation.
+	itemTypeName = map[ItemType]string{
+		ItemTypeMatch:  "match",
+		ItemTypeResult: "result",
+		ItemTypeNews:   "news",
+		ItemTypeThread: "thread",
+	}
+)
+
+// Value returns int value of an item type.
+func (it ItemType) Value() int {
+	return int(it)
+}
+
+// String returns string representaion of an item type.
+func (it ItemType) String() string {
+	return itemTypeName[it]
+}
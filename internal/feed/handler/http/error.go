@@ -0,0 +1,99 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/feed"
+)
+
+// Followings are the known errors from Feed HTTP handlers.
+var (
+	// errInvalidFollowID is returned when the given follow ID
+	// is invalid.
+	errInvalidFollowID = errors.New("INVALID_FOLLOW_ID")
+
+	// errInvalidUserID is returned when the given user ID is
+	// invalid.
+	errInvalidUserID = errors.New("INVALID_USER_ID")
+
+	// errInvalidFollowType is returned when the given follow
+	// type is invalid.
+	errInvalidFollowType = errors.New("INVALID_FOLLOW_TYPE")
+
+	// errInvalidTargetID is returned when the given target ID
+	// is invalid.
+	errInvalidTargetID = errors.New("INVALID_TARGET_ID")
+
+	// errAlreadyFollowed is returned when the given target is
+	// already followed.
+	errAlreadyFollowed = errors.New("ALREADY_FOLLOWED")
+
+	// errUnknownTarget is returned when the given target does
+	// not exist.
+	errUnknownTarget = errors.New("UNKNOWN_TARGET")
+
+	// errInvalidCursor is returned when the given cursor is
+	// invalid.
+	errInvalidCursor = errors.New("INVALID_CURSOR")
+
+	// errInvalidLimit is returned when the given limit is
+	// invalid.
+	errInvalidLimit = errors.New("INVALID_LIMIT")
+
+	// errInvalidUpcoming is returned when the given upcoming
+	// flag is invalid.
+	errInvalidUpcoming = errors.New("INVALID_UPCOMING")
+
+	// errMethodNotAllowed is returned when accessing not
+	// allowed HTTP method.
+	errMethodNotAllowed = errors.New("METHOD_NOT_ALLOWED")
+
+	// errFollowNotFound is returned when the
+	// follow with the given ID does not exist.
+	errFollowNotFound = errors.New("FOLLOW_NOT_FOUND")
+
+	// errQueryTimeout is returned when a database query has
+	// reached its timeout limit.
+	errQueryTimeout = errors.New("QUERY_TIMEOUT")
+)
+
+var (
+	// mapHTTPError maps service error into HTTP error that
+	// categorize as bad request error, unless its status code
+	// is set in mapHTTPStatus.
+	//
+	// Internal server error-related should not be mapped
+	// here, and the handler should just return `errInternal`
+	// as the error instead
+	mapHTTPError = map[error]error{
+		feed.ErrInvalidFollowID:   errInvalidFollowID,
+		feed.ErrInvalidUserID:     errInvalidUserID,
+		feed.ErrInvalidFollowType: errInvalidFollowType,
+		feed.ErrInvalidTargetID:   errInvalidTargetID,
+		feed.ErrAlreadyFollowed:   errAlreadyFollowed,
+		feed.ErrUnknownTarget:     errUnknownTarget,
+		feed.ErrInvalidCursor:     errInvalidCursor,
+		feed.ErrInvalidLimit:      errInvalidLimit,
+		feed.ErrFollowNotFound:    errFollowNotFound,
+		helper.ErrQueryTimeout:    errQueryTimeout,
+	}
+
+	// mapHTTPStatus maps HTTP error into its status code when
+	// it is not a bad request error.
+	mapHTTPStatus = map[error]int{
+		errQueryTimeout:   http.StatusGatewayTimeout,
+		errFollowNotFound: http.StatusNotFound,
+	}
+)
+
+// newEndpoint returns helper.Endpoint of the handler with the
+// given name.
+func newEndpoint(name string) helper.Endpoint {
+	return helper.Endpoint{
+		Name:      "[Feed HTTP][" + name + "]",
+		MapError:  mapHTTPError,
+		MapStatus: mapHTTPStatus,
+	}
+}
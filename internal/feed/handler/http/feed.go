@@ -0,0 +1,86 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/feed"
+)
+
+type feedHandler struct {
+	feed  feed.Service
+	admin admin.Service
+}
+
+func (h *feedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.handleGetFeed(w, r)
+	default:
+		helper.WriteErrorResponse(w, http.StatusMethodNotAllowed, []string{errMethodNotAllowed.Error()})
+	}
+}
+
+func (h *feedHandler) handleGetFeed(w http.ResponseWriter, r *http.Request) {
+	helper.Serve(w, r, newEndpoint("handleGetFeed"), func(ctx context.Context) (feedHTTP, error) {
+		// check access token
+		tokenData, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return feedHTTP{}, err
+		}
+
+		// parsed filter
+		filter, err := parseGetFeedFilter(r.URL.Query())
+		if err != nil {
+			return feedHTTP{}, helper.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		res, next, err := h.feed.GetFeed(ctx, tokenData.AdminID, filter)
+		if err != nil {
+			return feedHTTP{}, err
+		}
+
+		// format each items
+		items := make([]itemHTTP, 0)
+		for _, r := range res {
+			i, err := formatItem(r)
+			if err != nil {
+				return feedHTTP{}, err
+			}
+			items = append(items, i)
+		}
+
+		return feedHTTP{
+			Items:      items,
+			NextCursor: next,
+		}, nil
+	})
+}
+
+func parseGetFeedFilter(request url.Values) (feed.Filter, error) {
+	filter := feed.Filter{
+		Cursor: request.Get("cursor"),
+	}
+
+	if limitStr := request.Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return feed.Filter{}, errInvalidLimit
+		}
+		filter.Limit = limit
+	}
+
+	if upcomingStr := request.Get("upcoming"); upcomingStr != "" {
+		upcoming, err := strconv.ParseBool(upcomingStr)
+		if err != nil {
+			return feed.Filter{}, errInvalidUpcoming
+		}
+		filter.Upcoming = upcoming
+	}
+
+	return filter, nil
+}
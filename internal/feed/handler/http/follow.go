@@ -0,0 +1,52 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/feed"
+)
+
+type followHandler struct {
+	feed  feed.Service
+	admin admin.Service
+}
+
+func (h *followHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	followID, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		slog.InfoContext(r.Context(), "failed to parse follow ID", slog.String("handler", "[Feed HTTP][followHandler]"), slog.String("id", vars["id"]), slog.String("error", err.Error()))
+		helper.WriteErrorResponse(w, http.StatusBadRequest, []string{errInvalidFollowID.Error()})
+		return
+	}
+
+	switch r.Method {
+	case http.MethodDelete:
+		h.handleDeleteFollowByID(w, r, followID)
+	default:
+		helper.WriteErrorResponse(w, http.StatusMethodNotAllowed, []string{errMethodNotAllowed.Error()})
+	}
+}
+
+func (h *followHandler) handleDeleteFollowByID(w http.ResponseWriter, r *http.Request, followID int64) {
+	helper.Serve(w, r, newEndpoint("handleDeleteFollowByID"), func(ctx context.Context) (int64, error) {
+		// check access token
+		tokenData, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return 0, err
+		}
+
+		err = h.feed.DeleteFollowByID(ctx, tokenData.AdminID, followID)
+		if err != nil {
+			return 0, err
+		}
+
+		return followID, nil
+	})
+}
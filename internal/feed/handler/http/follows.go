@@ -0,0 +1,103 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/feed"
+)
+
+type followsHandler struct {
+	feed  feed.Service
+	admin admin.Service
+}
+
+func (h *followsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.handleGetFollows(w, r)
+	case http.MethodPost:
+		h.handleCreateFollow(w, r)
+	default:
+		helper.WriteErrorResponse(w, http.StatusMethodNotAllowed, []string{errMethodNotAllowed.Error()})
+	}
+}
+
+func (h *followsHandler) handleGetFollows(w http.ResponseWriter, r *http.Request) {
+	helper.Serve(w, r, newEndpoint("handleGetFollows"), func(ctx context.Context) ([]followHTTP, error) {
+		// check access token
+		tokenData, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := h.feed.GetFollows(ctx, tokenData.AdminID)
+		if err != nil {
+			return nil, err
+		}
+
+		// format each follows
+		follows := make([]followHTTP, 0)
+		for _, r := range res {
+			f, err := formatFollow(r)
+			if err != nil {
+				return nil, err
+			}
+			follows = append(follows, f)
+		}
+
+		return follows, nil
+	})
+}
+
+func (h *followsHandler) handleCreateFollow(w http.ResponseWriter, r *http.Request) {
+	helper.Serve(w, r, newEndpoint("handleCreateFollow"), func(ctx context.Context) (int64, error) {
+		// check access token
+		tokenData, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return 0, err
+		}
+
+		// decode request body
+		request := followHTTP{}
+		err = helper.DecodeJSON(r, &request)
+		if err != nil {
+			return 0, err
+		}
+
+		// format HTTP request into service object
+		reqFollow, err := parseFollowFromCreateRequest(request, tokenData.AdminID)
+		if err != nil {
+			return 0, helper.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		return h.feed.CreateFollow(ctx, reqFollow)
+	})
+}
+
+// parseFollowFromCreateRequest returns feed.Follow from the
+// given HTTP request object.
+//
+// userID is used for UserID fields. Thus need to use ID of
+// user that make the request.
+func parseFollowFromCreateRequest(fh followHTTP, userID int64) (feed.Follow, error) {
+	result := feed.Follow{
+		UserID: userID,
+	}
+
+	if fh.Type != nil {
+		followType, err := parseFollowType(*fh.Type)
+		if err != nil {
+			return feed.Follow{}, err
+		}
+		result.Type = followType
+	}
+
+	if fh.TargetID != nil {
+		result.TargetID = *fh.TargetID
+	}
+
+	return result, nil
+}
@@ -0,0 +1,50 @@
+package http
+
+import (
+	"github.com/x-sports/internal/feed"
+)
+
+// formatFollow formats the given follow into the
+// respective HTTP-format object.
+func formatFollow(f feed.Follow) (followHTTP, error) {
+	followType := f.Type.String()
+
+	return followHTTP{
+		ID:          &f.ID,
+		Type:        &followType,
+		TargetID:    &f.TargetID,
+		TargetNames: &f.TargetNames,
+		TargetIcons: &f.TargetIcons,
+	}, nil
+}
+
+// formatItem formats the given feed item into the
+// respective HTTP-format object.
+func formatItem(i feed.Item) (itemHTTP, error) {
+	itemType := i.Type.String()
+	itemTime := i.Time.Format(dateFormat)
+
+	return itemHTTP{
+		Type:        &itemType,
+		RefID:       &i.RefID,
+		Title:       &i.Title,
+		Description: &i.Description,
+		Image:       &i.Image,
+		GameID:      &i.GameID,
+		GameNames:   &i.GameNames,
+		GameIcons:   &i.GameIcons,
+		Time:        &itemTime,
+	}, nil
+}
+
+// parseFollowType returns feed.FollowType from the given
+// string.
+func parseFollowType(req string) (feed.FollowType, error) {
+	switch req {
+	case feed.FollowTypeTeam.String():
+		return feed.FollowTypeTeam, nil
+	case feed.FollowTypeGame.String():
+		return feed.FollowTypeGame, nil
+	}
+	return feed.FollowTypeUnknown, errInvalidFollowType
+}
@@ -0,0 +1,153 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/feed"
+)
+
+var (
+	errUnknownConfig = errors.New("unknown config name")
+)
+
+// dateFormat denotes the standard date format used in
+// feed HTTP request and response.
+var dateFormat = "2006-01-02 15:04:05 -07:00"
+
+// Handler contains feed HTTP-handlers.
+type Handler struct {
+	handlers map[string]*handler
+	feed     feed.Service
+	admin    admin.Service
+}
+
+// handler is the HTTP handler wrapper.
+type handler struct {
+	h        http.Handler
+	identity HandlerIdentity
+}
+
+// HandlerIdentity denotes the identity of an HTTP hanlder.
+type HandlerIdentity struct {
+	Name string
+	URL  string
+}
+
+// Followings are the known HTTP handler identities
+var (
+	// HandlerFollow denotes HTTP handler to interact
+	// with a follow
+	HandlerFollow = HandlerIdentity{
+		Name: "follow",
+		URL:  "/follows/{id}",
+	}
+
+	// HandlerFollows denotes HTTP handler to interact
+	// with follows
+	HandlerFollows = HandlerIdentity{
+		Name: "follows",
+		URL:  "/follows",
+	}
+
+	// HandlerFeed denotes HTTP handler to interact
+	// with a feed
+	HandlerFeed = HandlerIdentity{
+		Name: "feed",
+		URL:  "/feed",
+	}
+)
+
+// New creates a new Handler.
+func New(feed feed.Service, admin admin.Service, identities []HandlerIdentity) (*Handler, error) {
+	h := &Handler{
+		handlers: make(map[string]*handler),
+		feed:     feed,
+		admin:    admin,
+	}
+
+	// apply options
+	for _, identity := range identities {
+		if h.handlers == nil {
+			h.handlers = map[string]*handler{}
+		}
+
+		h.handlers[identity.Name] = &handler{
+			identity: identity,
+		}
+
+		handler, err := h.createHTTPHandler(identity.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		h.handlers[identity.Name].h = handler
+	}
+
+	return h, nil
+}
+
+// createHTTPHandler creates a new HTTP handler that
+// implements http.Handler.
+func (h *Handler) createHTTPHandler(configName string) (http.Handler, error) {
+	var httpHandler http.Handler
+	switch configName {
+	case HandlerFollow.Name:
+		httpHandler = &followHandler{
+			feed:  h.feed,
+			admin: h.admin,
+		}
+	case HandlerFollows.Name:
+		httpHandler = &followsHandler{
+			feed:  h.feed,
+			admin: h.admin,
+		}
+	case HandlerFeed.Name:
+		httpHandler = &feedHandler{
+			feed:  h.feed,
+			admin: h.admin,
+		}
+	default:
+		return httpHandler, errUnknownConfig
+	}
+	return httpHandler, nil
+}
+
+// Start starts all HTTP handlers.
+func (h *Handler) Start(multiplexer *mux.Router) error {
+	for _, handler := range h.handlers {
+		multiplexer.Handle(handler.identity.URL, handler.h).Name(handler.identity.Name)
+	}
+	return nil
+}
+
+// followHTTP denotes follow object in HTTP request or
+// response body.
+type followHTTP struct {
+	ID          *int64  `json:"id"`
+	Type        *string `json:"type"`
+	TargetID    *int64  `json:"target_id"`
+	TargetNames *string `json:"target_names"`
+	TargetIcons *string `json:"target_icons"`
+}
+
+// itemHTTP denotes feed item object in HTTP response body.
+type itemHTTP struct {
+	Type        *string `json:"type"`
+	RefID       *int64  `json:"ref_id"`
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	Image       *string `json:"image"`
+	GameID      *int64  `json:"game_id"`
+	GameNames   *string `json:"game_names"`
+	GameIcons   *string `json:"game_icons"`
+	Time        *string `json:"time"`
+}
+
+// feedHTTP denotes a page of feed in HTTP response body.
+type feedHTTP struct {
+	Items      []itemHTTP `json:"items"`
+	NextCursor string     `json:"next_cursor,omitempty"`
+}
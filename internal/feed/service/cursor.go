@@ -0,0 +1,45 @@
+package service
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/x-sports/internal/feed"
+)
+
+// cursorFormat is the format of a decoded cursor, contains
+// the item time in unix nano, the item type, and the item
+// reference ID.
+const cursorFormat = "%d:%d:%d"
+
+// encodeCursor returns the opaque string representation of
+// the given cursor.
+func encodeCursor(c feed.Cursor) string {
+	raw := fmt.Sprintf(cursorFormat, c.Time.UnixNano(), c.Type.Value(), c.RefID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeCursor parses the given opaque string into a cursor.
+func decodeCursor(s string) (feed.Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return feed.Cursor{}, feed.ErrInvalidCursor
+	}
+
+	var (
+		nano    int64
+		itemTyp int
+		refID   int64
+	)
+	_, err = fmt.Sscanf(string(raw), cursorFormat, &nano, &itemTyp, &refID)
+	if err != nil || refID <= 0 {
+		return feed.Cursor{}, feed.ErrInvalidCursor
+	}
+
+	return feed.Cursor{
+		Time:  time.Unix(0, nano),
+		Type:  feed.ItemType(itemTyp),
+		RefID: refID,
+	}, nil
+}
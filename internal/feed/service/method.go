@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/global/tracing"
+	"github.com/x-sports/internal/feed"
+)
+
+func (s *service) CreateFollow(ctx context.Context, reqFollow feed.Follow) (int64, error) {
+	ctx, span := tracing.Start(ctx, "feed.Service.CreateFollow")
+	defer span.End()
+
+	// validate field
+	err := validateFollow(reqFollow)
+	if err != nil {
+		return 0, err
+	}
+
+	// the followed target must exist
+	err = s.validateFollowTarget(ctx, reqFollow)
+	if err != nil {
+		return 0, err
+	}
+
+	reqFollow.CreateTime = s.timeNow()
+
+	// create follow in a transaction, ErrAlreadyFollowed is
+	// returned by the store if the target is already
+	// followed
+	var followID int64
+	err = helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		var err error
+		followID, err = pgStoreClient.CreateFollow(ctx, reqFollow)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return followID, nil
+}
+
+func (s *service) GetFollows(ctx context.Context, userID int64) ([]feed.Follow, error) {
+	ctx, span := tracing.Start(ctx, "feed.Service.GetFollows")
+	defer span.End()
+
+	// validate user id
+	if userID <= 0 {
+		return nil, feed.ErrInvalidUserID
+	}
+
+	// get pg store client without using transaction
+	pgStoreClient, err := s.pgStore.NewClient(false)
+	if err != nil {
+		return nil, err
+	}
+
+	// get all follows from postgre
+	follows, err := pgStoreClient.GetFollows(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return follows, nil
+}
+
+func (s *service) DeleteFollowByID(ctx context.Context, userID int64, followID int64) error {
+	ctx, span := tracing.Start(ctx, "feed.Service.DeleteFollowByID")
+	defer span.End()
+
+	// validate ids
+	if userID <= 0 {
+		return feed.ErrInvalidUserID
+	}
+
+	if followID <= 0 {
+		return feed.ErrInvalidFollowID
+	}
+
+	// delete follow from pgstore in a transaction
+	return helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		return pgStoreClient.DeleteFollowByID(ctx, userID, followID)
+	})
+}
+
+func (s *service) GetFeed(ctx context.Context, userID int64, filter feed.Filter) ([]feed.Item, string, error) {
+	ctx, span := tracing.Start(ctx, "feed.Service.GetFeed")
+	defer span.End()
+
+	// validate user id
+	if userID <= 0 {
+		return nil, "", feed.ErrInvalidUserID
+	}
+
+	// validate and apply default limit
+	limit := filter.Limit
+	if limit < 0 || limit > maxFeedLimit {
+		return nil, "", feed.ErrInvalidLimit
+	}
+	if limit == 0 {
+		limit = defaultFeedLimit
+	}
+
+	// parse cursor of the previous page
+	var cursor *feed.Cursor
+	if filter.Cursor != "" {
+		c, err := decodeCursor(filter.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		cursor = &c
+	}
+
+	// get pg store client without using transaction
+	pgStoreClient, err := s.pgStore.NewClient(false)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// get one more item than requested to know whether
+	// there is a next page
+	items, err := pgStoreClient.GetFeed(ctx, userID, s.timeNow(), filter.Upcoming, cursor, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(items) > limit {
+		items = items[:limit]
+		last := items[len(items)-1]
+		next = encodeCursor(feed.Cursor{
+			Time:  last.Time,
+			Type:  last.Type,
+			RefID: last.RefID,
+		})
+	}
+
+	return items, next, nil
+}
+
+// validateFollow validates fields of the given Follow
+// whether its comply the predetermined rules.
+func validateFollow(reqFollow feed.Follow) error {
+	if reqFollow.UserID <= 0 {
+		return feed.ErrInvalidUserID
+	}
+
+	if _, valid := feed.FollowTypeList[reqFollow.Type]; !valid {
+		return feed.ErrInvalidFollowType
+	}
+
+	if reqFollow.TargetID <= 0 {
+		return feed.ErrInvalidTargetID
+	}
+
+	return nil
+}
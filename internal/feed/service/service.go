@@ -0,0 +1,28 @@
+package service
+
+import "time"
+
+// Following constants are the default values used when
+// getting a feed.
+const (
+	defaultFeedLimit = 20
+	maxFeedLimit     = 100
+)
+
+// New construts a new service.
+type service struct {
+	pgStore    PGStore
+	gameLookup GameLookup
+	teamLookup TeamLookup
+	timeNow    func() time.Time
+}
+
+// New returns a new service
+func New(pgStore PGStore, gameLookup GameLookup, teamLookup TeamLookup) (*service, error) {
+	return &service{
+		pgStore:    pgStore,
+		gameLookup: gameLookup,
+		teamLookup: teamLookup,
+		timeNow:    time.Now,
+	}, nil
+}
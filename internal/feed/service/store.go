@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/x-sports/internal/feed"
+)
+
+// PGStore is the PostgreSQL store for feed service.
+type PGStore interface {
+	NewClient(useTx bool) (PGStoreClient, error)
+}
+
+type PGStoreClient interface {
+	// Commit commits the transaction.
+	Commit() error
+
+	// Rollback aborts the transaction.
+	Rollback() error
+
+	// CreateFollow creates a new follow relationship and
+	// return the created follow ID.
+	//
+	// ErrAlreadyFollowed is returned if the user already
+	// follows the target.
+	CreateFollow(ctx context.Context, follow feed.Follow) (int64, error)
+
+	// GetFollows returns all follows of the given user.
+	GetFollows(ctx context.Context, userID int64) ([]feed.Follow, error)
+
+	// DeleteFollowByID deletes a follow with the given
+	// follow ID owned by the given user.
+	DeleteFollowByID(ctx context.Context, userID int64, followID int64) error
+
+	// GetFeed returns at most limit items relevant to the
+	// follows of the given user, positioned strictly after
+	// the given cursor. A nil cursor means the first page.
+	//
+	// The items are until the given time, newest first, or
+	// after it, soonest first, if upcoming is true.
+	GetFeed(ctx context.Context, userID int64, now time.Time, upcoming bool, cursor *feed.Cursor, limit int) ([]feed.Item, error)
+}
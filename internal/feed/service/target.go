@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/x-sports/internal/feed"
+	"github.com/x-sports/internal/game"
+	"github.com/x-sports/internal/team"
+)
+
+// GameLookup looks up the game followed by a user, e.g.
+// game.Service.
+type GameLookup interface {
+	// GetGameByID returns a game with the given game ID.
+	GetGameByID(ctx context.Context, gameID int64) (game.Game, error)
+}
+
+// TeamLookup looks up the team followed by a user, e.g.
+// team.Service.
+type TeamLookup interface {
+	// GetTeamByID returns a team with the given team ID.
+	GetTeamByID(ctx context.Context, teamID int64) (team.Team, error)
+}
+
+// validateFollowTarget validates that the target of the given
+// follow exists, ErrUnknownTarget is returned otherwise.
+func (s *service) validateFollowTarget(ctx context.Context, reqFollow feed.Follow) error {
+	var err error
+	switch reqFollow.Type {
+	case feed.FollowTypeTeam:
+		_, err = s.teamLookup.GetTeamByID(ctx, reqFollow.TargetID)
+	case feed.FollowTypeGame:
+		_, err = s.gameLookup.GetGameByID(ctx, reqFollow.TargetID)
+	}
+	if errors.Is(err, team.ErrTeamNotFound) || errors.Is(err, game.ErrGameNotFound) {
+		return feed.ErrUnknownTarget
+	}
+
+	return err
+}
@@ -0,0 +1,36 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/x-sports/internal/feed"
+)
+
+func (sc *storeClient) CreateFollow(ctx context.Context, follow feed.Follow) (int64, error) {
+	ctx, done := observe(ctx, "CreateFollow")
+	result, err := sc.next.CreateFollow(ctx, follow)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetFollows(ctx context.Context, userID int64) ([]feed.Follow, error) {
+	ctx, done := observe(ctx, "GetFollows")
+	result, err := sc.next.GetFollows(ctx, userID)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) DeleteFollowByID(ctx context.Context, userID int64, followID int64) error {
+	ctx, done := observe(ctx, "DeleteFollowByID")
+	err := sc.next.DeleteFollowByID(ctx, userID, followID)
+	done(err)
+	return err
+}
+
+func (sc *storeClient) GetFeed(ctx context.Context, userID int64, now time.Time, upcoming bool, cursor *feed.Cursor, limit int) ([]feed.Item, error) {
+	ctx, done := observe(ctx, "GetFeed")
+	result, err := sc.next.GetFeed(ctx, userID, now, upcoming, cursor, limit)
+	done(err)
+	return result, err
+}
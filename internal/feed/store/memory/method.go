@@ -0,0 +1,254 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/x-sports/internal/feed"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/memory"
+)
+
+func (sc *storeClient) CreateFollow(ctx context.Context, reqFollow feed.Follow) (int64, error) {
+	var followID int64
+	err := sc.c.Do(func(data *memory.Data) error {
+		// a target is followed at most once by a user, the
+		// same as the unique constraint in PostgreSQL
+		for _, f := range data.Follows.All() {
+			if f.UserID == reqFollow.UserID && f.Type == reqFollow.Type && f.TargetID == reqFollow.TargetID {
+				return feed.ErrAlreadyFollowed
+			}
+		}
+
+		followID = data.Follows.NextID()
+
+		// target names and icons are derived when read
+		reqFollow.ID = followID
+		reqFollow.TargetNames = ""
+		reqFollow.TargetIcons = ""
+		data.Follows.Put(followID, reqFollow)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return followID, nil
+}
+
+func (sc *storeClient) GetFollows(ctx context.Context, userID int64) ([]feed.Follow, error) {
+	follows := make([]feed.Follow, 0)
+	err := sc.c.Do(func(data *memory.Data) error {
+		for _, f := range data.Follows.All() {
+			if f.UserID != userID {
+				continue
+			}
+
+			// get names and icons of the followed team or game,
+			// empty if it does not exist
+			switch f.Type {
+			case feed.FollowTypeTeam:
+				if t, ok := data.Teams.Get(f.TargetID); ok {
+					f.TargetNames = t.TeamNames
+					f.TargetIcons = t.TeamIcons
+				}
+			case feed.FollowTypeGame:
+				if g, ok := data.Games.Get(f.TargetID); ok {
+					f.TargetNames = g.GameNames
+					f.TargetIcons = g.GameIcons
+				}
+			}
+
+			follows = append(follows, f)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(follows, func(i, j int) bool {
+		return follows[i].CreateTime.After(follows[j].CreateTime)
+	})
+
+	return follows, nil
+}
+
+func (sc *storeClient) DeleteFollowByID(ctx context.Context, userID int64, followID int64) error {
+	return sc.c.Do(func(data *memory.Data) error {
+		f, ok := data.Follows.Get(followID)
+		if !ok || f.UserID != userID {
+			return feed.ErrFollowNotFound
+		}
+
+		data.Follows.Delete(followID)
+		return nil
+	})
+}
+
+func (sc *storeClient) GetFeed(ctx context.Context, userID int64, now time.Time, upcoming bool, cursor *feed.Cursor, limit int) ([]feed.Item, error) {
+	items := make([]feed.Item, 0)
+	err := sc.c.Do(func(data *memory.Data) error {
+		// matches are included when the user follows the game
+		// or one of the teams, while news and threads are
+		// included when the user follows the game directly or
+		// through one of its teams
+		followedTeams := make(map[int64]bool)
+		followedGames := make(map[int64]bool)
+		relatedGames := make(map[int64]bool)
+		for _, f := range data.Follows.All() {
+			if f.UserID != userID {
+				continue
+			}
+
+			switch f.Type {
+			case feed.FollowTypeTeam:
+				followedTeams[f.TargetID] = true
+				if t, ok := data.Teams.Get(f.TargetID); ok {
+					relatedGames[t.GameID] = true
+				}
+			case feed.FollowTypeGame:
+				followedGames[f.TargetID] = true
+				relatedGames[f.TargetID] = true
+			}
+		}
+
+		for _, m := range data.Matches.All() {
+			if !followedGames[m.GameID] && !followedTeams[m.TeamAID] && !followedTeams[m.TeamBID] {
+				continue
+			}
+			g, ok := data.Games.Get(m.GameID)
+			if !ok {
+				continue
+			}
+			teamA, ok := data.Teams.Get(m.TeamAID)
+			if !ok {
+				continue
+			}
+			teamB, ok := data.Teams.Get(m.TeamBID)
+			if !ok {
+				continue
+			}
+
+			itemType := feed.ItemTypeMatch
+			if m.Status == match.StatusCompleted {
+				itemType = feed.ItemTypeResult
+			}
+
+			items = append(items, feed.Item{
+				Type:        itemType,
+				RefID:       m.ID,
+				Title:       teamA.TeamNames + " vs " + teamB.TeamNames,
+				Description: m.TournamentNames,
+				Image:       g.GameIcons,
+				GameID:      m.GameID,
+				GameNames:   g.GameNames,
+				GameIcons:   g.GameIcons,
+				Time:        m.Date,
+			})
+		}
+
+		for _, n := range data.News.All() {
+			g, ok := data.Games.Get(n.GameID)
+			if !ok || !relatedGames[n.GameID] {
+				continue
+			}
+
+			items = append(items, feed.Item{
+				Type:        feed.ItemTypeNews,
+				RefID:       n.ID,
+				Title:       n.Title,
+				Description: n.Description,
+				Image:       n.ImageNews,
+				GameID:      n.GameID,
+				GameNames:   g.GameNames,
+				GameIcons:   g.GameIcons,
+				Time:        n.Date,
+			})
+		}
+
+		for _, t := range data.Threads.All() {
+			g, ok := data.Games.Get(t.GameID)
+			if !ok || !relatedGames[t.GameID] {
+				continue
+			}
+
+			items = append(items, feed.Item{
+				Type:        feed.ItemTypeThread,
+				RefID:       t.ID,
+				Title:       t.Title,
+				Description: t.Description,
+				Image:       t.ImageThread,
+				GameID:      t.GameID,
+				GameNames:   g.GameNames,
+				GameIcons:   g.GameIcons,
+				Time:        t.Date,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// get either the items until now, newest first, or the
+	// upcoming ones, soonest first
+	result := make([]feed.Item, 0, len(items))
+	for _, item := range items {
+		if item.Time.After(now) == upcoming {
+			result = append(result, item)
+		}
+	}
+	items = result
+	sort.Slice(items, func(i, j int) bool {
+		return after(items[j], position(items[i]), upcoming)
+	})
+
+	// only get items positioned after the cursor
+	if cursor != nil {
+		i := sort.Search(len(items), func(i int) bool {
+			return after(items[i], *cursor, upcoming)
+		})
+		items = items[i:]
+	}
+	if len(items) > limit {
+		items = items[:limit]
+	}
+
+	return items, nil
+}
+
+// position returns the cursor of the given item.
+func position(item feed.Item) feed.Cursor {
+	return feed.Cursor{
+		Time:  item.Time,
+		Type:  item.Type,
+		RefID: item.RefID,
+	}
+}
+
+// after returns whether the given item is positioned after the
+// given cursor, ordered by time, type, and reference ID, all
+// descending, or all ascending if upcoming is true.
+func after(item feed.Item, cursor feed.Cursor, upcoming bool) bool {
+	if upcoming {
+		return less(cursor, position(item))
+	}
+	return less(position(item), cursor)
+}
+
+// less returns whether the given cursor a is before the cursor
+// b, ordered by time, type, and reference ID, all ascending.
+func less(a, b feed.Cursor) bool {
+	if !a.Time.Equal(b.Time) {
+		return a.Time.Before(b.Time)
+	}
+	if a.Type != b.Type {
+		return a.Type < b.Type
+	}
+	return a.RefID < b.RefID
+}
@@ -0,0 +1,214 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/feed"
+	"github.com/x-sports/internal/match"
+)
+
+func (sc *storeClient) CreateFollow(ctx context.Context, reqFollow feed.Follow) (int64, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// construct arguments filled with fields for the query
+	argsKV := map[string]interface{}{
+		"user_id":     reqFollow.UserID,
+		"follow_type": reqFollow.Type,
+		"target_id":   reqFollow.TargetID,
+		"create_time": reqFollow.CreateTime,
+	}
+
+	// prepare query
+	query, args, err := sqlx.Named(queryCreateFollow, argsKV)
+	if err != nil {
+		return 0, err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return 0, err
+	}
+	query = sc.q.Rebind(query)
+
+	// execute query
+	var followID int64
+	err = sc.q.QueryRowxContext(ctx, query, args...).Scan(&followID)
+	if helper.IsUniqueViolation(err) {
+		return 0, feed.ErrAlreadyFollowed
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return followID, nil
+}
+
+func (sc *storeClient) GetFollows(ctx context.Context, userID int64) ([]feed.Follow, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// construct arguments filled with fields for the query
+	argsKV := map[string]interface{}{
+		"user_id":          userID,
+		"follow_type_team": feed.FollowTypeTeam,
+		"follow_type_game": feed.FollowTypeGame,
+	}
+
+	// prepare query
+	query, args, err := sqlx.Named(queryGetFollows, argsKV)
+	if err != nil {
+		return nil, err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	query = sc.q.Rebind(query)
+
+	// query to database
+	rows, err := sc.q.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// read follows
+	follows := make([]feed.Follow, 0)
+	for rows.Next() {
+		var row followDB
+		err = rows.StructScan(&row)
+		if err != nil {
+			return nil, err
+		}
+
+		follows = append(follows, row.format())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return follows, nil
+}
+
+func (sc *storeClient) DeleteFollowByID(ctx context.Context, userID int64, followID int64) error {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// construct arguments filled with fields for the query
+	argsKV := map[string]interface{}{
+		"id":      followID,
+		"user_id": userID,
+	}
+
+	// prepare query
+	query, args, err := sqlx.Named(queryDeleteFollowByID, argsKV)
+	if err != nil {
+		return err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return err
+	}
+	query = sc.q.Rebind(query)
+
+	// execute query
+	res, err := sc.q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	// check whether the follow exists
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return feed.ErrFollowNotFound
+	}
+
+	return nil
+}
+
+func (sc *storeClient) GetFeed(ctx context.Context, userID int64, now time.Time, upcoming bool, cursor *feed.Cursor, limit int) ([]feed.Item, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// construct arguments filled with fields for the query
+	argsKV := map[string]interface{}{
+		"user_id":          userID,
+		"follow_type_team": feed.FollowTypeTeam,
+		"follow_type_game": feed.FollowTypeGame,
+		"status_completed": match.StatusCompleted,
+		"item_type_match":  feed.ItemTypeMatch,
+		"item_type_result": feed.ItemTypeResult,
+		"item_type_news":   feed.ItemTypeNews,
+		"item_type_thread": feed.ItemTypeThread,
+		"now":              now,
+		"limit":            limit,
+	}
+
+	// get either the items until now, newest first, or the
+	// upcoming ones, soonest first
+	conditions := []string{"f.item_time <= :now"}
+	order, comparison := "DESC", "<"
+	if upcoming {
+		conditions = []string{"f.item_time > :now"}
+		order, comparison = "ASC", ">"
+	}
+
+	// only get items positioned after the cursor, using the
+	// same ordering as the query
+	if cursor != nil {
+		conditions = append(conditions, fmt.Sprintf("(f.item_time, f.item_type, f.ref_id) %s (:cursor_time, :cursor_type, :cursor_ref_id)", comparison))
+		argsKV["cursor_time"] = cursor.Time
+		argsKV["cursor_type"] = cursor.Type
+		argsKV["cursor_ref_id"] = cursor.RefID
+	}
+	query := fmt.Sprintf(queryGetFeed, "WHERE "+strings.Join(conditions, " AND "), order)
+
+	// prepare query
+	query, args, err := sqlx.Named(query, argsKV)
+	if err != nil {
+		return nil, err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	query = sc.q.Rebind(query)
+
+	// query to database
+	rows, err := sc.q.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// read items
+	items := make([]feed.Item, 0)
+	for rows.Next() {
+		var row itemDB
+		err = rows.StructScan(&row)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, row.format())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
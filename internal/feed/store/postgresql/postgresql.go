@@ -0,0 +1,123 @@
+package postgresql
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/x-sports/internal/feed"
+	"github.com/x-sports/internal/feed/service"
+)
+
+var (
+	errInvalidCommit   = errors.New("cannot do commit on non-transactional querier")
+	errInvalidRollback = errors.New("cannot do rollback on non-transactional querier")
+)
+
+// store implements feed/service.PGStore
+type store struct {
+	db           *sqlx.DB
+	queryTimeout time.Duration
+}
+
+// storeClient implements feed/service.PGStoreClient
+type storeClient struct {
+	q            sqlx.ExtContext
+	queryTimeout time.Duration
+}
+
+// New creates a new store whose queries are limited to the
+// given timeout.
+func New(db *sqlx.DB, queryTimeout time.Duration) (*store, error) {
+	s := &store{
+		db:           db,
+		queryTimeout: queryTimeout,
+	}
+
+	return s, nil
+}
+
+func (s *store) NewClient(useTx bool) (service.PGStoreClient, error) {
+	var q sqlx.ExtContext
+
+	// determine what object should be use as querier
+	q = s.db
+	if useTx {
+		var err error
+		q, err = s.db.Beginx()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &storeClient{
+		q:            q,
+		queryTimeout: s.queryTimeout,
+	}, nil
+}
+
+func (sc *storeClient) Commit() error {
+	if tx, ok := sc.q.(*sqlx.Tx); ok {
+		return tx.Commit()
+	}
+	return errInvalidCommit
+}
+
+func (sc *storeClient) Rollback() error {
+	if tx, ok := sc.q.(*sqlx.Tx); ok {
+		return tx.Rollback()
+	}
+	return errInvalidRollback
+}
+
+// followDB denotes a follow data in the store.
+type followDB struct {
+	ID          int64           `db:"id"`
+	UserID      int64           `db:"user_id"`
+	Type        feed.FollowType `db:"follow_type"`
+	TargetID    int64           `db:"target_id"`
+	TargetNames string          `db:"target_names"`
+	TargetIcons string          `db:"target_icons"`
+	CreateTime  time.Time       `db:"create_time"`
+}
+
+// format formats database struct into domain struct.
+func (fdb *followDB) format() feed.Follow {
+	return feed.Follow{
+		ID:          fdb.ID,
+		UserID:      fdb.UserID,
+		Type:        fdb.Type,
+		TargetID:    fdb.TargetID,
+		TargetNames: fdb.TargetNames,
+		TargetIcons: fdb.TargetIcons,
+		CreateTime:  fdb.CreateTime,
+	}
+}
+
+// itemDB denotes a feed item data in the store.
+type itemDB struct {
+	Type        feed.ItemType `db:"item_type"`
+	RefID       int64         `db:"ref_id"`
+	Title       string        `db:"title"`
+	Description string        `db:"description"`
+	Image       string        `db:"image"`
+	GameID      int64         `db:"game_id"`
+	GameNames   string        `db:"game_names"`
+	GameIcons   string        `db:"game_icons"`
+	Time        time.Time     `db:"item_time"`
+}
+
+// format formats database struct into domain struct.
+func (idb *itemDB) format() feed.Item {
+	return feed.Item{
+		Type:        idb.Type,
+		RefID:       idb.RefID,
+		Title:       idb.Title,
+		Description: idb.Description,
+		Image:       idb.Image,
+		GameID:      idb.GameID,
+		GameNames:   idb.GameNames,
+		GameIcons:   idb.GameIcons,
+		Time:        idb.Time,
+	}
+}
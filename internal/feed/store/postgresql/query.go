@@ -0,0 +1,179 @@
+package postgresql
+
+const queryCreateFollow = `
+	INSERT INTO
+		follow
+	(
+		user_id,
+		follow_type,
+		target_id,
+		create_time
+	) VALUES (
+		:user_id,
+		:follow_type,
+		:target_id,
+		:create_time
+	)  RETURNING
+		id
+`
+
+const queryGetFollows = `
+	SELECT
+		f.id,
+		f.user_id,
+		f.follow_type,
+		f.target_id,
+		COALESCE(t.team_names, g.game_names, '') AS target_names,
+		COALESCE(t.team_icons, g.game_icons, '') AS target_icons,
+		f.create_time
+	FROM
+		follow f
+	LEFT JOIN
+		team t
+	ON
+		f.follow_type = :follow_type_team AND f.target_id = t.id
+	LEFT JOIN
+		game g
+	ON
+		f.follow_type = :follow_type_game AND f.target_id = g.id
+	WHERE
+		f.user_id = :user_id
+	ORDER BY
+		f.create_time DESC
+`
+
+const queryDeleteFollowByID = `
+	DELETE FROM
+		follow
+	WHERE
+		id = :id AND
+		user_id = :user_id
+`
+
+// queryGetFeed merges matches, news and threads relevant to
+// the follows of a user. Matches are included when the user
+// follows the game or one of the teams, while news and
+// threads are included when the user follows the game
+// directly or through one of its teams.
+//
+// The items are filtered by the first verb, and ordered by
+// time, type and reference ID in the direction of the second
+// verb.
+const queryGetFeed = `
+	WITH followed_team AS (
+		SELECT
+			target_id AS team_id
+		FROM
+			follow
+		WHERE
+			user_id = :user_id AND
+			follow_type = :follow_type_team
+	), followed_game AS (
+		SELECT
+			target_id AS game_id
+		FROM
+			follow
+		WHERE
+			user_id = :user_id AND
+			follow_type = :follow_type_game
+	), related_game AS (
+		SELECT
+			game_id
+		FROM
+			followed_game
+		UNION
+		SELECT
+			t.game_id
+		FROM
+			team t
+		INNER JOIN
+			followed_team ft
+		ON
+			t.id = ft.team_id
+	)
+	SELECT
+		f.item_type,
+		f.ref_id,
+		f.title,
+		f.description,
+		f.image,
+		f.game_id,
+		f.game_names,
+		f.game_icons,
+		f.item_time
+	FROM (
+		SELECT
+			CASE WHEN m.status = :status_completed THEN CAST(:item_type_result AS INTEGER) ELSE CAST(:item_type_match AS INTEGER) END AS item_type,
+			m.id AS ref_id,
+			t1.team_names || ' vs ' || t2.team_names AS title,
+			m.tournament_names AS description,
+			g.game_icons AS image,
+			m.game_id,
+			g.game_names,
+			g.game_icons,
+			m.date AS item_time
+		FROM
+			match m
+		INNER JOIN
+			game g
+		ON
+			m.game_id = g.id
+		INNER JOIN
+			team t1
+		ON
+			m.team_a_id = t1.id
+		INNER JOIN
+			team t2
+		ON
+			m.team_b_id = t2.id
+		WHERE
+			m.game_id IN (SELECT game_id FROM followed_game) OR
+			m.team_a_id IN (SELECT team_id FROM followed_team) OR
+			m.team_b_id IN (SELECT team_id FROM followed_team)
+		UNION ALL
+		SELECT
+			CAST(:item_type_news AS INTEGER) AS item_type,
+			n.id AS ref_id,
+			n.title,
+			n.description,
+			n.image_news AS image,
+			n.game_id,
+			g.game_names,
+			g.game_icons,
+			n.date AS item_time
+		FROM
+			news n
+		INNER JOIN
+			game g
+		ON
+			n.game_id = g.id
+		WHERE
+			n.game_id IN (SELECT game_id FROM related_game)
+		UNION ALL
+		SELECT
+			CAST(:item_type_thread AS INTEGER) AS item_type,
+			t.id AS ref_id,
+			t.title,
+			t.description,
+			t.image_thread AS image,
+			t.game_id,
+			g.game_names,
+			g.game_icons,
+			t.date AS item_time
+		FROM
+			thread t
+		INNER JOIN
+			game g
+		ON
+			t.game_id = g.id
+		WHERE
+			t.game_id IN (SELECT game_id FROM related_game)
+	) f
+	%[1]s
+	ORDER BY
+		f.item_time %[2]s,
+		f.item_type %[2]s,
+		f.ref_id %[2]s
+	LIMIT
+		:limit
+`
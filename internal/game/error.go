@@ -14,4 +14,12 @@ var (
 	// ErrInvalidGameID is returned when the given game id is
 	// invalid.
 	ErrInvalidGameID = errors.New("invalid game id")
+
+	// ErrGameNotFound is returned when the
+	// game with the given ID does not exist.
+	ErrGameNotFound = errors.New("game not found")
+
+	// ErrVersionConflict is returned when the game has
+	// been updated since the version the update is based on.
+	ErrVersionConflict = errors.New("game version conflict")
 )
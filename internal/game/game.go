@@ -10,6 +10,20 @@ type Service interface {
 	// created game ID.
 	CreateGame(ctx context.Context, game Game) (int64, error)
 
+	// CreateGames creates the given games in a single
+	// transaction and returns the created game IDs in the
+	// same order.
+	//
+	// The games are validated together and none of them is
+	// created if any of them is invalid. The violations are
+	// reported in a helper.ValidationError, with the index of
+	// the game prepended to the fields, e.g. "[2].game_icons".
+	CreateGames(ctx context.Context, games []Game) ([]int64, error)
+
+	// ValidateGame validates the given game the same way as
+	// CreateGame, without creating it.
+	ValidateGame(ctx context.Context, game Game) error
+
 	// GetAllGames returns all games.
 	GetAllGames(ctx context.Context) ([]Game, error)
 
@@ -17,14 +31,13 @@ type Service interface {
 	// game ID.
 	GetGameByID(ctx context.Context, gameID int64) (Game, error)
 
-	// UpdateGame updates existing game with the given
-	// game data.
+	// UpdateGame updates the fields set in the given game
+	// update of an existing game, and returns the updated
+	// game.
 	//
-	// UpdateGame do updates on all main attributes
-	// except ID, CreateBy, and CreateTime. So, make sure to
-	// use current values in the given data if do not want
-	// to update some specific attributes.
-	UpdateGame(ctx context.Context, game Game) error
+	// ErrVersionConflict is returned if the update has a
+	// version and the game has been updated since then.
+	UpdateGame(ctx context.Context, update GameUpdate) (Game, error)
 }
 
 type Game struct {
@@ -34,3 +47,28 @@ type Game struct {
 	CreateTime time.Time
 	UpdateTime time.Time
 }
+
+// GameUpdate is a partial update of an existing game, only
+// its non-nil fields are updated.
+type GameUpdate struct {
+	ID        int64
+	GameNames *string
+	GameIcons *string
+
+	// Version is the update time of the game the update is
+	// based on, zero value means no version check.
+	Version time.Time
+}
+
+// Apply returns the given game with the fields set in the
+// update applied.
+func (u GameUpdate) Apply(g Game) Game {
+	if u.GameNames != nil {
+		g.GameNames = *u.GameNames
+	}
+	if u.GameIcons != nil {
+		g.GameIcons = *u.GameIcons
+	}
+
+	return g
+}
@@ -2,24 +2,14 @@ package http
 
 import (
 	"errors"
+	"net/http"
 
+	"github.com/x-sports/global/helper"
 	"github.com/x-sports/internal/game"
 )
 
 // Followings are the known errors from Game HTTP handlers.
 var (
-	// errBadRequest is returned when the given request is
-	// bad/invalid.
-	errBadRequest = errors.New("BAD_REQUEST")
-
-	// errInternalServer is returned when there is an
-	// unexpected error encountered when processing a request.
-	errInternalServer = errors.New("INTERNAL_SERVER_ERROR")
-
-	// errInvalidToken is returned when the given token is
-	// invalid.
-	errInvalidToken = errors.New("INVALID_TOKEN")
-
 	// errInvalidGameID is returned when the given game id is
 	// invalid.
 	errInvalidGameID = errors.New("INVALID_GAME_ID")
@@ -36,18 +26,23 @@ var (
 	// allowed HTTP method.
 	errMethodNotAllowed = errors.New("METHOD_NOT_ALLOWED")
 
-	// errRequestTimeout is returned when processing time
-	// has reached the timeout limit.
-	errRequestTimeout = errors.New("REQUEST_TIMEOUT")
+	// errGameNotFound is returned when the
+	// game with the given ID does not exist.
+	errGameNotFound = errors.New("GAME_NOT_FOUND")
+
+	// errVersionConflict is returned when the game has
+	// been updated since the version in If-Match header.
+	errVersionConflict = errors.New("VERSION_CONFLICT")
 
-	// errUnauthorizedAccess is returned when the request
-	// is unaothorized.
-	errUnauthorizedAccess = errors.New("UNAUTHORIZED_ACCESS")
+	// errQueryTimeout is returned when a database query has
+	// reached its timeout limit.
+	errQueryTimeout = errors.New("QUERY_TIMEOUT")
 )
 
 var (
 	// mapHTTPError maps service error into HTTP error that
-	// categorize as bad request error.
+	// categorize as bad request error, unless its status code
+	// is set in mapHTTPStatus.
 	//
 	// Internal server error-related should not be mapped
 	// here, and the handler should just return `errInternal`
@@ -56,5 +51,26 @@ var (
 		game.ErrInvalidGameNames: errInvalidGameNames,
 		game.ErrInvalidGameIcons: errInvalidGameIcons,
 		game.ErrInvalidGameID:    errInvalidGameID,
+		game.ErrGameNotFound:     errGameNotFound,
+		game.ErrVersionConflict:  errVersionConflict,
+		helper.ErrQueryTimeout:   errQueryTimeout,
+	}
+
+	// mapHTTPStatus maps HTTP error into its status code when
+	// it is not a bad request error.
+	mapHTTPStatus = map[error]int{
+		errQueryTimeout:    http.StatusGatewayTimeout,
+		errGameNotFound:    http.StatusNotFound,
+		errVersionConflict: http.StatusConflict,
 	}
 )
+
+// newEndpoint returns helper.Endpoint of the handler with the
+// given name.
+func newEndpoint(name string) helper.Endpoint {
+	return helper.Endpoint{
+		Name:      "[Game HTTP][" + name + "]",
+		MapError:  mapHTTPError,
+		MapStatus: mapHTTPStatus,
+	}
+}
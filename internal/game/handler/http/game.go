@@ -2,21 +2,24 @@ package http
 
 import (
 	"context"
-	"encoding/json"
-	"io/ioutil"
-	"log"
+	"log/slog"
 	"net/http"
 	"strconv"
-	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/x-sports/global/helper"
 	"github.com/x-sports/internal/admin"
 	"github.com/x-sports/internal/game"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/news"
+	"github.com/x-sports/internal/team"
 )
 
 type gameHandler struct {
 	game  game.Service
+	team  team.Service
+	match match.Service
+	news  news.Service
 	admin admin.Service
 }
 
@@ -24,12 +27,14 @@ func (h *gameHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	gameID, err := strconv.ParseInt(vars["id"], 10, 64)
 	if err != nil {
-		log.Printf("[game HTTP][gameHandler] Failed to parse game ID. ID: %s. Err: %s\n", vars["id"], err.Error())
+		slog.InfoContext(r.Context(), "failed to parse game ID", slog.String("handler", "[game HTTP][gameHandler]"), slog.String("id", vars["id"]), slog.String("error", err.Error()))
 		helper.WriteErrorResponse(w, http.StatusBadRequest, []string{errInvalidGameID.Error()})
 		return
 	}
 
 	switch r.Method {
+	case http.MethodGet:
+		h.handleGetGameByID(w, r, gameID)
 	case http.MethodPatch:
 		h.handleUpdateGame(w, r, gameID)
 	default:
@@ -37,141 +42,108 @@ func (h *gameHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (h *gameHandler) handleUpdateGame(w http.ResponseWriter, r *http.Request, gameID int64) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
+func (h *gameHandler) handleGetGameByID(w http.ResponseWriter, r *http.Request, gameID int64) {
+	helper.Serve(w, r, newEndpoint("handleGetGameByID"), func(ctx context.Context) (helper.Versioned[gameDetailHTTP], error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			log.Printf("[game HTTP][handleUpdateGame] Failed to update game. gameID: %d, Err: %s\n", gameID, err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
+			return helper.Versioned[gameDetailHTTP]{}, err
 		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
 
-	// prepare channels for main go routine
-	resChan := make(chan struct{}, 1)
-	errChan := make(chan error, 1)
+		// TODO: add authorization flow with roles
 
-	go func() {
-		// read request body
-		body, err := ioutil.ReadAll(r.Body)
+		res, err := h.game.GetGameByID(ctx, gameID)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
+			return helper.Versioned[gameDetailHTTP]{}, err
 		}
 
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
+		// get teams of the game
+		teams, err := h.team.GetAllTeams(ctx, team.Filter{GameID: gameID})
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
+			return helper.Versioned[gameDetailHTTP]{}, err
 		}
 
-		// unmarshall body
-		request := gameHTTP{}
-		err = json.Unmarshal(body, &request)
+		// get nearest upcoming matches of the game
+		matches, err := h.match.GetAllMatchs(ctx, match.Filter{
+			GameID: gameID,
+			Status: match.StatusUpcoming,
+			Order:  match.OrderByDate,
+			Limit:  upcomingMatchLimit,
+		})
+		if err != nil {
+			return helper.Versioned[gameDetailHTTP]{}, err
+		}
+
+		// get latest news of the game
+		newss, err := h.news.GetAllNews(ctx, news.Filter{
+			GameID: gameID,
+			Limit:  latestNewsLimit,
+		})
+		if err != nil {
+			return helper.Versioned[gameDetailHTTP]{}, err
+		}
+
+		detail := gameDetail{
+			game:            res,
+			teams:           teams,
+			upcomingMatches: matches,
+			latestNews:      newss,
+		}
+
+		data, err := formatGameDetail(detail)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
+			return helper.Versioned[gameDetailHTTP]{}, err
 		}
 
+		return helper.Versioned[gameDetailHTTP]{Data: data, Version: res.UpdateTime, Embedded: detail.embedded()}, nil
+	})
+}
+
+func (h *gameHandler) handleUpdateGame(w http.ResponseWriter, r *http.Request, gameID int64) {
+	helper.Serve(w, r, newEndpoint("handleUpdateGame"), func(ctx context.Context) (helper.Versioned[int64], error) {
 		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleUpdateGame")
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return helper.Versioned[int64]{}, err
+		}
+
+		// get the version the update is based on
+		version, err := helper.ParseIfMatch(r)
 		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
+			return helper.Versioned[int64]{}, err
 		}
 
-		// get current curriculum
-		current, err := h.game.GetGameByID(ctx, gameID)
+		// decode request body
+		request := gameHTTP{}
+		err = helper.DecodeJSON(r, &request)
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[game HTTP][handleUpdateGame] Internal error from GetGameByID. gameID: %d. Err: %s\n", gameID, err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return helper.Versioned[int64]{}, err
 		}
 
 		// format HTTP request into service object
-		reqGame, err := parseGameFromUpdateRequest(request, current)
+		update, err := parseGameFromUpdateRequest(request)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- err
-			return
+			return helper.Versioned[int64]{}, helper.NewHTTPError(http.StatusBadRequest, err)
 		}
+		update.ID = gameID
+		update.Version = version
 
-		err = h.game.UpdateGame(ctx, reqGame)
+		res, err := h.game.UpdateGame(ctx, update)
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Game HTTP][handleUpdateGame] Internal error from UpdateGame. Err: %s\n", err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return helper.Versioned[int64]{}, err
 		}
 
-		resChan <- struct{}{}
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case <-resChan:
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: gameID,
-		})
-	}
+		return helper.Versioned[int64]{Data: gameID, Version: res.UpdateTime}, nil
+	})
 }
 
-// parseGameFromUpdateRequest returns game.game from the
-// given HTTP request object.
-func parseGameFromUpdateRequest(gh gameHTTP, current game.Game) (game.Game, error) {
-	result := current
-
-	if gh.GameNames != nil {
-		result.GameNames = *gh.GameNames
-	}
-
-	if gh.GameIcons != nil {
-		result.GameIcons = *gh.GameIcons
+// parseGameFromUpdateRequest returns game.GameUpdate from
+// the given HTTP request object, only the fields set in the
+// request are updated.
+func parseGameFromUpdateRequest(gh gameHTTP) (game.GameUpdate, error) {
+	result := game.GameUpdate{
+		GameNames: gh.GameNames,
+		GameIcons: gh.GameIcons,
 	}
 
 	return result, nil
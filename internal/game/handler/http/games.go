@@ -2,11 +2,7 @@ package http
 
 import (
 	"context"
-	"encoding/json"
-	"io/ioutil"
-	"log"
 	"net/http"
-	"time"
 
 	"github.com/x-sports/global/helper"
 	"github.com/x-sports/internal/admin"
@@ -30,202 +26,57 @@ func (h *gamesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *gamesHandler) handleGetAllGames(w http.ResponseWriter, r *http.Request) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		source     string          // stores request source
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
-		if err != nil {
-			log.Printf("[Game HTTP][handleGetAllGames] Failed to get all games. Source: %s, Err: %s\n", source, err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
-		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
-
-	// prepare channels for main go routine
-	resChan := make(chan []game.Game, 1)
-	errChan := make(chan error, 1)
-
-	go func() {
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
-		}
-
+	helper.Serve(w, r, newEndpoint("handleGetAllGames"), func(ctx context.Context) ([]gameHTTP, error) {
 		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleGetAllGames")
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
+			return nil, err
 		}
 
 		// TODO: add authorization flow with roles
 
 		res, err := h.game.GetAllGames(ctx)
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Game HTTP][handleGetAllGames] Internal error from GetAllGames. Err: %s\n", err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return nil, err
 		}
 
-		resChan <- res
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case res := <-resChan:
 		// format each games
 		games := make([]gameHTTP, 0)
 		for _, r := range res {
-			var g gameHTTP
-			g, err = formatGame(r)
+			g, err := formatGame(r)
 			if err != nil {
-				return
+				return nil, err
 			}
 			games = append(games, g)
 		}
 
-		// construct response data
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: games,
-		})
-	}
+		return games, nil
+	})
 }
 
 func (h *gamesHandler) handleCreateGame(w http.ResponseWriter, r *http.Request) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
-		if err != nil {
-			log.Printf("[game HTTP][handleCreateGame] Failed to create game. Err: %s\n", err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
-		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
-
-	// prepare channels for main go routine
-	resChan := make(chan int64, 1)
-	errChan := make(chan error, 1)
-
-	go func() {
-		// read request body
-		body, err := ioutil.ReadAll(r.Body)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
-		}
-
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
+	helper.Serve(w, r, newEndpoint("handleCreateGame"), func(ctx context.Context) (int64, error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
+			return 0, err
 		}
 
-		// unmarshall body
+		// decode request body
 		request := gameHTTP{}
-		err = json.Unmarshal(body, &request)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
-		}
-
-		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleCreateGame")
+		err = helper.DecodeJSON(r, &request)
 		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
+			return 0, err
 		}
 
 		// format HTTP request into service object
-		reqSGame, err := parseGameFromCreateRequest(request)
+		reqGame, err := parseGameFromCreateRequest(request)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- err
-			return
+			return 0, helper.NewHTTPError(http.StatusBadRequest, err)
 		}
 
-		// login
-		res, err := h.game.CreateGame(ctx, reqSGame)
-		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Game HTTP][handleCreateGame] Internal error from CreateGame. Err: %s\n", err.Error())
-			}
-
-			errChan <- parsedErr
-			return
-		}
-
-		resChan <- res
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case gameID := <-resChan:
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: gameID,
-		})
-	}
+		return h.game.CreateGame(ctx, reqGame)
+	})
 }
 
 // parseGameFromCreateRequest returns game.Game from the
@@ -1,6 +1,50 @@
 package http
 
-import "github.com/x-sports/internal/game"
+import (
+	"github.com/x-sports/internal/game"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/news"
+	"github.com/x-sports/internal/team"
+)
+
+// Followings are the maximum number of related data embedded
+// in a game detail.
+const (
+	upcomingMatchLimit = 5
+	latestNewsLimit    = 5
+)
+
+// gameDetail denotes a game along with its related data.
+type gameDetail struct {
+	game            game.Game
+	teams           []team.Team
+	upcomingMatches []match.Match
+	latestNews      []news.News
+}
+
+// embedded returns the update times of the records embedded
+// in the game detail, so that the detail is modified when any
+// of them is modified, removed, or added.
+func (gd gameDetail) embedded() []interface{} {
+	result := make([]interface{}, 0, 2*(len(gd.teams)+len(gd.upcomingMatches)+len(gd.latestNews))+3)
+
+	result = append(result, len(gd.teams))
+	for _, t := range gd.teams {
+		result = append(result, t.ID, t.UpdateTime)
+	}
+
+	result = append(result, len(gd.upcomingMatches))
+	for _, m := range gd.upcomingMatches {
+		result = append(result, m.ID, m.UpdateTime)
+	}
+
+	result = append(result, len(gd.latestNews))
+	for _, n := range gd.latestNews {
+		result = append(result, n.ID, n.UpdateTime)
+	}
+
+	return result
+}
 
 // formatGame formats the given game into the
 // respective HTTP-format object.
@@ -11,3 +55,80 @@ func formatGame(g game.Game) (gameHTTP, error) {
 		GameIcons: &g.GameIcons,
 	}, nil
 }
+
+// formatGameDetail formats the given game detail into the
+// respective HTTP-format object.
+func formatGameDetail(gd gameDetail) (gameDetailHTTP, error) {
+	g, err := formatGame(gd.game)
+	if err != nil {
+		return gameDetailHTTP{}, err
+	}
+
+	result := gameDetailHTTP{
+		gameHTTP:        g,
+		Teams:           make([]teamHTTP, 0, len(gd.teams)),
+		UpcomingMatches: make([]matchHTTP, 0, len(gd.upcomingMatches)),
+		LatestNews:      make([]newsHTTP, 0, len(gd.latestNews)),
+	}
+
+	for _, t := range gd.teams {
+		result.Teams = append(result.Teams, formatTeam(t))
+	}
+
+	for _, m := range gd.upcomingMatches {
+		result.UpcomingMatches = append(result.UpcomingMatches, formatMatch(m))
+	}
+
+	for _, n := range gd.latestNews {
+		result.LatestNews = append(result.LatestNews, formatNews(n))
+	}
+
+	return result, nil
+}
+
+// formatTeam formats the given team into the respective
+// HTTP-format object.
+func formatTeam(t team.Team) teamHTTP {
+	return teamHTTP{
+		ID:        &t.ID,
+		TeamNames: &t.TeamNames,
+		TeamIcons: &t.TeamIcons,
+	}
+}
+
+// formatMatch formats the given match into the respective
+// HTTP-format object.
+func formatMatch(m match.Match) matchHTTP {
+	date := m.Date.Format(dateFormat)
+	status := m.Status.String()
+
+	return matchHTTP{
+		ID:              &m.ID,
+		TournamentNames: &m.TournamentNames,
+		TeamAID:         &m.TeamAID,
+		TeamANames:      &m.TeamANames,
+		TeamAIcons:      &m.TeamAIcons,
+		TeamAOdds:       &m.TeamAOdds,
+		TeamBID:         &m.TeamBID,
+		TeamBNames:      &m.TeamBNames,
+		TeamBIcons:      &m.TeamBIcons,
+		TeamBOdds:       &m.TeamBOdds,
+		Date:            &date,
+		MatchLink:       &m.MatchLink,
+		Status:          &status,
+	}
+}
+
+// formatNews formats the given news into the respective
+// HTTP-format object.
+func formatNews(n news.News) newsHTTP {
+	date := n.Date.Format(dateFormat)
+
+	return newsHTTP{
+		ID:          &n.ID,
+		Title:       &n.Title,
+		Description: &n.Description,
+		ImageNews:   &n.ImageNews,
+		Date:        &date,
+	}
+}
@@ -7,16 +7,24 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/x-sports/internal/admin"
 	"github.com/x-sports/internal/game"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/news"
+	"github.com/x-sports/internal/team"
 )
 
 var (
 	errUnknownConfig = errors.New("unknown config name")
 )
 
+var dateFormat = "2006-01-02 15:04:05 -07:00"
+
 // Handler contains admin HTTP-handlers.
 type Handler struct {
 	handlers map[string]*handler
 	game     game.Service
+	team     team.Service
+	match    match.Service
+	news     news.Service
 	admin    admin.Service
 }
 
@@ -50,10 +58,13 @@ var (
 )
 
 // New creates a new Handler.
-func New(game game.Service, admin admin.Service, identities []HandlerIdentity) (*Handler, error) {
+func New(game game.Service, team team.Service, match match.Service, news news.Service, admin admin.Service, identities []HandlerIdentity) (*Handler, error) {
 	h := &Handler{
 		handlers: make(map[string]*handler),
 		game:     game,
+		team:     team,
+		match:    match,
+		news:     news,
 		admin:    admin,
 	}
 
@@ -86,6 +97,9 @@ func (h *Handler) createHTTPHandler(configName string) (http.Handler, error) {
 	case HandlerGame.Name:
 		httpHandler = &gameHandler{
 			game:  h.game,
+			team:  h.team,
+			match: h.match,
+			news:  h.news,
 			admin: h.admin,
 		}
 	case HandlerGames.Name:
@@ -102,7 +116,7 @@ func (h *Handler) createHTTPHandler(configName string) (http.Handler, error) {
 // Start starts all HTTP handlers.
 func (h *Handler) Start(multiplexer *mux.Router) error {
 	for _, handler := range h.handlers {
-		multiplexer.Handle(handler.identity.URL, handler.h)
+		multiplexer.Handle(handler.identity.URL, handler.h).Name(handler.identity.Name)
 	}
 	return nil
 }
@@ -114,3 +128,48 @@ type gameHTTP struct {
 	GameNames *string `json:"game_names"`
 	GameIcons *string `json:"game_icons"`
 }
+
+// gameDetailHTTP denotes a game along with its related data
+// in HTTP response body.
+type gameDetailHTTP struct {
+	gameHTTP
+	Teams           []teamHTTP  `json:"teams"`
+	UpcomingMatches []matchHTTP `json:"upcoming_matches"`
+	LatestNews      []newsHTTP  `json:"latest_news"`
+}
+
+// teamHTTP denotes team object of a game in HTTP response
+// body.
+type teamHTTP struct {
+	ID        *int64  `json:"id"`
+	TeamNames *string `json:"team_names"`
+	TeamIcons *string `json:"team_icons"`
+}
+
+// matchHTTP denotes match object of a game in HTTP response
+// body.
+type matchHTTP struct {
+	ID              *int64   `json:"id"`
+	TournamentNames *string  `json:"tournament_names"`
+	TeamAID         *int64   `json:"team_a_id"`
+	TeamANames      *string  `json:"team_a_names"`
+	TeamAIcons      *string  `json:"team_a_icons"`
+	TeamAOdds       *float32 `json:"team_a_odds"`
+	TeamBID         *int64   `json:"team_b_id"`
+	TeamBNames      *string  `json:"team_b_names"`
+	TeamBIcons      *string  `json:"team_b_icons"`
+	TeamBOdds       *float32 `json:"team_b_odds"`
+	Date            *string  `json:"date"`
+	MatchLink       *string  `json:"match_link"`
+	Status          *string  `json:"status"`
+}
+
+// newsHTTP denotes news object of a game in HTTP response
+// body.
+type newsHTTP struct {
+	ID          *int64  `json:"id"`
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	ImageNews   *string `json:"image_news"`
+	Date        *string `json:"date"`
+}
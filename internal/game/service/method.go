@@ -2,11 +2,18 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/global/tracing"
 	"github.com/x-sports/internal/game"
 )
 
 func (s *service) CreateGame(ctx context.Context, reqGame game.Game) (int64, error) {
+	ctx, span := tracing.Start(ctx, "game.Service.CreateGame")
+	defer span.End()
+
 	// validate field
 	err := validateGame(reqGame)
 	if err != nil {
@@ -15,22 +22,72 @@ func (s *service) CreateGame(ctx context.Context, reqGame game.Game) (int64, err
 
 	reqGame.CreateTime = s.timeNow()
 
-	// get pg store client without using transaction
-	pgStoreClient, err := s.pgStore.NewClient(false)
+	// create game in a transaction
+	var gameID int64
+	err = helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		var err error
+		gameID, err = pgStoreClient.CreateGame(ctx, reqGame)
+		return err
+	})
 	if err != nil {
 		return 0, err
 	}
 
-	gameID, err := pgStoreClient.CreateGame(ctx, reqGame)
+	return gameID, nil
+}
+
+func (s *service) CreateGames(ctx context.Context, reqGames []game.Game) ([]int64, error) {
+	ctx, span := tracing.Start(ctx, "game.Service.CreateGames")
+	defer span.End()
+
+	// validate all games, so that all of the invalid games
+	// are reported at once
+	var verr helper.ValidationError
+	for i, reqGame := range reqGames {
+		var itemErr *helper.ValidationError
+		if errors.As(validateGame(reqGame), &itemErr) {
+			verr.Merge(fmt.Sprintf("[%d].", i), itemErr)
+		}
+	}
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+
+	createTime := s.timeNow()
+
+	// create games in a transaction
+	gameIDs := make([]int64, 0, len(reqGames))
+	err := helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		for _, reqGame := range reqGames {
+			reqGame.CreateTime = createTime
+			gameID, err := pgStoreClient.CreateGame(ctx, reqGame)
+			if err != nil {
+				return err
+			}
+			gameIDs = append(gameIDs, gameID)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	return gameID, nil
+	return gameIDs, nil
+}
+
+func (s *service) ValidateGame(ctx context.Context, reqGame game.Game) error {
+	ctx, span := tracing.Start(ctx, "game.Service.ValidateGame")
+	defer span.End()
+
+	return validateGame(reqGame)
 }
 
 func (s *service) GetAllGames(ctx context.Context) ([]game.Game, error) {
-	// get pg store client using transaction
+	ctx, span := tracing.Start(ctx, "game.Service.GetAllGames")
+	defer span.End()
+
+	// get pg store client without using transaction
 	pgStoreClient, err := s.pgStore.NewClient(false)
 	if err != nil {
 		return nil, err
@@ -45,32 +102,56 @@ func (s *service) GetAllGames(ctx context.Context) ([]game.Game, error) {
 	return games, nil
 }
 
-func (s *service) UpdateGame(ctx context.Context, reqGame game.Game) error {
-	// validate field
-	err := validateGame(reqGame)
-	if err != nil {
-		return err
-	}
+func (s *service) UpdateGame(ctx context.Context, update game.GameUpdate) (game.Game, error) {
+	ctx, span := tracing.Start(ctx, "game.Service.UpdateGame")
+	defer span.End()
 
-	// modify fields
-	reqGame.UpdateTime = s.timeNow()
-
-	// get pg store client using transaction
-	pgStoreClient, err := s.pgStore.NewClient(false)
-	if err != nil {
-		return err
+	// validate game id
+	if update.ID <= 0 {
+		return game.Game{}, game.ErrInvalidGameID
 	}
 
-	// updates Game in pgstore
-	err = pgStoreClient.UpdateGame(ctx, reqGame)
-	if err != nil {
+	// updates game in pgstore in a transaction
+	var result game.Game
+	err := helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		// get game before the update to check its version
+		current, err := pgStoreClient.GetGameByID(ctx, update.ID)
+		if err != nil {
+			return err
+		}
+		if !update.Version.IsZero() && !update.Version.Equal(current.UpdateTime) {
+			return game.ErrVersionConflict
+		}
+
+		// validate the game with the update applied
+		err = validateGame(update.Apply(current))
+		if err != nil {
+			return err
+		}
+
+		// updates game in pgstore, unless it is updated since
+		// it is read
+		update.Version = current.UpdateTime
+		err = pgStoreClient.UpdateGame(ctx, update, s.timeNow())
+		if err != nil {
+			return err
+		}
+
+		// get updated game to have its update time
+		result, err = pgStoreClient.GetGameByID(ctx, update.ID)
 		return err
+	})
+	if err != nil {
+		return game.Game{}, err
 	}
 
-	return nil
+	return result, nil
 }
 
 func (s *service) GetGameByID(ctx context.Context, gameID int64) (game.Game, error) {
+	ctx, span := tracing.Start(ctx, "game.Service.GetGameByID")
+	defer span.End()
+
 	// get pg store client without using transaction
 	pgStoreClient, err := s.pgStore.NewClient(false)
 	if err != nil {
@@ -87,15 +168,18 @@ func (s *service) GetGameByID(ctx context.Context, gameID int64) (game.Game, err
 }
 
 // validateGame validates fields of the given Game
-// whether its comply the predetermined rules.
+// whether its comply the predetermined rules, all violations
+// are collected in a helper.ValidationError.
 func validateGame(reqGame game.Game) error {
+	var verr helper.ValidationError
+
 	if reqGame.GameNames == "" {
-		return game.ErrInvalidGameNames
+		verr.Add("game_names", helper.CodeRequired, game.ErrInvalidGameNames)
 	}
 
 	if reqGame.GameIcons == "" {
-		return game.ErrInvalidGameIcons
+		verr.Add("game_icons", helper.CodeRequired, game.ErrInvalidGameIcons)
 	}
 
-	return nil
+	return verr.Err()
 }
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/x-sports/internal/game"
 )
@@ -29,12 +30,12 @@ type PGStoreClient interface {
 	// game ID.
 	GetGameByID(ctx context.Context, gameID int64) (game.Game, error)
 
-	// UpdateGame updates existing game with the given
-	// game data.
+	// UpdateGame updates the fields set in the given game
+	// update of an existing game, and sets its update time
+	// to the given time.
 	//
-	// UpdateGame do updates on all main attributes
-	// except ID, CreateBy, and CreateTime. So, make sure to
-	// use current values in the given data if do not want
-	// to update some specific attributes.
-	UpdateGame(ctx context.Context, game game.Game) error
+	// The game is only updated if its update time equals
+	// to the update version, otherwise ErrVersionConflict is
+	// returned, or ErrGameNotFound if it does not exist.
+	UpdateGame(ctx context.Context, update game.GameUpdate, updateTime time.Time) error
 }
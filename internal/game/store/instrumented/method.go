@@ -0,0 +1,36 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/x-sports/internal/game"
+)
+
+func (sc *storeClient) CreateGame(ctx context.Context, game game.Game) (int64, error) {
+	ctx, done := observe(ctx, "CreateGame")
+	result, err := sc.next.CreateGame(ctx, game)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetAllGames(ctx context.Context) ([]game.Game, error) {
+	ctx, done := observe(ctx, "GetAllGames")
+	result, err := sc.next.GetAllGames(ctx)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetGameByID(ctx context.Context, gameID int64) (game.Game, error) {
+	ctx, done := observe(ctx, "GetGameByID")
+	result, err := sc.next.GetGameByID(ctx, gameID)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) UpdateGame(ctx context.Context, update game.GameUpdate, updateTime time.Time) error {
+	ctx, done := observe(ctx, "UpdateGame")
+	err := sc.next.UpdateGame(ctx, update, updateTime)
+	done(err)
+	return err
+}
@@ -0,0 +1,80 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/x-sports/internal/game"
+	"github.com/x-sports/internal/memory"
+)
+
+func (sc *storeClient) CreateGame(ctx context.Context, reqGame game.Game) (int64, error) {
+	var gameID int64
+	err := sc.c.Do(func(data *memory.Data) error {
+		gameID = data.Games.NextID()
+
+		// update time defaults to the create time
+		reqGame.ID = gameID
+		reqGame.CreateTime = memory.Time(reqGame.CreateTime)
+		reqGame.UpdateTime = reqGame.CreateTime
+		data.Games.Put(gameID, reqGame)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return gameID, nil
+}
+
+func (sc *storeClient) GetAllGames(ctx context.Context) ([]game.Game, error) {
+	var games []game.Game
+	err := sc.c.Do(func(data *memory.Data) error {
+		games = data.Games.All()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return games, nil
+}
+
+func (sc *storeClient) GetGameByID(ctx context.Context, gameID int64) (game.Game, error) {
+	var result game.Game
+	err := sc.c.Do(func(data *memory.Data) error {
+		g, ok := data.Games.Get(gameID)
+		if !ok {
+			return game.ErrGameNotFound
+		}
+
+		result = g
+		return nil
+	})
+	if err != nil {
+		return game.Game{}, err
+	}
+
+	return result, nil
+}
+
+func (sc *storeClient) UpdateGame(ctx context.Context, update game.GameUpdate, updateTime time.Time) error {
+	return sc.c.Do(func(data *memory.Data) error {
+		// the game is not updated when its update time has
+		// changed
+		g, ok := data.Games.Get(update.ID)
+		if !ok {
+			return game.ErrGameNotFound
+		}
+		if !g.UpdateTime.Equal(update.Version) {
+			return game.ErrVersionConflict
+		}
+
+		g = update.Apply(g)
+		g.UpdateTime = memory.Time(updateTime)
+		data.Games.Put(g.ID, g)
+
+		return nil
+	})
+}
@@ -2,13 +2,21 @@ package postgresql
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/x-sports/internal/game"
 )
 
 func (sc *storeClient) CreateGame(ctx context.Context, reqGame game.Game) (int64, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	// construct arguments filled with fields for the query
 	argsKV := map[string]interface{}{
 		"game_names":  reqGame.GameNames,
@@ -29,7 +37,7 @@ func (sc *storeClient) CreateGame(ctx context.Context, reqGame game.Game) (int64
 
 	// execute query
 	var gameID int64
-	err = sc.q.QueryRowx(query, args...).Scan(&gameID)
+	err = sc.q.QueryRowxContext(ctx, query, args...).Scan(&gameID)
 	if err != nil {
 		return 0, err
 	}
@@ -38,6 +46,10 @@ func (sc *storeClient) CreateGame(ctx context.Context, reqGame game.Game) (int64
 }
 
 func (sc *storeClient) GetAllGames(ctx context.Context) ([]game.Game, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	query := fmt.Sprintf(queryGetGames, "")
 
 	// prepare query
@@ -52,7 +64,7 @@ func (sc *storeClient) GetAllGames(ctx context.Context) ([]game.Game, error) {
 	query = sc.q.Rebind(query)
 
 	// query to database
-	rows, err := sc.q.Queryx(query, args...)
+	rows, err := sc.q.QueryxContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -77,18 +89,31 @@ func (sc *storeClient) GetAllGames(ctx context.Context) ([]game.Game, error) {
 	return games, nil
 }
 
-func (sc *storeClient) UpdateGame(ctx context.Context, reqGame game.Game) error {
+func (sc *storeClient) UpdateGame(ctx context.Context, update game.GameUpdate, updateTime time.Time) error {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	// construct arguments filled with fields for the query
 	argsKV := map[string]interface{}{
-		"id":          reqGame.ID,
-		"game_names":  reqGame.GameNames,
-		"game_icons":  reqGame.GameIcons,
-		"create_time": reqGame.CreateTime,
-		"update_time": reqGame.UpdateTime,
+		"id":          update.ID,
+		"version":     sql.NullTime{Time: update.Version, Valid: !update.Version.IsZero()},
+		"update_time": updateTime,
+	}
+
+	// only set the columns of the fields set in the update
+	sets := []string{"update_time = :update_time"}
+	if update.GameNames != nil {
+		sets = append(sets, "game_names = :game_names")
+		argsKV["game_names"] = *update.GameNames
+	}
+	if update.GameIcons != nil {
+		sets = append(sets, "game_icons = :game_icons")
+		argsKV["game_icons"] = *update.GameIcons
 	}
 
 	// prepare query
-	query, args, err := sqlx.Named(queryUpdateGame, argsKV)
+	query, args, err := sqlx.Named(fmt.Sprintf(queryUpdateGame, strings.Join(sets, ",\n\t\t")), argsKV)
 	if err != nil {
 		return err
 	}
@@ -99,16 +124,41 @@ func (sc *storeClient) UpdateGame(ctx context.Context, reqGame game.Game) error
 	query = sc.q.Rebind(query)
 
 	// execute query
-	_, err = sc.q.Exec(query, args...)
-	return err
+	res, err := sc.q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	// check whether the game is updated, it is not when
+	// it does not exist or its update time has changed
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		_, err = sc.GetGameByID(ctx, update.ID)
+		if err != nil {
+			return err
+		}
+		return game.ErrVersionConflict
+	}
+
+	return nil
 }
 
 func (sc *storeClient) GetGameByID(ctx context.Context, gameID int64) (game.Game, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	query := fmt.Sprintf(queryGetGames, "WHERE g.id = $1")
 
 	// query single row
 	var gdb gameDB
-	err := sc.q.QueryRowx(query, gameID).StructScan(&gdb)
+	err := sc.q.QueryRowxContext(ctx, query, gameID).StructScan(&gdb)
+	if errors.Is(err, sql.ErrNoRows) {
+		return game.Game{}, game.ErrGameNotFound
+	}
 	if err != nil {
 		return game.Game{}, err
 	}
@@ -6,10 +6,12 @@ const queryCreateGame = `
 	(
 		game_names,
 		game_icons,
-		create_time
+		create_time,
+		update_time
 	) VALUES (
 		:game_names,
 		:game_icons,
+		:create_time,
 		:create_time
 	)  RETURNING
 		id
@@ -31,10 +33,8 @@ const queryUpdateGame = `
 	UPDATE
 		game
 	SET
-		game_names = :game_names,
-		game_icons = :game_icons,
-		create_time = :create_time,
-		update_time = :update_time
+		%s
 	WHERE
-		id = :id
+		id = :id AND
+		update_time IS NOT DISTINCT FROM :version
 `
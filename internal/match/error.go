@@ -38,4 +38,32 @@ var (
 	// ErrInvalidMatchLink is returned whrn the given match link
 	// is invalid.
 	ErrInvalidMatchLink = errors.New("invalid match link")
+
+	// ErrMatchNotFound is returned when the
+	// match with the given ID does not exist.
+	ErrMatchNotFound = errors.New("match not found")
+
+	// ErrSameTeams is returned when both teams of a match
+	// are the same team.
+	ErrSameTeams = errors.New("teams must differ")
+
+	// ErrUnknownGame is returned when the game of a match
+	// does not exist.
+	ErrUnknownGame = errors.New("unknown game")
+
+	// ErrUnknownTeam is returned when a team of a match does
+	// not exist.
+	ErrUnknownTeam = errors.New("unknown team")
+
+	// ErrTeamNotInGame is returned when a team of a match
+	// does not belong to the match game.
+	ErrTeamNotInGame = errors.New("team not in game")
+
+	// ErrVersionConflict is returned when the match has
+	// been updated since the version the update is based on.
+	ErrVersionConflict = errors.New("match version conflict")
+
+	// ErrInvalidBulkSize is returned when the number of
+	// matches in a bulk request is zero or exceeds the limit.
+	ErrInvalidBulkSize = errors.New("invalid bulk size")
 )
@@ -0,0 +1,15 @@
+package match
+
+// Followings are the types of events recorded when a match
+// changes.
+const (
+	EventTypeCreated   = "match.created"
+	EventTypeUpdated   = "match.updated"
+	EventTypeCompleted = "match.completed"
+)
+
+// UpdatedEvent denotes data of a match updated event.
+type UpdatedEvent struct {
+	Previous Match
+	Current  Match
+}
@@ -0,0 +1,146 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/match"
+)
+
+type matchsBulkHandler struct {
+	match match.Service
+	admin admin.Service
+}
+
+func (h *matchsBulkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		h.handleCreateMatches(w, r)
+	case http.MethodPatch:
+		h.handleUpdateMatches(w, r)
+	default:
+		helper.WriteErrorResponse(w, http.StatusMethodNotAllowed, []string{errMethodNotAllowed.Error()})
+	}
+}
+
+func (h *matchsBulkHandler) handleCreateMatches(w http.ResponseWriter, r *http.Request) {
+	helper.Serve(w, r, newEndpoint("handleCreateMatches"), func(ctx context.Context) ([]int64, error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return nil, err
+		}
+
+		// decode request body
+		request := []matchHTTP{}
+		err = helper.DecodeJSON(r, &request)
+		if err != nil {
+			return nil, err
+		}
+
+		// format HTTP request into service objects, all of
+		// the malformed matches are reported at once
+		var verr helper.ValidationError
+		reqMatches := make([]match.Match, 0, len(request))
+		for i, mh := range request {
+			reqMatch, err := parseMatchFromCreateRequest(mh)
+			if err != nil {
+				addBulkParseError(&verr, i, err)
+				continue
+			}
+			reqMatches = append(reqMatches, reqMatch)
+		}
+		if err := verr.Err(); err != nil {
+			return nil, err
+		}
+
+		return h.match.CreateMatches(ctx, reqMatches)
+	})
+}
+
+func (h *matchsBulkHandler) handleUpdateMatches(w http.ResponseWriter, r *http.Request) {
+	helper.Serve(w, r, newEndpoint("handleUpdateMatches"), func(ctx context.Context) ([]int64, error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return nil, err
+		}
+
+		// decode request body
+		request := []matchBulkUpdateHTTP{}
+		err = helper.DecodeJSON(r, &request)
+		if err != nil {
+			return nil, err
+		}
+
+		// format HTTP request into service objects, all of
+		// the malformed updates are reported at once
+		var verr helper.ValidationError
+		updates := make([]match.MatchUpdate, 0, len(request))
+		for i, uh := range request {
+			update, err := parseMatchFromBulkUpdateRequest(uh)
+			if err != nil {
+				addBulkParseError(&verr, i, err)
+				continue
+			}
+			updates = append(updates, update)
+		}
+		if err := verr.Err(); err != nil {
+			return nil, err
+		}
+
+		res, err := h.match.UpdateMatches(ctx, updates)
+		if err != nil {
+			return nil, err
+		}
+
+		matchIDs := make([]int64, 0, len(res))
+		for _, m := range res {
+			matchIDs = append(matchIDs, m.ID)
+		}
+
+		return matchIDs, nil
+	})
+}
+
+// parseMatchFromBulkUpdateRequest returns match.MatchUpdate
+// from the given HTTP request object of a bulk update, which
+// only updates status and odds of a match.
+func parseMatchFromBulkUpdateRequest(uh matchBulkUpdateHTTP) (match.MatchUpdate, error) {
+	result := match.MatchUpdate{
+		TeamAOdds: uh.TeamAOdds,
+		TeamBOdds: uh.TeamBOdds,
+		Winner:    uh.Winner,
+	}
+
+	if uh.ID != nil {
+		result.ID = *uh.ID
+	}
+
+	if uh.Status != nil {
+		status, err := parseStatus(*uh.Status)
+		if err != nil {
+			return match.MatchUpdate{}, err
+		}
+		result.Status = &status
+	}
+
+	return result, nil
+}
+
+// addBulkParseError adds the error of parsing the match at
+// the given index of a bulk request into verr.
+func addBulkParseError(verr *helper.ValidationError, index int, err error) {
+	field, code := "", helper.CodeInvalidFormat
+	switch err {
+	case errInvalidTimeFormat:
+		field = ".date"
+	case errInvalidStatus:
+		field, code = ".status", helper.CodeInvalidValue
+	}
+
+	verr.Add(fmt.Sprintf("[%d]%s", index, field), code, err)
+}
@@ -2,24 +2,14 @@ package http
 
 import (
 	"errors"
+	"net/http"
 
+	"github.com/x-sports/global/helper"
 	"github.com/x-sports/internal/match"
 )
 
 // Followings are the known errors from Match HTTP handlers.
 var (
-	// errBadRequest is returned when the given request is
-	// bad/invalid.
-	errBadRequest = errors.New("BAD_REQUEST")
-
-	// errInternalServer is returned when there is an
-	// unexpected error encountered when processing a request.
-	errInternalServer = errors.New("INTERNAL_SERVER_ERROR")
-
-	// errInvalidToken is returned when the given token is
-	// invalid.
-	errInvalidToken = errors.New("INVALID_TOKEN")
-
 	// errInvalidTournamentNames is returned when the given tournament names is
 	// invalid.
 	errInvalidTournamentNames = errors.New("INVALID_TOURNAMENT_NAMES")
@@ -56,6 +46,22 @@ var (
 	// match link is invalid.
 	errInvalidMatchLink = errors.New("INVALID_MATCH_LINK")
 
+	// errSameTeams is returned when both teams of the given
+	// match are the same team.
+	errSameTeams = errors.New("TEAMS_MUST_DIFFER")
+
+	// errUnknownGame is returned when the game of the given
+	// match does not exist.
+	errUnknownGame = errors.New("UNKNOWN_GAME")
+
+	// errUnknownTeam is returned when a team of the given
+	// match does not exist.
+	errUnknownTeam = errors.New("UNKNOWN_TEAM")
+
+	// errTeamNotInGame is returned when a team of the given
+	// match does not belong to the match game.
+	errTeamNotInGame = errors.New("TEAM_NOT_IN_GAME")
+
 	// errInvalidTimeFormat is returned when the given time
 	// string format is invalid.
 	errInvalidTimeFormat = errors.New("INVALID_TIME_FORMAT")
@@ -64,18 +70,27 @@ var (
 	// allowed HTTP method.
 	errMethodNotAllowed = errors.New("METHOD_NOT_ALLOWED")
 
-	// errRequestTimeout is returned when processing time
-	// has reached the timeout limit.
-	errRequestTimeout = errors.New("REQUEST_TIMEOUT")
+	// errMatchNotFound is returned when the
+	// match with the given ID does not exist.
+	errMatchNotFound = errors.New("MATCH_NOT_FOUND")
+
+	// errVersionConflict is returned when the match has
+	// been updated since the version in If-Match header.
+	errVersionConflict = errors.New("VERSION_CONFLICT")
 
-	// errUnauthorizedAccess is returned when the request
-	// is unaothorized.
-	errUnauthorizedAccess = errors.New("UNAUTHORIZED_ACCESS")
+	// errInvalidBulkSize is returned when the number of
+	// matches in a bulk request is invalid.
+	errInvalidBulkSize = errors.New("INVALID_BULK_SIZE")
+
+	// errQueryTimeout is returned when a database query has
+	// reached its timeout limit.
+	errQueryTimeout = errors.New("QUERY_TIMEOUT")
 )
 
 var (
 	// mapHTTPError maps service error into HTTP error that
-	// categorize as bad request error.
+	// categorize as bad request error, unless its status code
+	// is set in mapHTTPStatus.
 	//
 	// Internal server error-related should not be mapped
 	// here, and the handler should just return `errInternal`
@@ -90,5 +105,31 @@ var (
 		match.ErrInvalidDate:            errInvalidDate,
 		match.ErrInvalidWinner:          errInvalidWinner,
 		match.ErrInvalidMatchLink:       errInvalidMatchLink,
+		match.ErrSameTeams:              errSameTeams,
+		match.ErrUnknownGame:            errUnknownGame,
+		match.ErrUnknownTeam:            errUnknownTeam,
+		match.ErrTeamNotInGame:          errTeamNotInGame,
+		match.ErrMatchNotFound:          errMatchNotFound,
+		match.ErrVersionConflict:        errVersionConflict,
+		match.ErrInvalidBulkSize:        errInvalidBulkSize,
+		helper.ErrQueryTimeout:          errQueryTimeout,
+	}
+
+	// mapHTTPStatus maps HTTP error into its status code when
+	// it is not a bad request error.
+	mapHTTPStatus = map[error]int{
+		errQueryTimeout:    http.StatusGatewayTimeout,
+		errMatchNotFound:   http.StatusNotFound,
+		errVersionConflict: http.StatusConflict,
 	}
 )
+
+// newEndpoint returns helper.Endpoint of the handler with the
+// given name.
+func newEndpoint(name string) helper.Endpoint {
+	return helper.Endpoint{
+		Name:      "[Match HTTP][" + name + "]",
+		MapError:  mapHTTPError,
+		MapStatus: mapHTTPStatus,
+	}
+}
@@ -42,7 +42,7 @@ var (
 	// with a match
 	HandlerMatch = HandlerIdentity{
 		Name: "match",
-		URL:  "/matchs/{id}",
+		URL:  "/matchs/{id:[0-9]+}",
 	}
 
 	// HandlerMatchs denotes HTTP handler to interact
@@ -51,6 +51,13 @@ var (
 		Name: "matchs",
 		URL:  "/matchs",
 	}
+
+	// HandlerMatchsBulk denotes HTTP handler to create or
+	// update matchs in bulk
+	HandlerMatchsBulk = HandlerIdentity{
+		Name: "matchs_bulk",
+		URL:  "/matchs/bulk",
+	}
 )
 
 // New creates a new Handler.
@@ -97,6 +104,11 @@ func (h *Handler) createHTTPHandler(configName string) (http.Handler, error) {
 			match: h.match,
 			admin: h.admin,
 		}
+	case HandlerMatchsBulk.Name:
+		httpHandler = &matchsBulkHandler{
+			match: h.match,
+			admin: h.admin,
+		}
 	default:
 		return httpHandler, errUnknownConfig
 	}
@@ -106,7 +118,7 @@ func (h *Handler) createHTTPHandler(configName string) (http.Handler, error) {
 // Start starts all HTTP handlers.
 func (h *Handler) Start(multiplexer *mux.Router) error {
 	for _, handler := range h.handlers {
-		multiplexer.Handle(handler.identity.URL, handler.h)
+		multiplexer.Handle(handler.identity.URL, handler.h).Name(handler.identity.Name)
 	}
 	return nil
 }
@@ -132,3 +144,13 @@ type matchHTTP struct {
 	Status          *string  `json:"status"`
 	Winner          *int64   `json:"winner"`
 }
+
+// matchBulkUpdateHTTP denotes an item of bulk match update in
+// HTTP request body.
+type matchBulkUpdateHTTP struct {
+	ID        *int64   `json:"id"`
+	TeamAOdds *float32 `json:"team_a_odds"`
+	TeamBOdds *float32 `json:"team_b_odds"`
+	Status    *string  `json:"status"`
+	Winner    *int64   `json:"winner"`
+}
@@ -2,9 +2,7 @@ package http
 
 import (
 	"context"
-	"encoding/json"
-	"io/ioutil"
-	"log"
+	"log/slog"
 	"net/http"
 	"strconv"
 	"time"
@@ -24,7 +22,7 @@ func (h *matchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	matchID, err := strconv.ParseInt(vars["id"], 10, 64)
 	if err != nil {
-		log.Printf("[Match HTTP][matchHandler] Failed to parse match ID. ID: %s. Err: %s\n", vars["id"], err.Error())
+		slog.InfoContext(r.Context(), "failed to parse match ID", slog.String("handler", "[Match HTTP][matchHandler]"), slog.String("id", vars["id"]), slog.String("error", err.Error()))
 		helper.WriteErrorResponse(w, http.StatusBadRequest, []string{errInvalidMatchID.Error()})
 		return
 	}
@@ -42,350 +40,113 @@ func (h *matchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *matchHandler) handleGetMatchByID(w http.ResponseWriter, r *http.Request, matchID int64) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
-		if err != nil {
-			log.Printf("[Match HTTP][handleGetMatchByID] Failed to get match by ID. matchID: %d, Err: %s\n", matchID, err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
-		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
-
-	// prepare channels for main go routine
-	resChan := make(chan match.Match, 1)
-	errChan := make(chan error, 1)
-
-	go func() {
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
-		}
-
+	helper.Serve(w, r, newEndpoint("handleGetMatchByID"), func(ctx context.Context) (helper.Versioned[matchHTTP], error) {
 		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleGetMatchByID")
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
+			return helper.Versioned[matchHTTP]{}, err
 		}
 
 		// TODO: add authorization flow with roles
 
 		res, err := h.match.GetMatchByID(ctx, matchID)
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Match HTTP][handleGetMatchByID] Internal error from GetMatchByID. matchID: %d. Err: %s\n", matchID, err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return helper.Versioned[matchHTTP]{}, err
 		}
 
-		resChan <- res
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case res := <-resChan:
-		// format curriculum
-		var m matchHTTP
-		m, err = formatMatch(res)
+		m, err := formatMatch(res)
 		if err != nil {
-			return
+			return helper.Versioned[matchHTTP]{}, err
 		}
-		// construct response data
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: m,
-		})
-	}
+
+		return helper.Versioned[matchHTTP]{Data: m, Version: res.UpdateTime}, nil
+	})
 }
 
 func (h *matchHandler) handleUpdateMatch(w http.ResponseWriter, r *http.Request, matchID int64) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
-		if err != nil {
-			log.Printf("[Match HTTP][handleUpdateMatch] Failed to update match. matchID: %d, Err: %s\n", matchID, err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
-		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
-
-	// prepare channels for main go routine
-	resChan := make(chan struct{}, 1)
-	errChan := make(chan error, 1)
-
-	go func() {
-		// read request body
-		body, err := ioutil.ReadAll(r.Body)
+	helper.Serve(w, r, newEndpoint("handleUpdateMatch"), func(ctx context.Context) (helper.Versioned[int64], error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
+			return helper.Versioned[int64]{}, err
 		}
 
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
+		// get the version the update is based on
+		version, err := helper.ParseIfMatch(r)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
+			return helper.Versioned[int64]{}, err
 		}
 
-		// unmarshall body
+		// decode request body
 		request := matchHTTP{}
-		err = json.Unmarshal(body, &request)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
-		}
-
-		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleUpdateMatch")
-		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
-		}
-
-		// get current curriculum
-		current, err := h.match.GetMatchByID(ctx, matchID)
+		err = helper.DecodeJSON(r, &request)
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Match HTTP][handleUpdateMatch] Internal error from GetMatchByID. matchID: %d. Err: %s\n", matchID, err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return helper.Versioned[int64]{}, err
 		}
 
 		// format HTTP request into service object
-		reqMatch, err := parseMatchFromUpdateRequest(request, current)
+		update, err := parseMatchFromUpdateRequest(request)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- err
-			return
+			return helper.Versioned[int64]{}, helper.NewHTTPError(http.StatusBadRequest, err)
 		}
+		update.ID = matchID
+		update.Version = version
 
-		err = h.match.UpdateMatch(ctx, reqMatch)
+		res, err := h.match.UpdateMatch(ctx, update)
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Match HTTP][handleUpdateMatch] Internal error from UpdateMatch. Err: %s\n", err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return helper.Versioned[int64]{}, err
 		}
 
-		resChan <- struct{}{}
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case <-resChan:
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: matchID,
-		})
-	}
+		return helper.Versioned[int64]{Data: matchID, Version: res.UpdateTime}, nil
+	})
 }
 
 func (h *matchHandler) handleDeleteMatchByID(w http.ResponseWriter, r *http.Request, matchID int64) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 2000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		source     string          // stores request source
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
-		if err != nil {
-			log.Printf("[Match HTTP][handleDeleteMatchByID] Failed to delete match. Source: %s, Err: %s\n", source, err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
-		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
-
-	// prepare channels for main go routine
-	resChan := make(chan struct{}, 1)
-	errChan := make(chan error, 1)
-
-	go func() {
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
-		}
-
+	helper.Serve(w, r, newEndpoint("handleDeleteMatchByID"), func(ctx context.Context) (int64, error) {
 		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleDeleteMatchByID")
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
+			return 0, err
 		}
 
-		// TODO: add authorization flow with roles
-
 		err = h.match.DeleteMatchByID(ctx, matchID)
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Match HTTP][handleDeleteMatchByID] Internal error from handleDeleteMatchByID. Err: %s\n", err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return 0, err
 		}
 
-		resChan <- struct{}{}
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case <-resChan:
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: matchID,
-		})
-	}
+		return matchID, nil
+	})
 }
 
-// parseMatchFromUpdateRequest returns match.Match from the
-// given HTTP request object.
-func parseMatchFromUpdateRequest(mh matchHTTP, current match.Match) (match.Match, error) {
-	result := current
-
-	if mh.TournamentNames != nil {
-		result.TournamentNames = *mh.TournamentNames
-	}
-
-	if mh.GameID != nil {
-		result.GameID = *mh.GameID
-	}
-
-	if mh.TeamAID != nil {
-		result.TeamAID = *mh.TeamAID
-	}
-
-	if mh.TeamBID != nil {
-		result.TeamBID = *mh.TeamBID
-	}
-
-	if mh.TeamAOdds != nil {
-		result.TeamAOdds = *mh.TeamAOdds
-	}
-
-	if mh.TeamBOdds != nil {
-		result.TeamBOdds = *mh.TeamBOdds
+// parseMatchFromUpdateRequest returns match.MatchUpdate from
+// the given HTTP request object, only the fields set in the
+// request are updated.
+func parseMatchFromUpdateRequest(mh matchHTTP) (match.MatchUpdate, error) {
+	result := match.MatchUpdate{
+		TournamentNames: mh.TournamentNames,
+		GameID:          mh.GameID,
+		TeamAID:         mh.TeamAID,
+		TeamBID:         mh.TeamBID,
+		TeamAOdds:       mh.TeamAOdds,
+		TeamBOdds:       mh.TeamBOdds,
+		MatchLink:       mh.MatchLink,
+		Winner:          mh.Winner,
 	}
 
 	if mh.Date != nil && *mh.Date != "" {
 		date, err := time.Parse(dateFormat, *mh.Date)
 		if err != nil {
-			return match.Match{}, errInvalidTimeFormat
+			return match.MatchUpdate{}, errInvalidTimeFormat
 		}
-		result.Date = date
+		result.Date = &date
 	}
 
 	if mh.Status != nil {
 		status, err := parseStatus(*mh.Status)
 		if err != nil {
-			return match.Match{}, err
+			return match.MatchUpdate{}, err
 		}
-		result.Status = status
-	}
-
-	if mh.MatchLink != nil {
-		result.MatchLink = *mh.MatchLink
-	}
-
-	if mh.Winner != nil {
-		result.Winner = *mh.Winner
+		result.Status = &status
 	}
 
 	return result, nil
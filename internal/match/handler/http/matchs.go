@@ -2,9 +2,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
-	"io/ioutil"
-	"log"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -32,103 +29,36 @@ func (h *matchsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *matchsHandler) handleGetAllMatchs(w http.ResponseWriter, r *http.Request) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		source     string          // stores request source
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
-		if err != nil {
-			log.Printf("[Match HTTP][handleGetAllMatchs] Failed to get all matchs. Source: %s, Err: %s\n", source, err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
-		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
-
-	// prepare channels for main go routine
-	resChan := make(chan []match.Match, 1)
-	errChan := make(chan error, 1)
-
-	go func() {
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
-		}
-
+	helper.Serve(w, r, newEndpoint("handleGetAllMatchs"), func(ctx context.Context) ([]matchHTTP, error) {
 		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleGetAllMatchs")
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
+			return nil, err
 		}
 
 		// parsed filter
 		gameID, status, err := parseGetMatchsFilter(r.URL.Query())
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- err
-			return
+			return nil, helper.NewHTTPError(http.StatusBadRequest, err)
 		}
 
-		res, err := h.match.GetAllMatchs(ctx, gameID, status)
+		res, err := h.match.GetAllMatchs(ctx, match.Filter{GameID: gameID, Status: status})
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Match HTTP][handleGetAllGames] Internal error from GetAllMatchs. Err: %s\n", err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return nil, err
 		}
 
-		resChan <- res
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case res := <-resChan:
 		// format each matchs
 		matchs := make([]matchHTTP, 0)
 		for _, r := range res {
-			var m matchHTTP
-			m, err = formatMatch(r)
+			m, err := formatMatch(r)
 			if err != nil {
-				return
+				return nil, err
 			}
 			matchs = append(matchs, m)
 		}
 
-		// construct response data
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: matchs,
-		})
-	}
+		return matchs, nil
+	})
 }
 
 func parseGetMatchsFilter(request url.Values) (int64, match.Status, error) {
@@ -155,107 +85,28 @@ func parseGetMatchsFilter(request url.Values) (int64, match.Status, error) {
 }
 
 func (h *matchsHandler) handleCreateMatch(w http.ResponseWriter, r *http.Request) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
-		if err != nil {
-			log.Printf("[Match HTTP][handleCreateMatch] Failed to create match. Err: %s\n", err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
-		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
-
-	// prepare channels for main go routine
-	resChan := make(chan int64, 1)
-	errChan := make(chan error, 1)
-
-	go func() {
-		// read request body
-		body, err := ioutil.ReadAll(r.Body)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
-		}
-
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
+	helper.Serve(w, r, newEndpoint("handleCreateMatch"), func(ctx context.Context) (int64, error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
+			return 0, err
 		}
 
-		// unmarshall body
+		// decode request body
 		request := matchHTTP{}
-		err = json.Unmarshal(body, &request)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
-		}
-
-		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleCreateMatch")
+		err = helper.DecodeJSON(r, &request)
 		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
+			return 0, err
 		}
 
 		// format HTTP request into service object
 		reqMatch, err := parseMatchFromCreateRequest(request)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- err
-			return
+			return 0, helper.NewHTTPError(http.StatusBadRequest, err)
 		}
 
-		res, err := h.match.CreateMatch(ctx, reqMatch)
-		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Match HTTP][handleCreateMatch] Internal error from CreateMatch. Err: %s\n", err.Error())
-			}
-
-			errChan <- parsedErr
-			return
-		}
-
-		resChan <- res
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case matchID := <-resChan:
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: matchID,
-		})
-	}
+		return h.match.CreateMatch(ctx, reqMatch)
+	})
 }
 
 // parseMatchFromCreateRequest returns match.Match from the
@@ -10,21 +10,48 @@ type Service interface {
 	// created Match ID.
 	CreateMatch(ctx context.Context, match Match) (int64, error)
 
-	// GetAllMatchs returns all matchs and filter by game id.
-	GetAllMatchs(ctx context.Context, gameID int64, status Status) ([]Match, error)
+	// ValidateMatch validates the given match the same way as
+	// CreateMatch, without creating it.
+	ValidateMatch(ctx context.Context, match Match) error
+
+	// GetAllMatchs returns all matchs that match the given
+	// filter, sorted by the filter order.
+	GetAllMatchs(ctx context.Context, filter Filter) ([]Match, error)
+
+	// GetTeamRecord returns the record of the given team ID
+	// from all of its completed matches.
+	GetTeamRecord(ctx context.Context, teamID int64) (Record, error)
 
 	// GetMatchByID returns a match with the given
 	// match ID.
 	GetMatchByID(ctx context.Context, matchID int64) (Match, error)
 
-	// UpdateMatch updates existing match with the given
-	// match data.
+	// UpdateMatch updates the fields set in the given match
+	// update of an existing match, and returns the updated
+	// match.
+	//
+	// ErrVersionConflict is returned if the update has a
+	// version and the match has been updated since then.
+	UpdateMatch(ctx context.Context, update MatchUpdate) (Match, error)
+
+	// CreateMatches creates the given matches in a single
+	// transaction and returns the created match IDs in the
+	// same order.
+	//
+	// The matches are validated together and none of them is
+	// created if any of them is invalid. The violations are
+	// reported in a helper.ValidationError, with the index of
+	// the match prepended to the fields, e.g. "[2].team_b_id".
+	CreateMatches(ctx context.Context, matches []Match) ([]int64, error)
+
+	// UpdateMatches applies the given match updates in a
+	// single transaction and returns the updated matches in
+	// the same order.
 	//
-	// UpdateMatch do updates on all main attributes
-	// except ID, CreateBy, and CreateTime. So, make sure to
-	// use current values in the given data if do not want
-	// to update some specific attributes.
-	UpdateMatch(ctx context.Context, match Match) error
+	// None of the matches is updated if any of the updates
+	// fails, the failures are reported the same way as
+	// CreateMatches.
+	UpdateMatches(ctx context.Context, updates []MatchUpdate) ([]Match, error)
 
 	// DeleteMatch delete a match
 	// with the given match id.
@@ -51,6 +78,113 @@ type Match struct {
 	Winner          int64
 	CreateTime      time.Time
 	UpdateTime      time.Time
+
+	// Revision is the number of updates of the match.
+	Revision int64
+}
+
+// MatchUpdate is a partial update of an existing match,
+// only its non-nil fields are updated.
+type MatchUpdate struct {
+	ID              int64
+	TournamentNames *string
+	GameID          *int64
+	TeamAID         *int64
+	TeamAOdds       *float32
+	TeamBID         *int64
+	TeamBOdds       *float32
+	Date            *time.Time
+	MatchLink       *string
+	Status          *Status
+	Winner          *int64
+
+	// Version is the update time of the match the update is
+	// based on, zero value means no version check.
+	Version time.Time
+}
+
+// Apply returns the given match with the fields set in the
+// update applied.
+func (u MatchUpdate) Apply(m Match) Match {
+	if u.TournamentNames != nil {
+		m.TournamentNames = *u.TournamentNames
+	}
+	if u.GameID != nil {
+		m.GameID = *u.GameID
+	}
+	if u.TeamAID != nil {
+		m.TeamAID = *u.TeamAID
+	}
+	if u.TeamAOdds != nil {
+		m.TeamAOdds = *u.TeamAOdds
+	}
+	if u.TeamBID != nil {
+		m.TeamBID = *u.TeamBID
+	}
+	if u.TeamBOdds != nil {
+		m.TeamBOdds = *u.TeamBOdds
+	}
+	if u.Date != nil {
+		m.Date = *u.Date
+	}
+	if u.MatchLink != nil {
+		m.MatchLink = *u.MatchLink
+	}
+	if u.Status != nil {
+		m.Status = *u.Status
+	}
+	if u.Winner != nil {
+		m.Winner = *u.Winner
+	}
+
+	return m
+}
+
+// Filter denotes the filter used to get matches, zero value
+// fields are not used to filter.
+type Filter struct {
+	// GameID filters matches of the given game.
+	GameID int64
+
+	// TeamID filters matches where the given team plays as
+	// either team.
+	TeamID int64
+
+	// Status filters matches with the given status.
+	Status Status
+
+	// TournamentNames filters matches of the given
+	// tournament, case insensitive.
+	TournamentNames string
+
+	// Order is the order of the matches.
+	Order Order
+
+	// Limit is the maximum number of matches, zero to get
+	// all matches.
+	Limit int
+}
+
+// Order denotes the order of matches.
+type Order int
+
+// Followings are the known orders.
+const (
+	OrderByID       Order = 0
+	OrderByDate     Order = 1
+	OrderByDateDesc Order = 2
+)
+
+// Record denotes the result of the completed matches of a
+// team.
+type Record struct {
+	Played int
+	Wins   int
+}
+
+// Losses returns the number of completed matches lost.
+func (r Record) Losses() int {
+	return r.Played - r.Wins
 }
 
 // Type denotes type of a status.
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/global/tracing"
+	"github.com/x-sports/internal/match"
+)
+
+func (s *service) CreateMatches(ctx context.Context, reqMatches []match.Match) ([]int64, error) {
+	ctx, span := tracing.Start(ctx, "match.Service.CreateMatches")
+	defer span.End()
+
+	// validate bulk size
+	if len(reqMatches) == 0 || len(reqMatches) > maxBulkSize {
+		return nil, match.ErrInvalidBulkSize
+	}
+
+	// validate all matches, so that all of the invalid
+	// matches are reported at once
+	var verr helper.ValidationError
+	for i, reqMatch := range reqMatches {
+		err := s.validateMatch(ctx, reqMatch)
+		if err != nil && !addBulkError(&verr, i, err) {
+			return nil, err
+		}
+	}
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+
+	// create matches and record the events in a transaction
+	matchIDs := make([]int64, 0, len(reqMatches))
+	err := helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		for _, reqMatch := range reqMatches {
+			matchID, err := s.createMatch(ctx, pgStoreClient, reqMatch)
+			if err != nil {
+				return err
+			}
+			matchIDs = append(matchIDs, matchID)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return matchIDs, nil
+}
+
+func (s *service) UpdateMatches(ctx context.Context, updates []match.MatchUpdate) ([]match.Match, error) {
+	ctx, span := tracing.Start(ctx, "match.Service.UpdateMatches")
+	defer span.End()
+
+	// validate bulk size
+	if len(updates) == 0 || len(updates) > maxBulkSize {
+		return nil, match.ErrInvalidBulkSize
+	}
+
+	// update matches and record the events in a transaction
+	results := make([]match.Match, 0, len(updates))
+	err := helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		var verr helper.ValidationError
+		for i, update := range updates {
+			updated, err := s.updateMatch(ctx, pgStoreClient, update)
+			if err != nil {
+				if !addBulkError(&verr, i, err) {
+					return err
+				}
+				continue
+			}
+			results = append(results, updated)
+		}
+
+		// roll back all of the updates if any of them fails
+		return verr.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// addBulkError adds the error of the match at the given index
+// of a bulk request into verr.
+//
+// It returns false if the error is not caused by the match,
+// e.g. a database error, which fails the whole request.
+func addBulkError(verr *helper.ValidationError, index int, err error) bool {
+	prefix := fmt.Sprintf("[%d].", index)
+
+	var itemErr *helper.ValidationError
+	switch {
+	case errors.As(err, &itemErr):
+		verr.Merge(prefix, itemErr)
+	case errors.Is(err, match.ErrInvalidMatchID):
+		verr.Add(prefix+"id", helper.CodeRequired, match.ErrInvalidMatchID)
+	case errors.Is(err, match.ErrMatchNotFound):
+		verr.Add(prefix+"id", helper.CodeNotFound, match.ErrMatchNotFound)
+	case errors.Is(err, match.ErrVersionConflict):
+		verr.Add(prefix+"id", helper.CodeConflict, match.ErrVersionConflict)
+	default:
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/game"
+	"github.com/x-sports/internal/match"
+)
+
+// GameLookup looks up the game referenced by a match, e.g.
+// game.Service.
+type GameLookup interface {
+	// GetGameByID returns a game with the given game ID.
+	GetGameByID(ctx context.Context, gameID int64) (game.Game, error)
+}
+
+// validateMatchGame validates the game of the given match
+// against the referenced game, which must exist. Violation
+// is added into the given verr, while the returned error is
+// only the error encountered when looking up the game.
+func (s *service) validateMatchGame(ctx context.Context, reqMatch match.Match, verr *helper.ValidationError) error {
+	// invalid game ID is already reported
+	if reqMatch.GameID <= 0 {
+		return nil
+	}
+
+	_, err := s.gameLookup.GetGameByID(ctx, reqMatch.GameID)
+	if errors.Is(err, game.ErrGameNotFound) {
+		verr.Add("game_id", helper.CodeNotFound, match.ErrUnknownGame)
+		return nil
+	}
+
+	return err
+}
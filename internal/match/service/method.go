@@ -3,25 +3,28 @@ package service
 import (
 	"context"
 
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/global/tracing"
 	"github.com/x-sports/internal/match"
 )
 
 func (s *service) CreateMatch(ctx context.Context, reqMatch match.Match) (int64, error) {
-	// validate field
-	err := validateMatch(reqMatch)
-	if err != nil {
-		return 0, err
-	}
-
-	reqMatch.CreateTime = s.timeNow()
+	ctx, span := tracing.Start(ctx, "match.Service.CreateMatch")
+	defer span.End()
 
-	// get pg store client without using transaction
-	pgStoreClient, err := s.pgStore.NewClient(false)
+	// validate field
+	err := s.validateMatch(ctx, reqMatch)
 	if err != nil {
 		return 0, err
 	}
 
-	matchID, err := pgStoreClient.CreateMatch(ctx, reqMatch)
+	// create match and record the event in a transaction
+	var matchID int64
+	err = helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		var err error
+		matchID, err = s.createMatch(ctx, pgStoreClient, reqMatch)
+		return err
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -29,7 +32,17 @@ func (s *service) CreateMatch(ctx context.Context, reqMatch match.Match) (int64,
 	return matchID, nil
 }
 
-func (s *service) GetAllMatchs(ctx context.Context, gameID int64, status match.Status) ([]match.Match, error) {
+func (s *service) ValidateMatch(ctx context.Context, reqMatch match.Match) error {
+	ctx, span := tracing.Start(ctx, "match.Service.ValidateMatch")
+	defer span.End()
+
+	return s.validateMatch(ctx, reqMatch)
+}
+
+func (s *service) GetAllMatchs(ctx context.Context, filter match.Filter) ([]match.Match, error) {
+	ctx, span := tracing.Start(ctx, "match.Service.GetAllMatchs")
+	defer span.End()
+
 	// get pg store client without using transaction
 	pgStoreClient, err := s.pgStore.NewClient(false)
 	if err != nil {
@@ -37,7 +50,7 @@ func (s *service) GetAllMatchs(ctx context.Context, gameID int64, status match.S
 	}
 
 	// get all match from postgre
-	match, err := pgStoreClient.GetAllMatchs(ctx, gameID, status)
+	match, err := pgStoreClient.GetAllMatchs(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -45,38 +58,47 @@ func (s *service) GetAllMatchs(ctx context.Context, gameID int64, status match.S
 	return match, nil
 }
 
-func (s *service) UpdateMatch(ctx context.Context, reqMatch match.Match) error {
-	// validate field
-	err := validateMatch(reqMatch)
-	if err != nil {
-		return err
-	}
-
-	// modify fields
-	reqMatch.UpdateTime = s.timeNow()
+func (s *service) GetTeamRecord(ctx context.Context, teamID int64) (match.Record, error) {
+	ctx, span := tracing.Start(ctx, "match.Service.GetTeamRecord")
+	defer span.End()
 
-	// get pg store client using transaction
+	// get pg store client without using transaction
 	pgStoreClient, err := s.pgStore.NewClient(false)
 	if err != nil {
-		return err
+		return match.Record{}, err
 	}
 
-	if reqMatch.Status == match.StatusCompleted {
-		if (reqMatch.Winner != reqMatch.TeamAID && reqMatch.Winner != reqMatch.TeamBID) || reqMatch.Winner <= 0 {
-			return match.ErrInvalidWinner
-		}
+	// count the record from postgre
+	result, err := pgStoreClient.GetTeamRecord(ctx, teamID)
+	if err != nil {
+		return match.Record{}, err
 	}
 
-	// updates match in pgstore
-	err = pgStoreClient.UpdateMatch(ctx, reqMatch)
-	if err != nil {
+	return result, nil
+}
+
+func (s *service) UpdateMatch(ctx context.Context, update match.MatchUpdate) (match.Match, error) {
+	ctx, span := tracing.Start(ctx, "match.Service.UpdateMatch")
+	defer span.End()
+
+	// update match and record the events in a transaction
+	var result match.Match
+	err := helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		var err error
+		result, err = s.updateMatch(ctx, pgStoreClient, update)
 		return err
+	})
+	if err != nil {
+		return match.Match{}, err
 	}
 
-	return nil
+	return result, nil
 }
 
 func (s *service) GetMatchByID(ctx context.Context, matchID int64) (match.Match, error) {
+	ctx, span := tracing.Start(ctx, "match.Service.GetMatchByID")
+	defer span.End()
+
 	// get pg store client without using transaction
 	pgStoreClient, err := s.pgStore.NewClient(false)
 	if err != nil {
@@ -93,64 +115,161 @@ func (s *service) GetMatchByID(ctx context.Context, matchID int64) (match.Match,
 }
 
 func (s *service) DeleteMatchByID(ctx context.Context, matchID int64) error {
+	ctx, span := tracing.Start(ctx, "match.Service.DeleteMatchByID")
+	defer span.End()
+
 	// validate match id
 	if matchID <= 0 {
 		return match.ErrInvalidMatchID
 	}
 
-	// get pg store client
-	pgStoreClient, err := s.pgStore.NewClient(false)
+	// delete match from pgstore in a transaction
+	return helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		return pgStoreClient.DeleteMatchByID(ctx, matchID)
+	})
+}
+
+// createMatch creates the given valid match and records the
+// event using the given store client.
+func (s *service) createMatch(ctx context.Context, pgStoreClient PGStoreClient, reqMatch match.Match) (int64, error) {
+	reqMatch.CreateTime = s.timeNow()
+
+	matchID, err := pgStoreClient.CreateMatch(ctx, reqMatch)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	// delete match from pgstore
-	err = pgStoreClient.DeleteMatchByID(ctx, matchID)
+	// get created match to have the derived fields in the
+	// event
+	created, err := pgStoreClient.GetMatchByID(ctx, matchID)
 	if err != nil {
-		return err
+		return 0, err
+	}
+
+	err = s.recordEvent(ctx, pgStoreClient, match.EventTypeCreated, created)
+	if err != nil {
+		return 0, err
 	}
 
-	return nil
+	return matchID, nil
+}
+
+// updateMatch validates and applies the given match update,
+// and records the events using the given store client.
+func (s *service) updateMatch(ctx context.Context, pgStoreClient PGStoreClient, update match.MatchUpdate) (match.Match, error) {
+	// validate match id
+	if update.ID <= 0 {
+		return match.Match{}, match.ErrInvalidMatchID
+	}
+
+	// get match before the update to check its version and
+	// record the status transition
+	previous, err := pgStoreClient.GetMatchByID(ctx, update.ID)
+	if err != nil {
+		return match.Match{}, err
+	}
+	if !update.Version.IsZero() && !update.Version.Equal(previous.UpdateTime) {
+		return match.Match{}, match.ErrVersionConflict
+	}
+
+	// validate the match with the update applied
+	err = s.validateMatch(ctx, update.Apply(previous))
+	if err != nil {
+		return match.Match{}, err
+	}
+
+	// updates match in pgstore, unless it is updated since it
+	// is read
+	update.Version = previous.UpdateTime
+	err = pgStoreClient.UpdateMatch(ctx, update, s.timeNow())
+	if err != nil {
+		return match.Match{}, err
+	}
+
+	// get updated match to have the derived fields in the
+	// event
+	current, err := pgStoreClient.GetMatchByID(ctx, update.ID)
+	if err != nil {
+		return match.Match{}, err
+	}
+
+	err = s.recordEvent(ctx, pgStoreClient, match.EventTypeUpdated, match.UpdatedEvent{
+		Previous: previous,
+		Current:  current,
+	})
+	if err != nil {
+		return match.Match{}, err
+	}
+
+	if previous.Status != match.StatusCompleted && current.Status == match.StatusCompleted {
+		err = s.recordEvent(ctx, pgStoreClient, match.EventTypeCompleted, current)
+		if err != nil {
+			return match.Match{}, err
+		}
+	}
+
+	return current, nil
 }
 
 // validateMatch validates fields of the given Match
-// whether its comply the predetermined rules.
-func validateMatch(reqMatch match.Match) error {
+// whether its comply the predetermined rules, all violations
+// are collected in a helper.ValidationError.
+func (s *service) validateMatch(ctx context.Context, reqMatch match.Match) error {
+	var verr helper.ValidationError
+
 	if reqMatch.TournamentNames == "" {
-		return match.ErrInvalidTournamentNames
+		verr.Add("tournament_names", helper.CodeRequired, match.ErrInvalidTournamentNames)
 	}
 
 	if reqMatch.GameID <= 0 {
-		return match.ErrInvalidGameID
+		verr.Add("game_id", helper.CodeRequired, match.ErrInvalidGameID)
 	}
 
 	if reqMatch.TeamAID <= 0 {
-		return match.ErrInvalidTeamID
+		verr.Add("team_a_id", helper.CodeRequired, match.ErrInvalidTeamID)
 	}
 
 	if reqMatch.TeamBID <= 0 {
-		return match.ErrInvalidTeamID
+		verr.Add("team_b_id", helper.CodeRequired, match.ErrInvalidTeamID)
 	}
 
 	if reqMatch.TeamAOdds <= 0 {
-		return match.ErrInvalidTeamOdds
+		verr.Add("team_a_odds", helper.CodeOutOfRange, match.ErrInvalidTeamOdds)
 	}
 
 	if reqMatch.TeamBOdds <= 0 {
-		return match.ErrInvalidTeamOdds
+		verr.Add("team_b_odds", helper.CodeOutOfRange, match.ErrInvalidTeamOdds)
 	}
 
 	if reqMatch.Date.IsZero() {
-		return match.ErrInvalidDate
+		verr.Add("date", helper.CodeRequired, match.ErrInvalidDate)
 	}
 
 	if _, valid := match.StatusList[reqMatch.Status]; !valid {
-		return match.ErrInvalidStatus
+		verr.Add("status", helper.CodeInvalidValue, match.ErrInvalidStatus)
 	}
 
 	if reqMatch.MatchLink == "" {
-		return match.ErrInvalidMatchLink
+		verr.Add("match_link", helper.CodeRequired, match.ErrInvalidMatchLink)
+	}
+
+	// completed match must be won by one of its teams
+	if reqMatch.Status == match.StatusCompleted {
+		if (reqMatch.Winner != reqMatch.TeamAID && reqMatch.Winner != reqMatch.TeamBID) || reqMatch.Winner <= 0 {
+			verr.Add("winner", helper.CodeInvalidValue, match.ErrInvalidWinner)
+		}
+	}
+
+	// validate the referenced game and teams
+	err := s.validateMatchGame(ctx, reqMatch, &verr)
+	if err != nil {
+		return err
+	}
+
+	err = s.validateMatchTeams(ctx, reqMatch, &verr)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return verr.Err()
 }
@@ -2,16 +2,24 @@ package service
 
 import "time"
 
+// maxBulkSize is the maximum number of matches in a bulk
+// request.
+const maxBulkSize = 100
+
 // New construts a new service.
 type service struct {
-	pgStore PGStore
-	timeNow func() time.Time
+	pgStore    PGStore
+	gameLookup GameLookup
+	teamLookup TeamLookup
+	timeNow    func() time.Time
 }
 
 // New returns a new service
-func New(pgStore PGStore) (*service, error) {
+func New(pgStore PGStore, gameLookup GameLookup, teamLookup TeamLookup) (*service, error) {
 	return &service{
-		pgStore: pgStore,
-		timeNow: time.Now,
+		pgStore:    pgStore,
+		gameLookup: gameLookup,
+		teamLookup: teamLookup,
+		timeNow:    time.Now,
 	}, nil
 }
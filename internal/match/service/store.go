@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/outbox"
 )
 
 // PGStore is the PostgreSQL store for admin service.
@@ -22,23 +24,32 @@ type PGStoreClient interface {
 	// created Match ID.
 	CreateMatch(ctx context.Context, match match.Match) (int64, error)
 
-	// GetAllMatchs returns all matchs and filter by game id.
-	GetAllMatchs(ctx context.Context, gameID int64, status match.Status) ([]match.Match, error)
+	// GetAllMatchs returns all matchs that match the given
+	// filter, sorted by the filter order.
+	GetAllMatchs(ctx context.Context, filter match.Filter) ([]match.Match, error)
+
+	// GetTeamRecord returns the record of the given team ID
+	// from all of its completed matches.
+	GetTeamRecord(ctx context.Context, teamID int64) (match.Record, error)
 
 	// GetMatchByID returns a match with the given
 	// match ID.
 	GetMatchByID(ctx context.Context, matchID int64) (match.Match, error)
 
-	// UpdateMatch updates existing match with the given
-	// match data.
+	// UpdateMatch updates the fields set in the given match
+	// update of an existing match, and sets its update time
+	// to the given time.
 	//
-	// UpdateMatch do updates on all main attributes
-	// except ID, CreateBy, and CreateTime. So, make sure to
-	// use current values in the given data if do not want
-	// to update some specific attributes.
-	UpdateMatch(ctx context.Context, match match.Match) error
+	// The match is only updated if its update time equals
+	// to the update version, otherwise ErrVersionConflict is
+	// returned, or ErrMatchNotFound if it does not exist.
+	UpdateMatch(ctx context.Context, update match.MatchUpdate, updateTime time.Time) error
 
 	// DeleteMatch delete a match
 	// with the given match id.
 	DeleteMatchByID(ctx context.Context, matchID int64) error
+
+	// CreateEvent records the given event in the outbox and
+	// return the created event ID.
+	CreateEvent(ctx context.Context, event outbox.Event) (int64, error)
 }
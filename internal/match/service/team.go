@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/team"
+)
+
+// TeamLookup looks up the teams referenced by a match, e.g.
+// team.Service.
+type TeamLookup interface {
+	// GetTeamByID returns a team with the given team ID.
+	GetTeamByID(ctx context.Context, teamID int64) (team.Team, error)
+}
+
+// validateMatchTeams validates the teams of the given match
+// against the referenced teams, both teams must exist,
+// differ, and belong to the match game. Violations are
+// added into the given verr, while the returned error is
+// only the error encountered when looking up the teams.
+func (s *service) validateMatchTeams(ctx context.Context, reqMatch match.Match, verr *helper.ValidationError) error {
+	if reqMatch.TeamAID > 0 && reqMatch.TeamAID == reqMatch.TeamBID {
+		verr.Add("team_b_id", helper.CodeConflict, match.ErrSameTeams)
+		return nil
+	}
+
+	teams := []struct {
+		field string
+		id    int64
+	}{
+		{field: "team_a_id", id: reqMatch.TeamAID},
+		{field: "team_b_id", id: reqMatch.TeamBID},
+	}
+
+	for _, t := range teams {
+		// invalid team ID is already reported
+		if t.id <= 0 {
+			continue
+		}
+
+		res, err := s.teamLookup.GetTeamByID(ctx, t.id)
+		if errors.Is(err, team.ErrTeamNotFound) {
+			verr.Add(t.field, helper.CodeNotFound, match.ErrUnknownTeam)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if reqMatch.GameID > 0 && res.GameID != reqMatch.GameID {
+			verr.Add(t.field, helper.CodeConflict, match.ErrTeamNotInGame)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+// Package instrumented provides the match store recording the
+// metrics and spans of another store.
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/x-sports/global/metrics"
+	"github.com/x-sports/global/tracing"
+	"github.com/x-sports/internal/match/service"
+)
+
+// storeName is the store label of the recorded metrics.
+const storeName = "match"
+
+// store implements match/service.PGStore
+type store struct {
+	next service.PGStore
+}
+
+// storeClient implements match/service.PGStoreClient
+type storeClient struct {
+	next  service.PGStoreClient
+	useTx bool
+
+	// events are the types of the events created in the
+	// transaction, which are counted once it is committed.
+	events []string
+}
+
+// New creates a new store recording the metrics and spans of
+// the given store.
+func New(next service.PGStore) (*store, error) {
+	s := &store{
+		next: next,
+	}
+
+	return s, nil
+}
+
+func (s *store) NewClient(useTx bool) (service.PGStoreClient, error) {
+	next, err := s.next.NewClient(useTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storeClient{
+		next:  next,
+		useTx: useTx,
+	}, nil
+}
+
+func (sc *storeClient) Commit() error {
+	start := time.Now()
+	err := sc.next.Commit()
+	metrics.ObserveQuery(storeName, "Commit", start, err)
+	if err == nil {
+		metrics.CountEvents(sc.events...)
+	}
+	return err
+}
+
+func (sc *storeClient) Rollback() error {
+	start := time.Now()
+	err := sc.next.Rollback()
+	metrics.ObserveQuery(storeName, "Rollback", start, err)
+	return err
+}
+
+// observe starts recording a call of the given method, in a
+// span being a child of the span in the given context. The
+// returned function ends the recording with the result of
+// the call.
+func observe(ctx context.Context, method string) (context.Context, func(err error)) {
+	start := time.Now()
+	ctx, span := tracing.Start(ctx, "match.PGStoreClient."+method)
+
+	return ctx, func(err error) {
+		tracing.End(span, err)
+		metrics.ObserveQuery(storeName, method, start, err)
+	}
+}
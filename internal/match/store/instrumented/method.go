@@ -0,0 +1,69 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/x-sports/global/metrics"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/outbox"
+)
+
+func (sc *storeClient) CreateMatch(ctx context.Context, match match.Match) (int64, error) {
+	ctx, done := observe(ctx, "CreateMatch")
+	result, err := sc.next.CreateMatch(ctx, match)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetAllMatchs(ctx context.Context, filter match.Filter) ([]match.Match, error) {
+	ctx, done := observe(ctx, "GetAllMatchs")
+	result, err := sc.next.GetAllMatchs(ctx, filter)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetTeamRecord(ctx context.Context, teamID int64) (match.Record, error) {
+	ctx, done := observe(ctx, "GetTeamRecord")
+	result, err := sc.next.GetTeamRecord(ctx, teamID)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetMatchByID(ctx context.Context, matchID int64) (match.Match, error) {
+	ctx, done := observe(ctx, "GetMatchByID")
+	result, err := sc.next.GetMatchByID(ctx, matchID)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) UpdateMatch(ctx context.Context, update match.MatchUpdate, updateTime time.Time) error {
+	ctx, done := observe(ctx, "UpdateMatch")
+	err := sc.next.UpdateMatch(ctx, update, updateTime)
+	done(err)
+	return err
+}
+
+func (sc *storeClient) DeleteMatchByID(ctx context.Context, matchID int64) error {
+	ctx, done := observe(ctx, "DeleteMatchByID")
+	err := sc.next.DeleteMatchByID(ctx, matchID)
+	done(err)
+	return err
+}
+
+func (sc *storeClient) CreateEvent(ctx context.Context, event outbox.Event) (int64, error) {
+	ctx, done := observe(ctx, "CreateEvent")
+	result, err := sc.next.CreateEvent(ctx, event)
+	done(err)
+
+	// events created in a transaction may still be rolled back
+	if err == nil {
+		if sc.useTx {
+			sc.events = append(sc.events, event.Type)
+		} else {
+			metrics.CountEvents(event.Type)
+		}
+	}
+
+	return result, err
+}
@@ -0,0 +1,39 @@
+package memory
+
+import (
+	"github.com/x-sports/internal/match/service"
+	"github.com/x-sports/internal/memory"
+)
+
+// store implements match/service.PGStore
+type store struct {
+	db *memory.DB
+}
+
+// storeClient implements match/service.PGStoreClient
+type storeClient struct {
+	c *memory.Client
+}
+
+// New creates a new store.
+func New(db *memory.DB) (*store, error) {
+	s := &store{
+		db: db,
+	}
+
+	return s, nil
+}
+
+func (s *store) NewClient(useTx bool) (service.PGStoreClient, error) {
+	return &storeClient{
+		c: s.db.NewClient(useTx),
+	}, nil
+}
+
+func (sc *storeClient) Commit() error {
+	return sc.c.Commit()
+}
+
+func (sc *storeClient) Rollback() error {
+	return sc.c.Rollback()
+}
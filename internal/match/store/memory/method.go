@@ -0,0 +1,213 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/memory"
+	"github.com/x-sports/internal/outbox"
+	outboxmemstore "github.com/x-sports/internal/outbox/store/memory"
+)
+
+func (sc *storeClient) CreateMatch(ctx context.Context, reqMatch match.Match) (int64, error) {
+	var matchID int64
+	err := sc.c.Do(func(data *memory.Data) error {
+		matchID = data.Matches.NextID()
+
+		// update time defaults to the create time, winner is
+		// not set on create, names and icons are derived when
+		// read
+		data.Matches.Put(matchID, match.Match{
+			ID:              matchID,
+			TournamentNames: reqMatch.TournamentNames,
+			GameID:          reqMatch.GameID,
+			TeamAID:         reqMatch.TeamAID,
+			TeamAOdds:       reqMatch.TeamAOdds,
+			TeamBID:         reqMatch.TeamBID,
+			TeamBOdds:       reqMatch.TeamBOdds,
+			Date:            memory.Time(reqMatch.Date),
+			MatchLink:       reqMatch.MatchLink,
+			Status:          reqMatch.Status,
+			CreateTime:      memory.Time(reqMatch.CreateTime),
+			UpdateTime:      memory.Time(reqMatch.CreateTime),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return matchID, nil
+}
+
+func (sc *storeClient) GetAllMatchs(ctx context.Context, filter match.Filter) ([]match.Match, error) {
+	matchs := make([]match.Match, 0)
+	err := sc.c.Do(func(data *memory.Data) error {
+		for _, m := range data.Matches.All() {
+			m, ok := join(data, m)
+			if !ok {
+				continue
+			}
+			if filter.GameID > 0 && m.GameID != filter.GameID {
+				continue
+			}
+			if filter.TeamID > 0 && m.TeamAID != filter.TeamID && m.TeamBID != filter.TeamID {
+				continue
+			}
+			if filter.Status > 0 && m.Status != filter.Status {
+				continue
+			}
+			if tournament := strings.TrimSpace(filter.TournamentNames); tournament != "" && !strings.EqualFold(strings.TrimSpace(m.TournamentNames), tournament) {
+				continue
+			}
+
+			matchs = append(matchs, m)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// sort by date when requested, the ID keeps the order
+	// stable
+	sort.SliceStable(matchs, func(i, j int) bool {
+		if !matchs[i].Date.Equal(matchs[j].Date) {
+			switch filter.Order {
+			case match.OrderByDate:
+				return matchs[i].Date.Before(matchs[j].Date)
+			case match.OrderByDateDesc:
+				return matchs[i].Date.After(matchs[j].Date)
+			}
+		}
+		return matchs[i].ID < matchs[j].ID
+	})
+
+	if filter.Limit > 0 && len(matchs) > filter.Limit {
+		matchs = matchs[:filter.Limit]
+	}
+
+	return matchs, nil
+}
+
+func (sc *storeClient) GetTeamRecord(ctx context.Context, teamID int64) (match.Record, error) {
+	var result match.Record
+	err := sc.c.Do(func(data *memory.Data) error {
+		for _, m := range data.Matches.All() {
+			if m.Status != match.StatusCompleted {
+				continue
+			}
+			if m.TeamAID != teamID && m.TeamBID != teamID {
+				continue
+			}
+
+			result.Played++
+			if m.Winner == teamID {
+				result.Wins++
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return match.Record{}, err
+	}
+
+	return result, nil
+}
+
+func (sc *storeClient) GetMatchByID(ctx context.Context, matchID int64) (match.Match, error) {
+	var result match.Match
+	err := sc.c.Do(func(data *memory.Data) error {
+		m, ok := data.Matches.Get(matchID)
+		if ok {
+			m, ok = join(data, m)
+		}
+		if !ok {
+			return match.ErrMatchNotFound
+		}
+
+		result = m
+		return nil
+	})
+	if err != nil {
+		return match.Match{}, err
+	}
+
+	return result, nil
+}
+
+func (sc *storeClient) UpdateMatch(ctx context.Context, update match.MatchUpdate, updateTime time.Time) error {
+	return sc.c.Do(func(data *memory.Data) error {
+		// the match is not updated when its update time has
+		// changed
+		m, ok := data.Matches.Get(update.ID)
+		if !ok {
+			return match.ErrMatchNotFound
+		}
+		if !m.UpdateTime.Equal(update.Version) {
+			return match.ErrVersionConflict
+		}
+
+		m = update.Apply(m)
+		m.Date = memory.Time(m.Date)
+		m.UpdateTime = memory.Time(updateTime)
+		m.Revision++
+		data.Matches.Put(m.ID, m)
+
+		return nil
+	})
+}
+
+func (sc *storeClient) DeleteMatchByID(ctx context.Context, matchID int64) error {
+	return sc.c.Do(func(data *memory.Data) error {
+		if !data.Matches.Delete(matchID) {
+			return match.ErrMatchNotFound
+		}
+		return nil
+	})
+}
+
+func (sc *storeClient) CreateEvent(ctx context.Context, reqEvent outbox.Event) (int64, error) {
+	var eventID int64
+	err := sc.c.Do(func(data *memory.Data) error {
+		eventID = outboxmemstore.CreateEvent(data, reqEvent)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return eventID, nil
+}
+
+// join returns the given match with the names and icons of
+// its game and teams, and false if any of them does not
+// exist.
+func join(data *memory.Data, m match.Match) (match.Match, bool) {
+	g, ok := data.Games.Get(m.GameID)
+	if !ok {
+		return match.Match{}, false
+	}
+	teamA, ok := data.Teams.Get(m.TeamAID)
+	if !ok {
+		return match.Match{}, false
+	}
+	teamB, ok := data.Teams.Get(m.TeamBID)
+	if !ok {
+		return match.Match{}, false
+	}
+
+	m.GameNames = g.GameNames
+	m.GameIcons = g.GameIcons
+	m.TeamANames = teamA.TeamNames
+	m.TeamAIcons = teamA.TeamIcons
+	m.TeamBNames = teamB.TeamNames
+	m.TeamBIcons = teamB.TeamIcons
+	return m, true
+}
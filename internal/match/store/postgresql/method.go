@@ -2,14 +2,23 @@ package postgresql
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/outbox"
+	outboxpgstore "github.com/x-sports/internal/outbox/store/postgresql"
 )
 
 func (sc *storeClient) CreateMatch(ctx context.Context, reqMatch match.Match) (int64, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	// construct arguments filled with fields for the query
 	argsKV := map[string]interface{}{
 		"tournament_names": reqMatch.TournamentNames,
@@ -43,7 +52,7 @@ func (sc *storeClient) CreateMatch(ctx context.Context, reqMatch match.Match) (i
 
 	// execute query
 	var matchID int64
-	err = sc.q.QueryRowx(query, args...).Scan(&matchID)
+	err = sc.q.QueryRowxContext(ctx, query, args...).Scan(&matchID)
 	if err != nil {
 		return 0, err
 	}
@@ -51,19 +60,42 @@ func (sc *storeClient) CreateMatch(ctx context.Context, reqMatch match.Match) (i
 	return matchID, nil
 }
 
-func (sc *storeClient) GetAllMatchs(ctx context.Context, gameID int64, status match.Status) ([]match.Match, error) {
+func (sc *storeClient) GetAllMatchs(ctx context.Context, filter match.Filter) ([]match.Match, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	// define variables to custom query
 	argsKV := make(map[string]interface{})
 	addConditions := make([]string, 0)
 
-	if gameID > 0 {
+	orderBy := "m.id"
+
+	if filter.GameID > 0 {
 		addConditions = append(addConditions, "m.game_id = :game_id")
-		argsKV["game_id"] = gameID
+		argsKV["game_id"] = filter.GameID
+	}
+
+	if filter.TeamID > 0 {
+		addConditions = append(addConditions, "(m.team_a_id = :team_id OR m.team_b_id = :team_id)")
+		argsKV["team_id"] = filter.TeamID
 	}
 
-	if status > 0 {
+	if filter.Status > 0 {
 		addConditions = append(addConditions, "m.status = :status")
-		argsKV["status"] = status
+		argsKV["status"] = filter.Status
+	}
+
+	if tournament := strings.TrimSpace(filter.TournamentNames); tournament != "" {
+		addConditions = append(addConditions, "LOWER(TRIM(m.tournament_names)) = LOWER(:tournament_names)")
+		argsKV["tournament_names"] = tournament
+	}
+
+	switch filter.Order {
+	case match.OrderByDate:
+		orderBy = "m.date, m.id"
+	case match.OrderByDateDesc:
+		orderBy = "m.date DESC, m.id"
 	}
 
 	// construct strings to custom query
@@ -74,6 +106,12 @@ func (sc *storeClient) GetAllMatchs(ctx context.Context, gameID int64, status ma
 	if len(addConditions) > 0 {
 		addCondition = fmt.Sprintf("WHERE %s", addCondition)
 	}
+	addCondition = fmt.Sprintf("%s ORDER BY %s", addCondition, orderBy)
+
+	if filter.Limit > 0 {
+		addCondition = fmt.Sprintf("%s LIMIT :limit", addCondition)
+		argsKV["limit"] = filter.Limit
+	}
 	query := fmt.Sprintf(queryGetMatchs, addCondition)
 
 	// prepare query
@@ -88,7 +126,7 @@ func (sc *storeClient) GetAllMatchs(ctx context.Context, gameID int64, status ma
 	query = sc.q.Rebind(query)
 
 	// query to database
-	rows, err := sc.q.Queryx(query, args...)
+	rows, err := sc.q.QueryxContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -113,32 +151,78 @@ func (sc *storeClient) GetAllMatchs(ctx context.Context, gameID int64, status ma
 	return match, nil
 }
 
-func (sc *storeClient) UpdateMatch(ctx context.Context, reqMatch match.Match) error {
+func (sc *storeClient) GetTeamRecord(ctx context.Context, teamID int64) (match.Record, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// query to database
+	var row recordDB
+	err := sc.q.QueryRowxContext(ctx, queryGetTeamRecord, teamID, match.StatusCompleted).StructScan(&row)
+	if err != nil {
+		return match.Record{}, err
+	}
+
+	return row.format(), nil
+}
+
+func (sc *storeClient) UpdateMatch(ctx context.Context, update match.MatchUpdate, updateTime time.Time) error {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	// construct arguments filled with fields for the query
 	argsKV := map[string]interface{}{
-		"id":               reqMatch.ID,
-		"tournament_names": reqMatch.TournamentNames,
-		"game_id":          reqMatch.GameID,
-		"game_names":       reqMatch.GameNames,
-		"game_icons":       reqMatch.GameIcons,
-		"team_a_id":        reqMatch.TeamAID,
-		"team_a_names":     reqMatch.TeamANames,
-		"team_a_icons":     reqMatch.TeamAIcons,
-		"team_a_odds":      reqMatch.TeamAOdds,
-		"team_b_id":        reqMatch.TeamBID,
-		"team_b_names":     reqMatch.TeamBNames,
-		"team_b_icons":     reqMatch.TeamBIcons,
-		"team_b_odds":      reqMatch.TeamBOdds,
-		"date":             reqMatch.Date,
-		"match_link":       reqMatch.MatchLink,
-		"status":           reqMatch.Status,
-		"winner":           reqMatch.Winner,
-		"create_time":      reqMatch.CreateTime,
-		"update_time":      reqMatch.UpdateTime,
+		"id":          update.ID,
+		"version":     sql.NullTime{Time: update.Version, Valid: !update.Version.IsZero()},
+		"update_time": updateTime,
+	}
+
+	// only set the columns of the fields set in the update
+	sets := []string{"update_time = :update_time", "revision = revision + 1"}
+	if update.TournamentNames != nil {
+		sets = append(sets, "tournament_names = :tournament_names")
+		argsKV["tournament_names"] = *update.TournamentNames
+	}
+	if update.GameID != nil {
+		sets = append(sets, "game_id = :game_id")
+		argsKV["game_id"] = *update.GameID
+	}
+	if update.TeamAID != nil {
+		sets = append(sets, "team_a_id = :team_a_id")
+		argsKV["team_a_id"] = *update.TeamAID
+	}
+	if update.TeamAOdds != nil {
+		sets = append(sets, "team_a_odds = :team_a_odds")
+		argsKV["team_a_odds"] = *update.TeamAOdds
+	}
+	if update.TeamBID != nil {
+		sets = append(sets, "team_b_id = :team_b_id")
+		argsKV["team_b_id"] = *update.TeamBID
+	}
+	if update.TeamBOdds != nil {
+		sets = append(sets, "team_b_odds = :team_b_odds")
+		argsKV["team_b_odds"] = *update.TeamBOdds
+	}
+	if update.Date != nil {
+		sets = append(sets, "date = :date")
+		argsKV["date"] = *update.Date
+	}
+	if update.MatchLink != nil {
+		sets = append(sets, "match_link = :match_link")
+		argsKV["match_link"] = *update.MatchLink
+	}
+	if update.Status != nil {
+		sets = append(sets, "status = :status")
+		argsKV["status"] = *update.Status
+	}
+	if update.Winner != nil {
+		sets = append(sets, "winner = :winner")
+		argsKV["winner"] = *update.Winner
 	}
 
 	// prepare query
-	query, args, err := sqlx.Named(queryUpdateMatch, argsKV)
+	query, args, err := sqlx.Named(fmt.Sprintf(queryUpdateMatch, strings.Join(sets, ",\n\t\t")), argsKV)
 	if err != nil {
 		return err
 	}
@@ -149,16 +233,41 @@ func (sc *storeClient) UpdateMatch(ctx context.Context, reqMatch match.Match) er
 	query = sc.q.Rebind(query)
 
 	// execute query
-	_, err = sc.q.Exec(query, args...)
-	return err
+	res, err := sc.q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	// check whether the match is updated, it is not when
+	// it does not exist or its update time has changed
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		_, err = sc.GetMatchByID(ctx, update.ID)
+		if err != nil {
+			return err
+		}
+		return match.ErrVersionConflict
+	}
+
+	return nil
 }
 
 func (sc *storeClient) GetMatchByID(ctx context.Context, matchID int64) (match.Match, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	query := fmt.Sprintf(queryGetMatchs, "WHERE m.id = $1")
 
 	// query single row
 	var mdb matchDB
-	err := sc.q.QueryRowx(query, matchID).StructScan(&mdb)
+	err := sc.q.QueryRowxContext(ctx, query, matchID).StructScan(&mdb)
+	if errors.Is(err, sql.ErrNoRows) {
+		return match.Match{}, match.ErrMatchNotFound
+	}
 	if err != nil {
 		return match.Match{}, err
 	}
@@ -167,6 +276,10 @@ func (sc *storeClient) GetMatchByID(ctx context.Context, matchID int64) (match.M
 }
 
 func (sc *storeClient) DeleteMatchByID(ctx context.Context, matchID int64) error {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	// construct arguments filled with fields for the query
 	argsKV := map[string]interface{}{
 		"id": matchID,
@@ -184,10 +297,27 @@ func (sc *storeClient) DeleteMatchByID(ctx context.Context, matchID int64) error
 	query = sc.q.Rebind(query)
 
 	// execute query
-	_, err = sc.q.Exec(query, args...)
+	res, err := sc.q.ExecContext(ctx, query, args...)
 	if err != nil {
 		return err
 	}
 
+	// check whether the match exists
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return match.ErrMatchNotFound
+	}
+
 	return nil
 }
+
+func (sc *storeClient) CreateEvent(ctx context.Context, reqEvent outbox.Event) (int64, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	return outboxpgstore.CreateEvent(ctx, sc.q, reqEvent)
+}
@@ -16,25 +16,29 @@ var (
 
 // store implements match/service.PGStore
 type store struct {
-	db *sqlx.DB
+	db           *sqlx.DB
+	queryTimeout time.Duration
 }
 
 // storeClient implements match/service.PGStoreClient
 type storeClient struct {
-	q sqlx.Ext
+	q            sqlx.ExtContext
+	queryTimeout time.Duration
 }
 
-// New creates a new store.
-func New(db *sqlx.DB) (*store, error) {
+// New creates a new store whose queries are limited to the
+// given timeout.
+func New(db *sqlx.DB, queryTimeout time.Duration) (*store, error) {
 	s := &store{
-		db: db,
+		db:           db,
+		queryTimeout: queryTimeout,
 	}
 
 	return s, nil
 }
 
 func (s *store) NewClient(useTx bool) (service.PGStoreClient, error) {
-	var q sqlx.Ext
+	var q sqlx.ExtContext
 
 	// determine what object should be use as querier
 	q = s.db
@@ -47,7 +51,8 @@ func (s *store) NewClient(useTx bool) (service.PGStoreClient, error) {
 	}
 
 	return &storeClient{
-		q: q,
+		q:            q,
+		queryTimeout: s.queryTimeout,
 	}, nil
 }
 
@@ -86,6 +91,7 @@ type matchDB struct {
 	Winner          int64        `db:"winner"`
 	CreateTime      time.Time    `db:"create_time"`
 	UpdateTime      *time.Time   `db:"update_time"`
+	Revision        int64        `db:"revision"`
 }
 
 // format formats database struct into domain struct.
@@ -109,6 +115,7 @@ func (mdb *matchDB) format() match.Match {
 		MatchLink:       mdb.MatchLink,
 		Winner:          mdb.Winner,
 		CreateTime:      mdb.CreateTime,
+		Revision:        mdb.Revision,
 	}
 
 	if mdb.UpdateTime != nil {
@@ -117,3 +124,17 @@ func (mdb *matchDB) format() match.Match {
 
 	return m
 }
+
+// recordDB denotes a team record counted in the store.
+type recordDB struct {
+	Played int `db:"played"`
+	Wins   int `db:"wins"`
+}
+
+// format formats database struct into domain struct.
+func (rdb *recordDB) format() match.Record {
+	return match.Record{
+		Played: rdb.Played,
+		Wins:   rdb.Wins,
+	}
+}
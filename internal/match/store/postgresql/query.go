@@ -13,7 +13,8 @@ const queryCreateMatch = `
 		date,
 		match_link,
 		status,
-		create_time
+		create_time,
+		update_time
 	) VALUES (
 		:tournament_names,
 		:game_id,
@@ -24,6 +25,7 @@ const queryCreateMatch = `
 		:date,
 		:match_link,
 		:status,
+		:create_time,
 		:create_time
 	)  RETURNING
 		id
@@ -49,7 +51,8 @@ const queryGetMatchs = `
 		m.status,
 		COALESCE(m.winner, 0) AS winner,
 		m.create_time, 
-		m.update_time
+		m.update_time,
+		m.revision
 	FROM
 		match m
 	INNER JOIN
@@ -67,24 +70,25 @@ const queryGetMatchs = `
 	%s
 `
 
+const queryGetTeamRecord = `
+	SELECT
+		COUNT(*) AS played,
+		COUNT(*) FILTER (WHERE winner = $1) AS wins
+	FROM
+		match
+	WHERE
+		(team_a_id = $1 OR team_b_id = $1) AND
+		status = $2
+`
+
 const queryUpdateMatch = `
 	UPDATE
 		match
 	SET
-		tournament_names = :tournament_names,
-		game_id = :game_id,
-		team_a_id = :team_a_id,
-		team_b_id = :team_b_id,
-		team_a_odds = :team_a_odds,
-		team_b_odds = :team_b_odds,
-		date = :date,
-		match_link = :match_link,
-		status = :status,
-		winner = :winner,
-		create_time = :create_time,
-		update_time = :update_time
+		%s
 	WHERE
-		id = :id
+		id = :id AND
+		update_time IS NOT DISTINCT FROM :version
 `
 
 const queryDeleteMatchByID = `
@@ -0,0 +1,218 @@
+// Package memory provides an in-memory database shared by the
+// in-memory stores of all domains, so that services and HTTP
+// handlers can be tested without PostgreSQL.
+//
+// The database mimics the tables of PostgreSQL, each domain
+// store reads and writes the tables it queries, including the
+// tables it joins.
+package memory
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/feed"
+	"github.com/x-sports/internal/game"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/news"
+	"github.com/x-sports/internal/notification"
+	"github.com/x-sports/internal/outbox"
+	"github.com/x-sports/internal/team"
+	"github.com/x-sports/internal/thread"
+	"github.com/x-sports/internal/webhook"
+)
+
+var (
+	// ErrInvalidCommit is returned when committing a client
+	// without transaction.
+	ErrInvalidCommit = errors.New("cannot do commit on non-transactional querier")
+
+	// ErrInvalidRollback is returned when rolling back a
+	// client without transaction.
+	ErrInvalidRollback = errors.New("cannot do rollback on non-transactional querier")
+
+	// ErrTxDone is returned when using a client whose
+	// transaction has been committed or rolled back.
+	ErrTxDone = errors.New("transaction has already been committed or rolled back")
+)
+
+// DB is an in-memory database, safe for concurrent use.
+type DB struct {
+	mu   sync.Mutex
+	data *Data
+}
+
+// Data contains the tables of a database.
+type Data struct {
+	Admins        *Table[admin.Admin]
+	Games         *Table[game.Game]
+	Teams         *Table[team.Team]
+	Matches       *Table[match.Match]
+	News          *Table[news.News]
+	Threads       *Table[thread.Thread]
+	Follows       *Table[feed.Follow]
+	Notifications *Table[notification.Notification]
+	Subscriptions *Table[webhook.Subscription]
+	Deliveries    *Table[webhook.Delivery]
+	Events        *Table[outbox.Event]
+
+	// NotificationDeliveries are the match notifications
+	// delivered to users, not the webhook Deliveries.
+	NotificationDeliveries *Table[notification.Delivery]
+
+	// Preferences are keyed by the user ID.
+	Preferences *Table[notification.Preference]
+}
+
+// New returns a new empty database.
+func New() *DB {
+	return &DB{
+		data: &Data{
+			Admins:        newTable[admin.Admin](),
+			Games:         newTable[game.Game](),
+			Teams:         newTable[team.Team](),
+			Matches:       newTable[match.Match](),
+			News:          newTable[news.News](),
+			Threads:       newTable[thread.Thread](),
+			Follows:       newTable[feed.Follow](),
+			Notifications: newTable[notification.Notification](),
+			Subscriptions: newTable[webhook.Subscription](),
+			Deliveries:    newTable[webhook.Delivery](),
+			Events:        newTable[outbox.Event](),
+			Preferences:   newTable[notification.Preference](),
+
+			NotificationDeliveries: newTable[notification.Delivery](),
+		},
+	}
+}
+
+// clone returns a copy of the data whose tables can be
+// changed without affecting the data.
+func (d *Data) clone() *Data {
+	return &Data{
+		Admins:        d.Admins.clone(),
+		Games:         d.Games.clone(),
+		Teams:         d.Teams.clone(),
+		Matches:       d.Matches.clone(),
+		News:          d.News.clone(),
+		Threads:       d.Threads.clone(),
+		Follows:       d.Follows.clone(),
+		Notifications: d.Notifications.clone(),
+		Subscriptions: d.Subscriptions.clone(),
+		Deliveries:    d.Deliveries.clone(),
+		Events:        d.Events.clone(),
+		Preferences:   d.Preferences.clone(),
+
+		NotificationDeliveries: d.NotificationDeliveries.clone(),
+	}
+}
+
+// merge applies the rows changed from base to tx into the
+// data, so that rows changed by others since base are kept.
+func (d *Data) merge(base, tx *Data) {
+	d.Admins.merge(base.Admins, tx.Admins)
+	d.Games.merge(base.Games, tx.Games)
+	d.Teams.merge(base.Teams, tx.Teams)
+	d.Matches.merge(base.Matches, tx.Matches)
+	d.News.merge(base.News, tx.News)
+	d.Threads.merge(base.Threads, tx.Threads)
+	d.Follows.merge(base.Follows, tx.Follows)
+	d.Notifications.merge(base.Notifications, tx.Notifications)
+	d.Subscriptions.merge(base.Subscriptions, tx.Subscriptions)
+	d.Deliveries.merge(base.Deliveries, tx.Deliveries)
+	d.Events.merge(base.Events, tx.Events)
+	d.Preferences.merge(base.Preferences, tx.Preferences)
+	d.NotificationDeliveries.merge(base.NotificationDeliveries, tx.NotificationDeliveries)
+}
+
+// Time returns the given time as stored by PostgreSQL, with
+// microsecond precision, so that versions derived from it
+// round trip the same way.
+func Time(t time.Time) time.Time {
+	return t.Truncate(time.Microsecond)
+}
+
+// Client reads and writes a database, directly or in a
+// transaction.
+//
+// A transaction works on a snapshot of the database taken
+// when the client is created, and only the rows it changes are
+// written on commit. There is no locking, concurrent
+// transactions changing the same row are resolved by the last
+// commit.
+type Client struct {
+	db    *DB
+	useTx bool
+	done  bool
+
+	// base is the snapshot the transaction starts from, while
+	// data is the one changed by the transaction.
+	base *Data
+	data *Data
+}
+
+// NewClient returns a new client of the database, using
+// transaction if useTx is true.
+func (db *DB) NewClient(useTx bool) *Client {
+	c := &Client{
+		db:    db,
+		useTx: useTx,
+	}
+
+	if useTx {
+		db.mu.Lock()
+		c.base = db.data.clone()
+		db.mu.Unlock()
+
+		c.data = c.base.clone()
+	}
+
+	return c
+}
+
+// Do calls fn with the data of the client. The data must only
+// be used inside fn.
+func (c *Client) Do(fn func(data *Data) error) error {
+	if !c.useTx {
+		c.db.mu.Lock()
+		defer c.db.mu.Unlock()
+		return fn(c.db.data)
+	}
+
+	if c.done {
+		return ErrTxDone
+	}
+	return fn(c.data)
+}
+
+// Commit writes the rows changed by the transaction.
+func (c *Client) Commit() error {
+	if !c.useTx {
+		return ErrInvalidCommit
+	}
+	if c.done {
+		return ErrTxDone
+	}
+	c.done = true
+
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+	c.db.data.merge(c.base, c.data)
+
+	return nil
+}
+
+// Rollback discards the rows changed by the transaction.
+func (c *Client) Rollback() error {
+	if !c.useTx {
+		return ErrInvalidRollback
+	}
+	if c.done {
+		return ErrTxDone
+	}
+	c.done = true
+
+	return nil
+}
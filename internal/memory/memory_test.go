@@ -0,0 +1,123 @@
+package memory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/x-sports/internal/game"
+)
+
+// putGame inserts a game with the given names using the given
+// client and returns its ID.
+func putGame(t *testing.T, c *Client, names string) int64 {
+	t.Helper()
+
+	var id int64
+	err := c.Do(func(data *Data) error {
+		id = data.Games.NextID()
+		data.Games.Put(id, game.Game{ID: id, GameNames: names})
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to put game: %s", err)
+	}
+	return id
+}
+
+// getGame returns the game with the given ID from the
+// database, and false if it does not exist.
+func getGame(t *testing.T, db *DB, id int64) (game.Game, bool) {
+	t.Helper()
+
+	var g game.Game
+	var ok bool
+	err := db.NewClient(false).Do(func(data *Data) error {
+		g, ok = data.Games.Get(id)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to get game: %s", err)
+	}
+	return g, ok
+}
+
+func TestClientRollback(t *testing.T) {
+	db := New()
+
+	tx := db.NewClient(true)
+	id := putGame(t, tx, "Dota 2")
+	if _, ok := getGame(t, db, id); ok {
+		t.Error("uncommitted game is visible outside the transaction")
+	}
+
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("failed to rollback: %s", err)
+	}
+	if _, ok := getGame(t, db, id); ok {
+		t.Error("rolled back game exists")
+	}
+
+	// the transaction cannot be used anymore
+	if err := tx.Commit(); !errors.Is(err, ErrTxDone) {
+		t.Errorf("commit after rollback = %v, want %v", err, ErrTxDone)
+	}
+
+	// the sequence is not rolled back
+	if next := putGame(t, db.NewClient(false), "Valorant"); next <= id {
+		t.Errorf("ID after rollback = %d, want greater than %d", next, id)
+	}
+}
+
+func TestClientCommit(t *testing.T) {
+	db := New()
+	existing := putGame(t, db.NewClient(false), "Dota 2")
+	deleted := putGame(t, db.NewClient(false), "Valorant")
+
+	tx := db.NewClient(true)
+	created := putGame(t, tx, "Mobile Legends")
+	err := tx.Do(func(data *Data) error {
+		data.Games.Delete(deleted)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to delete game: %s", err)
+	}
+
+	// rows changed by others during the transaction are kept
+	concurrent := putGame(t, db.NewClient(false), "PUBG")
+	err = db.NewClient(false).Do(func(data *Data) error {
+		data.Games.Put(existing, game.Game{ID: existing, GameNames: "Dota 2 Reborn"})
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to update game: %s", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("failed to commit: %s", err)
+	}
+
+	if _, ok := getGame(t, db, created); !ok {
+		t.Error("created game does not exist")
+	}
+	if _, ok := getGame(t, db, deleted); ok {
+		t.Error("deleted game exists")
+	}
+	if _, ok := getGame(t, db, concurrent); !ok {
+		t.Error("concurrently created game does not exist")
+	}
+	if g, _ := getGame(t, db, existing); g.GameNames != "Dota 2 Reborn" {
+		t.Errorf("concurrently updated game names = %s, want Dota 2 Reborn", g.GameNames)
+	}
+}
+
+func TestClientWithoutTransaction(t *testing.T) {
+	c := New().NewClient(false)
+
+	if err := c.Commit(); !errors.Is(err, ErrInvalidCommit) {
+		t.Errorf("commit = %v, want %v", err, ErrInvalidCommit)
+	}
+	if err := c.Rollback(); !errors.Is(err, ErrInvalidRollback) {
+		t.Errorf("rollback = %v, want %v", err, ErrInvalidRollback)
+	}
+}
@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"reflect"
+	"sort"
+	"sync/atomic"
+)
+
+// Table contains rows keyed by their IDs.
+type Table[T any] struct {
+	rows map[int64]T
+
+	// seq generates the IDs, it is shared by the snapshots
+	// of the table, like PostgreSQL sequence that is not
+	// rolled back with the transaction.
+	seq *int64
+}
+
+// newTable returns a new empty table.
+func newTable[T any]() *Table[T] {
+	return &Table[T]{
+		rows: make(map[int64]T),
+		seq:  new(int64),
+	}
+}
+
+// NextID returns a new ID of the table.
+func (t *Table[T]) NextID() int64 {
+	return atomic.AddInt64(t.seq, 1)
+}
+
+// Get returns the row with the given ID, and whether it
+// exists.
+func (t *Table[T]) Get(id int64) (T, bool) {
+	row, ok := t.rows[id]
+	return row, ok
+}
+
+// Put inserts or replaces the row with the given ID.
+func (t *Table[T]) Put(id int64, row T) {
+	t.rows[id] = row
+}
+
+// Delete deletes the row with the given ID, and returns
+// whether it exists.
+func (t *Table[T]) Delete(id int64) bool {
+	_, ok := t.rows[id]
+	delete(t.rows, id)
+	return ok
+}
+
+// All returns all rows ordered by their IDs.
+func (t *Table[T]) All() []T {
+	ids := make([]int64, 0, len(t.rows))
+	for id := range t.rows {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	rows := make([]T, 0, len(ids))
+	for _, id := range ids {
+		rows = append(rows, t.rows[id])
+	}
+	return rows
+}
+
+// clone returns a copy of the table sharing the same
+// sequence.
+func (t *Table[T]) clone() *Table[T] {
+	rows := make(map[int64]T, len(t.rows))
+	for id, row := range t.rows {
+		rows[id] = row
+	}
+
+	return &Table[T]{
+		rows: rows,
+		seq:  t.seq,
+	}
+}
+
+// merge applies the rows inserted, updated, or deleted from
+// base to tx into the table.
+func (t *Table[T]) merge(base, tx *Table[T]) {
+	for id, row := range tx.rows {
+		if old, ok := base.rows[id]; !ok || !reflect.DeepEqual(old, row) {
+			t.rows[id] = row
+		}
+	}
+
+	for id := range base.rows {
+		if _, ok := tx.rows[id]; !ok {
+			delete(t.rows, id)
+		}
+	}
+}
@@ -0,0 +1,297 @@
+// Package migration manages the database schema using the
+// versioned SQL migrations embedded in the binary.
+//
+// Migrations are files in the sql directory named
+// "<version>_<name>.up.sql" and "<version>_<name>.down.sql",
+// applied in the order of their versions. Applied migrations
+// are recorded in schema_migration table.
+package migration
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// lockKey is the key of the advisory lock held while applying
+// a migration, so that multiple instances migrating at the
+// same time do not apply the same migration twice.
+const lockKey = 5139207445
+
+//go:embed sql/*.sql
+var files embed.FS
+
+var (
+	// ErrInvalidSteps is returned when the given number of
+	// migrations to revert is invalid.
+	ErrInvalidSteps = errors.New("invalid steps")
+
+	// ErrIrreversible is returned when reverting a migration
+	// without down file.
+	ErrIrreversible = errors.New("irreversible migration")
+)
+
+// Migration denotes a versioned schema change.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status denotes a migration and whether it is applied.
+type Status struct {
+	Migration
+	Applied   bool
+	ApplyTime time.Time
+}
+
+// Migrator applies the embedded migrations to a database.
+type Migrator struct {
+	db         *sqlx.DB
+	migrations []Migration
+}
+
+// New returns a new Migrator of the given database.
+func New(db *sqlx.DB) (*Migrator, error) {
+	migrations, err := loadMigrations(files)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+// Up applies all migrations that are not applied yet, and
+// returns the applied migrations.
+//
+// Each migration is applied in its own transaction, so the
+// migrations applied before a failing one stay applied.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	result := make([]Migration, 0)
+	for _, mig := range m.migrations {
+		applied, err := m.apply(ctx, mig, true)
+		if err != nil {
+			return result, fmt.Errorf("failed to apply migration %d_%s: %w", mig.Version, mig.Name, err)
+		}
+		if applied {
+			result = append(result, mig)
+		}
+	}
+
+	return result, nil
+}
+
+// Down reverts the given number of the latest applied
+// migrations, and returns the reverted migrations.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	if steps <= 0 {
+		return nil, ErrInvalidSteps
+	}
+
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Migration, 0)
+	for i := len(statuses) - 1; i >= 0 && len(result) < steps; i-- {
+		mig := statuses[i].Migration
+		if !statuses[i].Applied {
+			continue
+		}
+		if mig.Down == "" {
+			return result, fmt.Errorf("failed to revert migration %d_%s: %w", mig.Version, mig.Name, ErrIrreversible)
+		}
+
+		reverted, err := m.apply(ctx, mig, false)
+		if err != nil {
+			return result, fmt.Errorf("failed to revert migration %d_%s: %w", mig.Version, mig.Name, err)
+		}
+		if reverted {
+			result = append(result, mig)
+		}
+	}
+
+	return result, nil
+}
+
+// Status returns all migrations and whether they are applied,
+// ordered by their versions.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	result := make([]Status, 0, len(m.migrations))
+	for _, mig := range m.migrations {
+		result = append(result, Status{
+			Migration: mig,
+		})
+	}
+
+	// nothing is applied before the first migration
+	var hasTable bool
+	err := m.db.GetContext(ctx, &hasTable, queryHasMigrationTable)
+	if err != nil {
+		return nil, err
+	}
+	if !hasTable {
+		return result, nil
+	}
+
+	var applied []struct {
+		Version   int64     `db:"version"`
+		ApplyTime time.Time `db:"apply_time"`
+	}
+	err = m.db.SelectContext(ctx, &applied, queryGetAppliedMigrations)
+	if err != nil {
+		return nil, err
+	}
+
+	applyTimes := make(map[int64]time.Time, len(applied))
+	for _, a := range applied {
+		applyTimes[a.Version] = a.ApplyTime
+	}
+	for i := range result {
+		if t, ok := applyTimes[result[i].Version]; ok {
+			result[i].Applied = true
+			result[i].ApplyTime = t
+		}
+	}
+
+	return result, nil
+}
+
+// apply applies the given migration if up is true, or reverts
+// it otherwise, and returns whether it is applied or
+// reverted, as it may have been done by another instance.
+func (m *Migrator) apply(ctx context.Context, mig Migration, up bool) (bool, error) {
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	// the lock is released when the transaction ends
+	_, err = tx.ExecContext(ctx, queryLockMigration, lockKey)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = tx.ExecContext(ctx, queryCreateMigrationTable)
+	if err != nil {
+		return false, err
+	}
+
+	var applied bool
+	err = tx.GetContext(ctx, &applied, queryIsMigrationApplied, mig.Version)
+	if err != nil {
+		return false, err
+	}
+	if applied == up {
+		return false, nil
+	}
+
+	if up {
+		_, err = tx.ExecContext(ctx, mig.Up)
+		if err != nil {
+			return false, err
+		}
+		_, err = tx.ExecContext(ctx, queryInsertMigration, mig.Version, mig.Name, time.Now())
+	} else {
+		_, err = tx.ExecContext(ctx, mig.Down)
+		if err != nil {
+			return false, err
+		}
+		_, err = tx.ExecContext(ctx, queryDeleteMigration, mig.Version)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// loadMigrations returns the migrations in the sql directory
+// of the given file system, ordered by their versions.
+func loadMigrations(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, "sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		filename := entry.Name()
+
+		var up bool
+		var base string
+		switch {
+		case strings.HasSuffix(filename, ".up.sql"):
+			up, base = true, strings.TrimSuffix(filename, ".up.sql")
+		case strings.HasSuffix(filename, ".down.sql"):
+			up, base = false, strings.TrimSuffix(filename, ".down.sql")
+		default:
+			return nil, fmt.Errorf("invalid migration file name: %s", filename)
+		}
+
+		versionStr, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("invalid migration file name: %s", filename)
+		}
+		version, err := strconv.ParseInt(versionStr, 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version: %s", filename)
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join("sql", filename))
+		if err != nil {
+			return nil, err
+		}
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{
+				Version: version,
+				Name:    name,
+			}
+			byVersion[version] = mig
+		}
+		if mig.Name != name {
+			return nil, fmt.Errorf("conflicting migration names of version %d: %s and %s", version, mig.Name, name)
+		}
+
+		if up {
+			mig.Up = string(content)
+		} else {
+			mig.Down = string(content)
+		}
+	}
+
+	result := make([]Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == "" {
+			return nil, fmt.Errorf("missing up file of migration %d_%s", mig.Version, mig.Name)
+		}
+		result = append(result, *mig)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Version < result[j].Version
+	})
+
+	return result, nil
+}
@@ -0,0 +1,61 @@
+package migration
+
+// queryCreateMigrationTable creates the table of applied
+// migrations if it does not exist yet.
+const queryCreateMigrationTable = `
+	CREATE TABLE IF NOT EXISTS schema_migration (
+		version    BIGINT PRIMARY KEY,
+		name       TEXT NOT NULL,
+		apply_time TIMESTAMPTZ NOT NULL
+	)
+`
+
+const queryLockMigration = `
+	SELECT
+		pg_advisory_xact_lock($1)
+`
+
+const queryHasMigrationTable = `
+	SELECT
+		to_regclass('schema_migration') IS NOT NULL
+`
+
+const queryGetAppliedMigrations = `
+	SELECT
+		m.version,
+		m.apply_time
+	FROM
+		schema_migration m
+`
+
+const queryIsMigrationApplied = `
+	SELECT EXISTS (
+		SELECT
+			1
+		FROM
+			schema_migration
+		WHERE
+			version = $1
+	)
+`
+
+const queryInsertMigration = `
+	INSERT INTO
+		schema_migration
+	(
+		version,
+		name,
+		apply_time
+	) VALUES (
+		$1,
+		$2,
+		$3
+	)
+`
+
+const queryDeleteMigration = `
+	DELETE FROM
+		schema_migration
+	WHERE
+		version = $1
+`
@@ -0,0 +1,12 @@
+DROP TABLE IF EXISTS outbox_event;
+DROP TABLE IF EXISTS webhook_delivery;
+DROP TABLE IF EXISTS webhook_subscription;
+DROP TABLE IF EXISTS notification_preference;
+DROP TABLE IF EXISTS notification;
+DROP TABLE IF EXISTS follow;
+DROP TABLE IF EXISTS thread;
+DROP TABLE IF EXISTS news;
+DROP TABLE IF EXISTS match;
+DROP TABLE IF EXISTS team;
+DROP TABLE IF EXISTS game;
+DROP TABLE IF EXISTS admin;
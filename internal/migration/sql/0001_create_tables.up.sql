@@ -0,0 +1,180 @@
+-- Tables are created only if they do not exist yet, so that a
+-- database created before the migrations can be adopted.
+--
+-- Updates of game, team, match, news, and thread are only
+-- applied to the update time they are based on, so the update
+-- time must be set since the record is created.
+
+CREATE TABLE IF NOT EXISTS admin (
+	id          BIGSERIAL PRIMARY KEY,
+	email       TEXT NOT NULL UNIQUE,
+	password    TEXT NOT NULL,
+	create_time TIMESTAMPTZ NOT NULL DEFAULT now(),
+	update_time TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS game (
+	id          BIGSERIAL PRIMARY KEY,
+	game_names  TEXT NOT NULL,
+	game_icons  TEXT NOT NULL,
+	create_time TIMESTAMPTZ NOT NULL,
+	update_time TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS team (
+	id          BIGSERIAL PRIMARY KEY,
+	team_names  TEXT NOT NULL,
+	team_icons  TEXT NOT NULL,
+	game_id     BIGINT NOT NULL REFERENCES game (id),
+	create_time TIMESTAMPTZ NOT NULL,
+	update_time TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS team_game_id_idx ON team (game_id);
+
+-- winner is zero or NULL when there is no winner yet, so it
+-- does not reference team.
+CREATE TABLE IF NOT EXISTS match (
+	id               BIGSERIAL PRIMARY KEY,
+	tournament_names TEXT NOT NULL,
+	game_id          BIGINT NOT NULL REFERENCES game (id),
+	team_a_id        BIGINT NOT NULL REFERENCES team (id),
+	team_b_id        BIGINT NOT NULL REFERENCES team (id),
+	team_a_odds      REAL NOT NULL DEFAULT 0,
+	team_b_odds      REAL NOT NULL DEFAULT 0,
+	date             TIMESTAMPTZ NOT NULL,
+	match_link       TEXT NOT NULL,
+	status           INTEGER NOT NULL,
+	winner           BIGINT,
+	create_time      TIMESTAMPTZ NOT NULL,
+	update_time      TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS match_game_id_date_idx ON match (game_id, date);
+CREATE INDEX IF NOT EXISTS match_team_a_id_idx ON match (team_a_id);
+CREATE INDEX IF NOT EXISTS match_team_b_id_idx ON match (team_b_id);
+
+CREATE TABLE IF NOT EXISTS news (
+	id          BIGSERIAL PRIMARY KEY,
+	title       TEXT NOT NULL,
+	game_id     BIGINT NOT NULL REFERENCES game (id),
+	description TEXT NOT NULL,
+	image_news  TEXT NOT NULL,
+	date        TIMESTAMPTZ NOT NULL,
+	create_time TIMESTAMPTZ NOT NULL,
+	update_time TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS news_game_id_date_idx ON news (game_id, date);
+
+CREATE TABLE IF NOT EXISTS thread (
+	id           BIGSERIAL PRIMARY KEY,
+	title        TEXT NOT NULL,
+	game_id      BIGINT NOT NULL REFERENCES game (id),
+	description  TEXT NOT NULL,
+	image_thread TEXT NOT NULL,
+	date         TIMESTAMPTZ NOT NULL,
+	create_time  TIMESTAMPTZ NOT NULL,
+	update_time  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS thread_game_id_date_idx ON thread (game_id, date);
+
+-- target_id refers to a game or a team based on follow_type.
+CREATE TABLE IF NOT EXISTS follow (
+	id          BIGSERIAL PRIMARY KEY,
+	user_id     BIGINT NOT NULL,
+	follow_type INTEGER NOT NULL,
+	target_id   BIGINT NOT NULL,
+	create_time TIMESTAMPTZ NOT NULL,
+	UNIQUE (user_id, follow_type, target_id)
+);
+
+CREATE INDEX IF NOT EXISTS follow_follow_type_target_id_idx ON follow (follow_type, target_id);
+
+-- match_id is kept after the match is deleted, so it does not
+-- reference match.
+CREATE TABLE IF NOT EXISTS notification (
+	id                BIGSERIAL PRIMARY KEY,
+	user_id           BIGINT NOT NULL,
+	notification_type INTEGER NOT NULL,
+	title             TEXT NOT NULL,
+	message           TEXT NOT NULL,
+	match_id          BIGINT,
+	is_read           BOOLEAN NOT NULL DEFAULT FALSE,
+	create_time       TIMESTAMPTZ NOT NULL,
+	read_time         TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS notification_user_id_create_time_idx ON notification (user_id, create_time DESC);
+
+CREATE TABLE IF NOT EXISTS notification_preference (
+	user_id         BIGINT PRIMARY KEY,
+	in_app          BOOLEAN NOT NULL,
+	email_enabled   BOOLEAN NOT NULL,
+	email           TEXT NOT NULL,
+	webhook_enabled BOOLEAN NOT NULL,
+	webhook_url     TEXT NOT NULL,
+	match_start     BOOLEAN NOT NULL,
+	match_result    BOOLEAN NOT NULL,
+	create_time     TIMESTAMPTZ NOT NULL,
+	update_time     TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS webhook_subscription (
+	id          BIGSERIAL PRIMARY KEY,
+	url         TEXT NOT NULL,
+	secret      TEXT NOT NULL,
+	event_types TEXT[] NOT NULL,
+	is_active   BOOLEAN NOT NULL,
+	create_time TIMESTAMPTZ NOT NULL,
+	update_time TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS webhook_delivery (
+	id              BIGSERIAL PRIMARY KEY,
+	subscription_id BIGINT NOT NULL REFERENCES webhook_subscription (id) ON DELETE CASCADE,
+	event_type      TEXT NOT NULL,
+	payload         BYTEA NOT NULL,
+	status          INTEGER NOT NULL,
+	attempts        INTEGER NOT NULL,
+	response_code   INTEGER NOT NULL,
+	last_error      TEXT NOT NULL,
+	create_time     TIMESTAMPTZ NOT NULL,
+	update_time     TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS webhook_delivery_subscription_id_create_time_idx ON webhook_delivery (subscription_id, create_time DESC);
+
+CREATE TABLE IF NOT EXISTS outbox_event (
+	id                BIGSERIAL PRIMARY KEY,
+	event_type        TEXT NOT NULL,
+	payload           BYTEA NOT NULL,
+	attempts          INTEGER NOT NULL DEFAULT 0,
+	last_error        TEXT NOT NULL DEFAULT '',
+	create_time       TIMESTAMPTZ NOT NULL,
+	next_attempt_time TIMESTAMPTZ NOT NULL,
+	dispatch_time     TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS outbox_event_pending_idx ON outbox_event (next_attempt_time) WHERE dispatch_time IS NULL;
+
+-- An adopted database may have update times without default
+-- or not set yet.
+ALTER TABLE game ALTER COLUMN update_time SET DEFAULT now();
+ALTER TABLE team ALTER COLUMN update_time SET DEFAULT now();
+ALTER TABLE match ALTER COLUMN update_time SET DEFAULT now();
+ALTER TABLE news ALTER COLUMN update_time SET DEFAULT now();
+ALTER TABLE thread ALTER COLUMN update_time SET DEFAULT now();
+
+UPDATE game SET update_time = create_time WHERE update_time IS NULL;
+UPDATE team SET update_time = create_time WHERE update_time IS NULL;
+UPDATE match SET update_time = create_time WHERE update_time IS NULL;
+UPDATE news SET update_time = create_time WHERE update_time IS NULL;
+UPDATE thread SET update_time = create_time WHERE update_time IS NULL;
+
+ALTER TABLE game ALTER COLUMN update_time SET NOT NULL;
+ALTER TABLE team ALTER COLUMN update_time SET NOT NULL;
+ALTER TABLE match ALTER COLUMN update_time SET NOT NULL;
+ALTER TABLE news ALTER COLUMN update_time SET NOT NULL;
+ALTER TABLE thread ALTER COLUMN update_time SET NOT NULL;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS team_team_names_trgm_idx;
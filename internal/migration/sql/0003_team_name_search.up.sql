@@ -0,0 +1,25 @@
+-- Teams are searched by a substring of their names using
+-- ILIKE, which can only use a trigram index.
+--
+-- Creating the pg_trgm extension requires the CREATE privilege
+-- on the database, or a superuser on PostgreSQL before 13. When
+-- the extension cannot be created, the index is skipped and the
+-- search still works without it. Once a privileged user has
+-- installed the extension, the index can be created with the
+-- CREATE INDEX statement below.
+
+DO $$
+BEGIN
+	CREATE EXTENSION IF NOT EXISTS pg_trgm;
+EXCEPTION WHEN insufficient_privilege THEN
+	RAISE NOTICE 'pg_trgm extension is not installed, skipping team_team_names_trgm_idx: %', SQLERRM;
+END
+$$;
+
+DO $$
+BEGIN
+	IF EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'pg_trgm') THEN
+		CREATE INDEX IF NOT EXISTS team_team_names_trgm_idx ON team USING gin (team_names gin_trgm_ops);
+	END IF;
+END
+$$;
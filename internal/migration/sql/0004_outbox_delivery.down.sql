@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS outbox_event_pending_idx;
+CREATE INDEX IF NOT EXISTS outbox_event_pending_idx ON outbox_event (next_attempt_time) WHERE dispatch_time IS NULL;
+
+ALTER TABLE outbox_event DROP COLUMN IF EXISTS fail_time;
+ALTER TABLE outbox_event DROP COLUMN IF EXISTS delivered;
@@ -0,0 +1,10 @@
+-- Events are delivered outside of a transaction, so each
+-- subscriber they are delivered to is recorded to not deliver
+-- them again on retry. Events failing too many times are kept
+-- as failed instead of being retried forever.
+
+ALTER TABLE outbox_event ADD COLUMN IF NOT EXISTS delivered TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE outbox_event ADD COLUMN IF NOT EXISTS fail_time TIMESTAMPTZ;
+
+DROP INDEX IF EXISTS outbox_event_pending_idx;
+CREATE INDEX IF NOT EXISTS outbox_event_pending_idx ON outbox_event (next_attempt_time) WHERE dispatch_time IS NULL AND fail_time IS NULL;
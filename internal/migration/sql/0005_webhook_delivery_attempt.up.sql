@@ -0,0 +1,8 @@
+-- Pending deliveries are sent by a worker polling the due
+-- ones, so that they are resumed after a restart. The pending
+-- deliveries left by the previous versions are due now.
+
+ALTER TABLE webhook_delivery ADD COLUMN IF NOT EXISTS next_attempt_time TIMESTAMPTZ NOT NULL DEFAULT now();
+ALTER TABLE webhook_delivery ALTER COLUMN next_attempt_time DROP DEFAULT;
+
+CREATE INDEX IF NOT EXISTS webhook_delivery_pending_idx ON webhook_delivery (next_attempt_time) WHERE status = 1;
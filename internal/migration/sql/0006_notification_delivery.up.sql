@@ -0,0 +1,13 @@
+-- Match notifications delivered to users are recorded per
+-- channel, so that retrying a match event only delivers
+-- through the channels that have not been delivered yet.
+
+CREATE TABLE IF NOT EXISTS notification_delivery (
+	id                BIGSERIAL PRIMARY KEY,
+	user_id           BIGINT NOT NULL,
+	match_id          BIGINT NOT NULL,
+	notification_type INTEGER NOT NULL,
+	channel           TEXT NOT NULL,
+	create_time       TIMESTAMPTZ NOT NULL,
+	UNIQUE (user_id, match_id, notification_type, channel)
+);
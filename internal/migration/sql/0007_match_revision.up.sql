@@ -0,0 +1,4 @@
+-- Revision counts the updates of a match, it is used as the
+-- sequence of the match event in calendar feeds.
+
+ALTER TABLE match ADD COLUMN IF NOT EXISTS revision INTEGER NOT NULL DEFAULT 0;
@@ -26,4 +26,12 @@ var (
 	// ErrInvalidDate is returned whrn the given date
 	// is invalid.
 	ErrInvalidDate = errors.New("invalid date")
+
+	// ErrNewsNotFound is returned when the
+	// news with the given ID does not exist.
+	ErrNewsNotFound = errors.New("news not found")
+
+	// ErrVersionConflict is returned when the news has
+	// been updated since the version the update is based on.
+	ErrVersionConflict = errors.New("news version conflict")
 )
@@ -0,0 +1,8 @@
+package news
+
+// Followings are the types of events recorded when a news
+// changes.
+const (
+	EventTypeCreated = "news.created"
+	EventTypeUpdated = "news.updated"
+)
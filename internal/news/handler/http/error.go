@@ -2,24 +2,14 @@ package http
 
 import (
 	"errors"
+	"net/http"
 
+	"github.com/x-sports/global/helper"
 	"github.com/x-sports/internal/news"
 )
 
 // Followings are the known errors from Match HTTP handlers.
 var (
-	// errBadRequest is returned when the given request is
-	// bad/invalid.
-	errBadRequest = errors.New("BAD_REQUEST")
-
-	// errInternalServer is returned when there is an
-	// unexpected error encountered when processing a request.
-	errInternalServer = errors.New("INTERNAL_SERVER_ERROR")
-
-	// errInvalidToken is returned when the given token is
-	// invalid.
-	errInvalidToken = errors.New("INVALID_TOKEN")
-
 	// errInvalidTitle is returned when the given title is
 	// invalid.
 	errInvalidTitle = errors.New("INVALID_TITLE")
@@ -52,18 +42,23 @@ var (
 	// allowed HTTP method.
 	errMethodNotAllowed = errors.New("METHOD_NOT_ALLOWED")
 
-	// errRequestTimeout is returned when processing time
-	// has reached the timeout limit.
-	errRequestTimeout = errors.New("REQUEST_TIMEOUT")
+	// errNewsNotFound is returned when the
+	// news with the given ID does not exist.
+	errNewsNotFound = errors.New("NEWS_NOT_FOUND")
+
+	// errVersionConflict is returned when the news has
+	// been updated since the version in If-Match header.
+	errVersionConflict = errors.New("VERSION_CONFLICT")
 
-	// errUnauthorizedAccess is returned when the request
-	// is unaothorized.
-	errUnauthorizedAccess = errors.New("UNAUTHORIZED_ACCESS")
+	// errQueryTimeout is returned when a database query has
+	// reached its timeout limit.
+	errQueryTimeout = errors.New("QUERY_TIMEOUT")
 )
 
 var (
 	// mapHTTPError maps service error into HTTP error that
-	// categorize as bad request error.
+	// categorize as bad request error, unless its status code
+	// is set in mapHTTPStatus.
 	//
 	// Internal server error-related should not be mapped
 	// here, and the handler should just return `errInternal`
@@ -75,5 +70,26 @@ var (
 		news.ErrInvalidDescription: errInvalidDescription,
 		news.ErrInvalidDate:        errInvalidDate,
 		news.ErrInvalidImageNews:   errInvalidImageNews,
+		news.ErrNewsNotFound:       errNewsNotFound,
+		news.ErrVersionConflict:    errVersionConflict,
+		helper.ErrQueryTimeout:     errQueryTimeout,
+	}
+
+	// mapHTTPStatus maps HTTP error into its status code when
+	// it is not a bad request error.
+	mapHTTPStatus = map[error]int{
+		errQueryTimeout:    http.StatusGatewayTimeout,
+		errNewsNotFound:    http.StatusNotFound,
+		errVersionConflict: http.StatusConflict,
 	}
 )
+
+// newEndpoint returns helper.Endpoint of the handler with the
+// given name.
+func newEndpoint(name string) helper.Endpoint {
+	return helper.Endpoint{
+		Name:      "[News HTTP][" + name + "]",
+		MapError:  mapHTTPError,
+		MapStatus: mapHTTPStatus,
+	}
+}
@@ -2,9 +2,7 @@ package http
 
 import (
 	"context"
-	"encoding/json"
-	"io/ioutil"
-	"log"
+	"log/slog"
 	"net/http"
 	"strconv"
 	"time"
@@ -24,7 +22,7 @@ func (h *newsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	newsID, err := strconv.ParseInt(vars["id"], 10, 64)
 	if err != nil {
-		log.Printf("[News HTTP][newsHandler] Failed to parse news ID. ID: %s. Err: %s\n", vars["id"], err.Error())
+		slog.InfoContext(r.Context(), "failed to parse news ID", slog.String("handler", "[News HTTP][newsHandler]"), slog.String("id", vars["id"]), slog.String("error", err.Error()))
 		helper.WriteErrorResponse(w, http.StatusBadRequest, []string{errInvalidNewsID.Error()})
 		return
 	}
@@ -40,244 +38,84 @@ func (h *newsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *newsHandler) handleGetNewsByID(w http.ResponseWriter, r *http.Request, newsID int64) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
-		if err != nil {
-			log.Printf("[News HTTP][handleGetNewsByID] Failed to get news by ID. newsID: %d, Err: %s\n", newsID, err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
-		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
-
-	// prepare channels for main go routine
-	resChan := make(chan news.News, 1)
-	errChan := make(chan error, 1)
-
-	go func() {
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
-		}
-
+	helper.Serve(w, r, newEndpoint("handleGetNewsByID"), func(ctx context.Context) (helper.Versioned[newsHTTP], error) {
 		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleGetNewsByID")
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
+			return helper.Versioned[newsHTTP]{}, err
 		}
 
 		// TODO: add authorization flow with roles
 
 		res, err := h.news.GetNewsByID(ctx, newsID)
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[News HTTP][handleGetNewsByID] Internal error from GetNewsByID. newsID: %d. Err: %s\n", newsID, err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return helper.Versioned[newsHTTP]{}, err
 		}
 
-		resChan <- res
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case res := <-resChan:
-		// format curriculum
-		var n newsHTTP
-		n, err = formatNews(res)
+		data, err := formatNews(res)
 		if err != nil {
-			return
+			return helper.Versioned[newsHTTP]{}, err
 		}
-		// construct response data
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: n,
-		})
-	}
+
+		return helper.Versioned[newsHTTP]{Data: data, Version: res.UpdateTime}, nil
+	})
 }
 
 func (h *newsHandler) handleUpdateNews(w http.ResponseWriter, r *http.Request, newsID int64) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
-		if err != nil {
-			log.Printf("[News HTTP][handleUpdateNews] Failed to update news. newsID: %d, Err: %s\n", newsID, err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
-		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
-
-	// prepare channels for main go routine
-	resChan := make(chan struct{}, 1)
-	errChan := make(chan error, 1)
-
-	go func() {
-		// read request body
-		body, err := ioutil.ReadAll(r.Body)
+	helper.Serve(w, r, newEndpoint("handleUpdateNews"), func(ctx context.Context) (helper.Versioned[int64], error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
+			return helper.Versioned[int64]{}, err
 		}
 
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
+		// get the version the update is based on
+		version, err := helper.ParseIfMatch(r)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
+			return helper.Versioned[int64]{}, err
 		}
 
-		// unmarshall body
+		// decode request body
 		request := newsHTTP{}
-		err = json.Unmarshal(body, &request)
+		err = helper.DecodeJSON(r, &request)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
-		}
-
-		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleUpdateNews")
-		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
-		}
-
-		// get current curriculum
-		current, err := h.news.GetNewsByID(ctx, newsID)
-		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[News HTTP][handleUpdateNews] Internal error from GetNewsByID. newsID: %d. Err: %s\n", newsID, err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return helper.Versioned[int64]{}, err
 		}
 
 		// format HTTP request into service object
-		reqNews, err := parseNewsFromUpdateRequest(request, current)
+		update, err := parseNewsFromUpdateRequest(request)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- err
-			return
+			return helper.Versioned[int64]{}, helper.NewHTTPError(http.StatusBadRequest, err)
 		}
+		update.ID = newsID
+		update.Version = version
 
-		err = h.news.UpdateNews(ctx, reqNews)
+		res, err := h.news.UpdateNews(ctx, update)
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[News HTTP][handleUpdateNews] Internal error from UpdateNews. Err: %s\n", err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return helper.Versioned[int64]{}, err
 		}
 
-		resChan <- struct{}{}
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case <-resChan:
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: newsID,
-		})
-	}
+		return helper.Versioned[int64]{Data: newsID, Version: res.UpdateTime}, nil
+	})
 }
 
-// parseNewsFromUpdateRequest returns news.News from the
-// given HTTP request object.
-func parseNewsFromUpdateRequest(nh newsHTTP, current news.News) (news.News, error) {
-	result := current
-
-	if nh.Title != nil {
-		result.Title = *nh.Title
-	}
-
-	if nh.GameID != nil {
-		result.GameID = *nh.GameID
-	}
-
-	if nh.Description != nil {
-		result.Description = *nh.Description
-	}
-
-	if nh.ImageNews != nil {
-		result.ImageNews = *nh.ImageNews
+// parseNewsFromUpdateRequest returns news.NewsUpdate from
+// the given HTTP request object, only the fields set in the
+// request are updated.
+func parseNewsFromUpdateRequest(nh newsHTTP) (news.NewsUpdate, error) {
+	result := news.NewsUpdate{
+		Title:       nh.Title,
+		GameID:      nh.GameID,
+		Description: nh.Description,
+		ImageNews:   nh.ImageNews,
 	}
 
 	if nh.Date != nil && *nh.Date != "" {
 		date, err := time.Parse(dateFormat, *nh.Date)
 		if err != nil {
-			return news.News{}, errInvalidTimeFormat
+			return news.NewsUpdate{}, errInvalidTimeFormat
 		}
-		result.Date = date
+		result.Date = &date
 	}
 
 	return result, nil
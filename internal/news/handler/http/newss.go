@@ -2,9 +2,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
-	"io/ioutil"
-	"log"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -32,102 +29,36 @@ func (h *newssHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *newssHandler) handleGetAllNews(w http.ResponseWriter, r *http.Request) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
-		if err != nil {
-			log.Printf("[News HTTP][handleGetAllNews] Failed to get all news. Err: %s\n", err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
-		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
-
-	// prepare channels for main go routine
-	resChan := make(chan []news.News, 1)
-	errChan := make(chan error, 1)
-
-	go func() {
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
-		}
-
+	helper.Serve(w, r, newEndpoint("handleGetAllNews"), func(ctx context.Context) ([]newsHTTP, error) {
 		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleGetAllNews")
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
+			return nil, err
 		}
 
 		// parsed filter
 		gameID, err := parseGetNewsFilter(r.URL.Query())
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- err
-			return
+			return nil, helper.NewHTTPError(http.StatusBadRequest, err)
 		}
 
-		res, err := h.news.GetAllNews(ctx, gameID)
+		res, err := h.news.GetAllNews(ctx, news.Filter{GameID: gameID})
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[News HTTP][handleGetAllGames] Internal error from GetAllNews. Err: %s\n", err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return nil, err
 		}
 
-		resChan <- res
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case res := <-resChan:
 		// format each news
 		news := make([]newsHTTP, 0)
 		for _, r := range res {
-			var m newsHTTP
-			m, err = formatNews(r)
+			v, err := formatNews(r)
 			if err != nil {
-				return
+				return nil, err
 			}
-			news = append(news, m)
+			news = append(news, v)
 		}
 
-		// construct response data
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: news,
-		})
-	}
+		return news, nil
+	})
 }
 
 func parseGetNewsFilter(request url.Values) (int64, error) {
@@ -144,107 +75,28 @@ func parseGetNewsFilter(request url.Values) (int64, error) {
 }
 
 func (h *newssHandler) handleCreateNews(w http.ResponseWriter, r *http.Request) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
-		if err != nil {
-			log.Printf("[News HTTP][handleCreateNews] Failed to create news. Err: %s\n", err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
-		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
-
-	// prepare channels for main go routine
-	resChan := make(chan int64, 1)
-	errChan := make(chan error, 1)
-
-	go func() {
-		// read request body
-		body, err := ioutil.ReadAll(r.Body)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
-		}
-
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
+	helper.Serve(w, r, newEndpoint("handleCreateNews"), func(ctx context.Context) (int64, error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
+			return 0, err
 		}
 
-		// unmarshall body
+		// decode request body
 		request := newsHTTP{}
-		err = json.Unmarshal(body, &request)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
-		}
-
-		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleCreateNews")
+		err = helper.DecodeJSON(r, &request)
 		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
+			return 0, err
 		}
 
 		// format HTTP request into service object
 		reqNews, err := parseNewsFromCreateRequest(request)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- err
-			return
+			return 0, helper.NewHTTPError(http.StatusBadRequest, err)
 		}
 
-		res, err := h.news.CreateNews(ctx, reqNews)
-		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[News HTTP][handleCreateNews] Internal error from CreateNews. Err: %s\n", err.Error())
-			}
-
-			errChan <- parsedErr
-			return
-		}
-
-		resChan <- res
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case newsID := <-resChan:
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: newsID,
-		})
-	}
+		return h.news.CreateNews(ctx, reqNews)
+	})
 }
 
 // parseNewsFromCreateRequest returns news.News from the
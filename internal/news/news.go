@@ -10,21 +10,21 @@ type Service interface {
 	// created news ID.
 	CreateNews(ctx context.Context, news News) (int64, error)
 
-	// GetAllNews returns all news and filter by game id.
-	GetAllNews(ctx context.Context, gameID int64) ([]News, error)
+	// GetAllNews returns all news that match the given
+	// filter, sorted from the latest date.
+	GetAllNews(ctx context.Context, filter Filter) ([]News, error)
 
 	// GetNewsByID returns a news with the given
 	// news ID.
 	GetNewsByID(ctx context.Context, newsID int64) (News, error)
 
-	// UpdateNews updates existing news with the given
-	// news data.
+	// UpdateNews updates the fields set in the given news
+	// update of an existing news, and returns the updated
+	// news.
 	//
-	// UpdateNews do updates on all main attributes
-	// except ID, CreateBy, and CreateTime. So, make sure to
-	// use current values in the given data if do not want
-	// to update some specific attributes.
-	UpdateNews(ctx context.Context, news News) error
+	// ErrVersionConflict is returned if the update has a
+	// version and the news has been updated since then.
+	UpdateNews(ctx context.Context, update NewsUpdate) (News, error)
 }
 
 type News struct {
@@ -39,3 +39,51 @@ type News struct {
 	CreateTime  time.Time
 	UpdateTime  time.Time
 }
+
+// NewsUpdate is a partial update of an existing news, only
+// its non-nil fields are updated.
+type NewsUpdate struct {
+	ID          int64
+	Title       *string
+	GameID      *int64
+	Description *string
+	ImageNews   *string
+	Date        *time.Time
+
+	// Version is the update time of the news the update is
+	// based on, zero value means no version check.
+	Version time.Time
+}
+
+// Apply returns the given news with the fields set in the
+// update applied.
+func (u NewsUpdate) Apply(n News) News {
+	if u.Title != nil {
+		n.Title = *u.Title
+	}
+	if u.GameID != nil {
+		n.GameID = *u.GameID
+	}
+	if u.Description != nil {
+		n.Description = *u.Description
+	}
+	if u.ImageNews != nil {
+		n.ImageNews = *u.ImageNews
+	}
+	if u.Date != nil {
+		n.Date = *u.Date
+	}
+
+	return n
+}
+
+// Filter denotes the filter used to get news, zero value
+// fields are not used to filter.
+type Filter struct {
+	// GameID filters news of the given game.
+	GameID int64
+
+	// Limit is the maximum number of news, zero to get all
+	// news.
+	Limit int
+}
@@ -3,10 +3,15 @@ package service
 import (
 	"context"
 
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/global/tracing"
 	"github.com/x-sports/internal/news"
 )
 
 func (s *service) CreateNews(ctx context.Context, reqNews news.News) (int64, error) {
+	ctx, span := tracing.Start(ctx, "news.Service.CreateNews")
+	defer span.End()
+
 	// validate field
 	err := validateNews(reqNews)
 	if err != nil {
@@ -15,13 +20,24 @@ func (s *service) CreateNews(ctx context.Context, reqNews news.News) (int64, err
 
 	reqNews.CreateTime = s.timeNow()
 
-	// get pg store client without using transaction
-	pgStoreClient, err := s.pgStore.NewClient(false)
-	if err != nil {
-		return 0, err
-	}
-
-	newsID, err := pgStoreClient.CreateNews(ctx, reqNews)
+	// create news and record the event in a transaction
+	var newsID int64
+	err = helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		var err error
+		newsID, err = pgStoreClient.CreateNews(ctx, reqNews)
+		if err != nil {
+			return err
+		}
+
+		// get created news to have the derived fields in
+		// the event
+		created, err := pgStoreClient.GetNewsByID(ctx, newsID)
+		if err != nil {
+			return err
+		}
+
+		return s.recordEvent(ctx, pgStoreClient, news.EventTypeCreated, created)
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -29,7 +45,10 @@ func (s *service) CreateNews(ctx context.Context, reqNews news.News) (int64, err
 	return newsID, nil
 }
 
-func (s *service) GetAllNews(ctx context.Context, gameID int64) ([]news.News, error) {
+func (s *service) GetAllNews(ctx context.Context, filter news.Filter) ([]news.News, error) {
+	ctx, span := tracing.Start(ctx, "news.Service.GetAllNews")
+	defer span.End()
+
 	// get pg store client without using transaction
 	pgStoreClient, err := s.pgStore.NewClient(false)
 	if err != nil {
@@ -37,7 +56,7 @@ func (s *service) GetAllNews(ctx context.Context, gameID int64) ([]news.News, er
 	}
 
 	// get all news from postgre
-	news, err := pgStoreClient.GetAllNews(ctx, gameID)
+	news, err := pgStoreClient.GetAllNews(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -45,32 +64,61 @@ func (s *service) GetAllNews(ctx context.Context, gameID int64) ([]news.News, er
 	return news, nil
 }
 
-func (s *service) UpdateNews(ctx context.Context, reqNews news.News) error {
-	// validate field
-	err := validateNews(reqNews)
-	if err != nil {
-		return err
-	}
-
-	// modify fields
-	reqNews.UpdateTime = s.timeNow()
+func (s *service) UpdateNews(ctx context.Context, update news.NewsUpdate) (news.News, error) {
+	ctx, span := tracing.Start(ctx, "news.Service.UpdateNews")
+	defer span.End()
 
-	// get pg store client using transaction
-	pgStoreClient, err := s.pgStore.NewClient(false)
-	if err != nil {
-		return err
+	// validate news id
+	if update.ID <= 0 {
+		return news.News{}, news.ErrInvalidNewsID
 	}
 
-	// updates news in pgstore
-	err = pgStoreClient.UpdateNews(ctx, reqNews)
+	// update news and record the event in a transaction
+	var result news.News
+	err := helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		// get news before the update to check its version
+		current, err := pgStoreClient.GetNewsByID(ctx, update.ID)
+		if err != nil {
+			return err
+		}
+		if !update.Version.IsZero() && !update.Version.Equal(current.UpdateTime) {
+			return news.ErrVersionConflict
+		}
+
+		// validate the news with the update applied
+		err = validateNews(update.Apply(current))
+		if err != nil {
+			return err
+		}
+
+		// updates news in pgstore, unless it is updated since
+		// it is read
+		update.Version = current.UpdateTime
+		err = pgStoreClient.UpdateNews(ctx, update, s.timeNow())
+		if err != nil {
+			return err
+		}
+
+		// get updated news to have the derived fields in
+		// the event
+		result, err = pgStoreClient.GetNewsByID(ctx, update.ID)
+		if err != nil {
+			return err
+		}
+
+		return s.recordEvent(ctx, pgStoreClient, news.EventTypeUpdated, result)
+	})
 	if err != nil {
-		return err
+		return news.News{}, err
 	}
 
-	return nil
+	return result, nil
 }
 
 func (s *service) GetNewsByID(ctx context.Context, newsID int64) (news.News, error) {
+	ctx, span := tracing.Start(ctx, "news.Service.GetNewsByID")
+	defer span.End()
+
 	// get pg store client without using transaction
 	pgStoreClient, err := s.pgStore.NewClient(false)
 	if err != nil {
@@ -87,27 +135,30 @@ func (s *service) GetNewsByID(ctx context.Context, newsID int64) (news.News, err
 }
 
 // validateNews validates fields of the given News
-// whether its comply the predetermined rules.
+// whether its comply the predetermined rules, all violations
+// are collected in a helper.ValidationError.
 func validateNews(reqNews news.News) error {
+	var verr helper.ValidationError
+
 	if reqNews.Title == "" {
-		return news.ErrInvalidTitle
+		verr.Add("title", helper.CodeRequired, news.ErrInvalidTitle)
 	}
 
 	if reqNews.GameID <= 0 {
-		return news.ErrInvalidGameID
+		verr.Add("game_id", helper.CodeRequired, news.ErrInvalidGameID)
 	}
 
 	if reqNews.Description == "" {
-		return news.ErrInvalidDescription
+		verr.Add("description", helper.CodeRequired, news.ErrInvalidDescription)
 	}
 
 	if reqNews.ImageNews == "" {
-		return news.ErrInvalidImageNews
+		verr.Add("image_news", helper.CodeRequired, news.ErrInvalidImageNews)
 	}
 
 	if reqNews.Date.IsZero() {
-		return news.ErrInvalidDate
+		verr.Add("date", helper.CodeRequired, news.ErrInvalidDate)
 	}
 
-	return nil
+	return verr.Err()
 }
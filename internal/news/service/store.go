@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/x-sports/internal/news"
+	"github.com/x-sports/internal/outbox"
 )
 
 // PGStore is the PostgreSQL store for admin service.
@@ -22,19 +24,24 @@ type PGStoreClient interface {
 	// created news ID.
 	CreateNews(ctx context.Context, news news.News) (int64, error)
 
-	// GetAllNews returns all news.
-	GetAllNews(ctx context.Context, gameID int64) ([]news.News, error)
+	// GetAllNews returns all news that match the given
+	// filter, sorted from the latest date.
+	GetAllNews(ctx context.Context, filter news.Filter) ([]news.News, error)
 
 	// GetNewsByID returns a news with the given
 	// news ID.
 	GetNewsByID(ctx context.Context, newsID int64) (news.News, error)
 
-	// UpdateNews updates existing news with the given
-	// news data.
+	// UpdateNews updates the fields set in the given news
+	// update of an existing news, and sets its update time
+	// to the given time.
 	//
-	// UpdateNews do updates on all main attributes
-	// except ID, CreateBy, and CreateTime. So, make sure to
-	// use current values in the given data if do not want
-	// to update some specific attributes.
-	UpdateNews(ctx context.Context, news news.News) error
+	// The news is only updated if its update time equals
+	// to the update version, otherwise ErrVersionConflict is
+	// returned, or ErrNewsNotFound if it does not exist.
+	UpdateNews(ctx context.Context, update news.NewsUpdate, updateTime time.Time) error
+
+	// CreateEvent records the given event in the outbox and
+	// return the created event ID.
+	CreateEvent(ctx context.Context, event outbox.Event) (int64, error)
 }
@@ -0,0 +1,55 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/x-sports/global/metrics"
+	"github.com/x-sports/internal/news"
+	"github.com/x-sports/internal/outbox"
+)
+
+func (sc *storeClient) CreateNews(ctx context.Context, news news.News) (int64, error) {
+	ctx, done := observe(ctx, "CreateNews")
+	result, err := sc.next.CreateNews(ctx, news)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetAllNews(ctx context.Context, filter news.Filter) ([]news.News, error) {
+	ctx, done := observe(ctx, "GetAllNews")
+	result, err := sc.next.GetAllNews(ctx, filter)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetNewsByID(ctx context.Context, newsID int64) (news.News, error) {
+	ctx, done := observe(ctx, "GetNewsByID")
+	result, err := sc.next.GetNewsByID(ctx, newsID)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) UpdateNews(ctx context.Context, update news.NewsUpdate, updateTime time.Time) error {
+	ctx, done := observe(ctx, "UpdateNews")
+	err := sc.next.UpdateNews(ctx, update, updateTime)
+	done(err)
+	return err
+}
+
+func (sc *storeClient) CreateEvent(ctx context.Context, event outbox.Event) (int64, error) {
+	ctx, done := observe(ctx, "CreateEvent")
+	result, err := sc.next.CreateEvent(ctx, event)
+	done(err)
+
+	// events created in a transaction may still be rolled back
+	if err == nil {
+		if sc.useTx {
+			sc.events = append(sc.events, event.Type)
+		} else {
+			metrics.CountEvents(event.Type)
+		}
+	}
+
+	return result, err
+}
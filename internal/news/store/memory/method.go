@@ -0,0 +1,139 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/x-sports/internal/memory"
+	"github.com/x-sports/internal/news"
+	"github.com/x-sports/internal/outbox"
+	outboxmemstore "github.com/x-sports/internal/outbox/store/memory"
+)
+
+func (sc *storeClient) CreateNews(ctx context.Context, reqNews news.News) (int64, error) {
+	var newsID int64
+	err := sc.c.Do(func(data *memory.Data) error {
+		newsID = data.News.NextID()
+
+		// update time defaults to the create time, game
+		// names and icons are derived when read
+		reqNews.ID = newsID
+		reqNews.GameNames = ""
+		reqNews.GameIcons = ""
+		reqNews.Date = memory.Time(reqNews.Date)
+		reqNews.CreateTime = memory.Time(reqNews.CreateTime)
+		reqNews.UpdateTime = reqNews.CreateTime
+		data.News.Put(newsID, reqNews)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return newsID, nil
+}
+
+func (sc *storeClient) GetAllNews(ctx context.Context, filter news.Filter) ([]news.News, error) {
+	result := make([]news.News, 0)
+	err := sc.c.Do(func(data *memory.Data) error {
+		for _, n := range data.News.All() {
+			n, ok := joinGame(data, n)
+			if !ok {
+				continue
+			}
+			if filter.GameID > 0 && n.GameID != filter.GameID {
+				continue
+			}
+
+			result = append(result, n)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// latest first, the ID keeps the order stable
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].Date.Equal(result[j].Date) {
+			return result[i].Date.After(result[j].Date)
+		}
+		return result[i].ID > result[j].ID
+	})
+
+	if filter.Limit > 0 && len(result) > filter.Limit {
+		result = result[:filter.Limit]
+	}
+
+	return result, nil
+}
+
+func (sc *storeClient) GetNewsByID(ctx context.Context, newsID int64) (news.News, error) {
+	var result news.News
+	err := sc.c.Do(func(data *memory.Data) error {
+		n, ok := data.News.Get(newsID)
+		if ok {
+			n, ok = joinGame(data, n)
+		}
+		if !ok {
+			return news.ErrNewsNotFound
+		}
+
+		result = n
+		return nil
+	})
+	if err != nil {
+		return news.News{}, err
+	}
+
+	return result, nil
+}
+
+func (sc *storeClient) UpdateNews(ctx context.Context, update news.NewsUpdate, updateTime time.Time) error {
+	return sc.c.Do(func(data *memory.Data) error {
+		// the news is not updated when its update time has
+		// changed
+		n, ok := data.News.Get(update.ID)
+		if !ok {
+			return news.ErrNewsNotFound
+		}
+		if !n.UpdateTime.Equal(update.Version) {
+			return news.ErrVersionConflict
+		}
+
+		n = update.Apply(n)
+		n.UpdateTime = memory.Time(updateTime)
+		data.News.Put(n.ID, n)
+
+		return nil
+	})
+}
+
+func (sc *storeClient) CreateEvent(ctx context.Context, reqEvent outbox.Event) (int64, error) {
+	var eventID int64
+	err := sc.c.Do(func(data *memory.Data) error {
+		eventID = outboxmemstore.CreateEvent(data, reqEvent)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return eventID, nil
+}
+
+// joinGame returns the given news with the names and icons of
+// its game, and false if the game does not exist.
+func joinGame(data *memory.Data, n news.News) (news.News, bool) {
+	g, ok := data.Games.Get(n.GameID)
+	if !ok {
+		return news.News{}, false
+	}
+
+	n.GameNames = g.GameNames
+	n.GameIcons = g.GameIcons
+	return n, true
+}
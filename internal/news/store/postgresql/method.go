@@ -2,14 +2,23 @@ package postgresql
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/x-sports/internal/news"
+	"github.com/x-sports/internal/outbox"
+	outboxpgstore "github.com/x-sports/internal/outbox/store/postgresql"
 )
 
 func (sc *storeClient) CreateNews(ctx context.Context, reqNews news.News) (int64, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	// construct arguments filled with fields for the query
 	argsKV := map[string]interface{}{
 		"title":       reqNews.Title,
@@ -33,7 +42,7 @@ func (sc *storeClient) CreateNews(ctx context.Context, reqNews news.News) (int64
 
 	// execute query
 	var newsID int64
-	err = sc.q.QueryRowx(query, args...).Scan(&newsID)
+	err = sc.q.QueryRowxContext(ctx, query, args...).Scan(&newsID)
 	if err != nil {
 		return 0, err
 	}
@@ -41,14 +50,18 @@ func (sc *storeClient) CreateNews(ctx context.Context, reqNews news.News) (int64
 	return newsID, nil
 }
 
-func (sc *storeClient) GetAllNews(ctx context.Context, gameID int64) ([]news.News, error) {
+func (sc *storeClient) GetAllNews(ctx context.Context, filter news.Filter) ([]news.News, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	// define variables to custom query
 	argsKV := make(map[string]interface{})
 	addConditions := make([]string, 0)
 
-	if gameID > 0 {
+	if filter.GameID > 0 {
 		addConditions = append(addConditions, "n.game_id = :game_id")
-		argsKV["game_id"] = gameID
+		argsKV["game_id"] = filter.GameID
 	}
 
 	// construct strings to custom query
@@ -59,6 +72,12 @@ func (sc *storeClient) GetAllNews(ctx context.Context, gameID int64) ([]news.New
 	if len(addConditions) > 0 {
 		addCondition = fmt.Sprintf("WHERE %s", addCondition)
 	}
+	addCondition = fmt.Sprintf("%s ORDER BY n.date DESC, n.id DESC", addCondition)
+
+	if filter.Limit > 0 {
+		addCondition = fmt.Sprintf("%s LIMIT :limit", addCondition)
+		argsKV["limit"] = filter.Limit
+	}
 	query := fmt.Sprintf(queryGetNews, addCondition)
 
 	// prepare query
@@ -73,7 +92,7 @@ func (sc *storeClient) GetAllNews(ctx context.Context, gameID int64) ([]news.New
 	query = sc.q.Rebind(query)
 
 	// query to database
-	rows, err := sc.q.Queryx(query, args...)
+	rows, err := sc.q.QueryxContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -98,21 +117,43 @@ func (sc *storeClient) GetAllNews(ctx context.Context, gameID int64) ([]news.New
 	return news, nil
 }
 
-func (sc *storeClient) UpdateNews(ctx context.Context, reqNews news.News) error {
+func (sc *storeClient) UpdateNews(ctx context.Context, update news.NewsUpdate, updateTime time.Time) error {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	// construct arguments filled with fields for the query
 	argsKV := map[string]interface{}{
-		"id":          reqNews.ID,
-		"title":       reqNews.Title,
-		"game_id":     reqNews.GameID,
-		"description": reqNews.Description,
-		"image_news":  reqNews.ImageNews,
-		"date":        reqNews.Date,
-		"create_time": reqNews.CreateTime,
-		"update_time": reqNews.UpdateTime,
+		"id":          update.ID,
+		"version":     sql.NullTime{Time: update.Version, Valid: !update.Version.IsZero()},
+		"update_time": updateTime,
+	}
+
+	// only set the columns of the fields set in the update
+	sets := []string{"update_time = :update_time"}
+	if update.Title != nil {
+		sets = append(sets, "title = :title")
+		argsKV["title"] = *update.Title
+	}
+	if update.GameID != nil {
+		sets = append(sets, "game_id = :game_id")
+		argsKV["game_id"] = *update.GameID
+	}
+	if update.Description != nil {
+		sets = append(sets, "description = :description")
+		argsKV["description"] = *update.Description
+	}
+	if update.ImageNews != nil {
+		sets = append(sets, "image_news = :image_news")
+		argsKV["image_news"] = *update.ImageNews
+	}
+	if update.Date != nil {
+		sets = append(sets, "date = :date")
+		argsKV["date"] = *update.Date
 	}
 
 	// prepare query
-	query, args, err := sqlx.Named(queryUpdateNews, argsKV)
+	query, args, err := sqlx.Named(fmt.Sprintf(queryUpdateNews, strings.Join(sets, ",\n\t\t")), argsKV)
 	if err != nil {
 		return err
 	}
@@ -123,19 +164,52 @@ func (sc *storeClient) UpdateNews(ctx context.Context, reqNews news.News) error
 	query = sc.q.Rebind(query)
 
 	// execute query
-	_, err = sc.q.Exec(query, args...)
-	return err
+	res, err := sc.q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	// check whether the news is updated, it is not when
+	// it does not exist or its update time has changed
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		_, err = sc.GetNewsByID(ctx, update.ID)
+		if err != nil {
+			return err
+		}
+		return news.ErrVersionConflict
+	}
+
+	return nil
 }
 
 func (sc *storeClient) GetNewsByID(ctx context.Context, newsID int64) (news.News, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	query := fmt.Sprintf(queryGetNews, "WHERE n.id = $1")
 
 	// query single row
 	var ndb newsDB
-	err := sc.q.QueryRowx(query, newsID).StructScan(&ndb)
+	err := sc.q.QueryRowxContext(ctx, query, newsID).StructScan(&ndb)
+	if errors.Is(err, sql.ErrNoRows) {
+		return news.News{}, news.ErrNewsNotFound
+	}
 	if err != nil {
 		return news.News{}, err
 	}
 
 	return ndb.format(), nil
 }
+
+func (sc *storeClient) CreateEvent(ctx context.Context, reqEvent outbox.Event) (int64, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	return outboxpgstore.CreateEvent(ctx, sc.q, reqEvent)
+}
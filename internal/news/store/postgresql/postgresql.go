@@ -16,25 +16,29 @@ var (
 
 // store implements news/service.PGStore
 type store struct {
-	db *sqlx.DB
+	db           *sqlx.DB
+	queryTimeout time.Duration
 }
 
 // storeClient implements news/service.PGStoreClient
 type storeClient struct {
-	q sqlx.Ext
+	q            sqlx.ExtContext
+	queryTimeout time.Duration
 }
 
-// New creates a new store.
-func New(db *sqlx.DB) (*store, error) {
+// New creates a new store whose queries are limited to the
+// given timeout.
+func New(db *sqlx.DB, queryTimeout time.Duration) (*store, error) {
 	s := &store{
-		db: db,
+		db:           db,
+		queryTimeout: queryTimeout,
 	}
 
 	return s, nil
 }
 
 func (s *store) NewClient(useTx bool) (service.PGStoreClient, error) {
-	var q sqlx.Ext
+	var q sqlx.ExtContext
 
 	// determine what object should be use as querier
 	q = s.db
@@ -47,7 +51,8 @@ func (s *store) NewClient(useTx bool) (service.PGStoreClient, error) {
 	}
 
 	return &storeClient{
-		q: q,
+		q:            q,
+		queryTimeout: s.queryTimeout,
 	}, nil
 }
 
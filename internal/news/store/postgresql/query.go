@@ -9,13 +9,15 @@ const queryCreateNews = `
 		description,
 		image_news,
 		date,
-		create_time
+		create_time,
+		update_time
 	) VALUES (
 		:title,
 		:game_id,
 		:description,
 		:image_news,
 		:date,
+		:create_time,
 		:create_time
 	)  RETURNING
 		id
@@ -46,13 +48,8 @@ const queryUpdateNews = `
 	UPDATE
 		news
 	SET
-		title = :title,
-		game_id = :game_id,
-		description = :description,
-		image_news = :image_news,
-		date = :date,
-		create_time = :create_time,
-		update_time = :update_time
+		%s
 	WHERE
-		id = :id
+		id = :id AND
+		update_time IS NOT DISTINCT FROM :version
 `
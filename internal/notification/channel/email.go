@@ -0,0 +1,66 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+	"strings"
+
+	"github.com/x-sports/internal/notification"
+)
+
+// Sender sends a raw email message to the given recipients.
+type Sender interface {
+	SendMail(from string, to []string, msg []byte) error
+}
+
+// SMTPSender implements Sender using a SMTP server.
+type SMTPSender struct {
+	Addr string
+	Auth smtp.Auth
+}
+
+// SendMail sends the message through the SMTP server.
+func (s SMTPSender) SendMail(from string, to []string, msg []byte) error {
+	return smtp.SendMail(s.Addr, s.Auth, from, to, msg)
+}
+
+// Email implements notification/service.Channel to send
+// notifications by email.
+type Email struct {
+	sender Sender
+	from   string
+}
+
+// NewEmail creates a new email channel sending messages from
+// the given address using the given sender.
+func NewEmail(sender Sender, from string) *Email {
+	return &Email{
+		sender: sender,
+		from:   from,
+	}
+}
+
+func (c *Email) Name() string {
+	return "email"
+}
+
+func (c *Email) Enabled(preference notification.Preference) bool {
+	return preference.EmailEnabled && preference.Email != ""
+}
+
+func (c *Email) Send(ctx context.Context, preference notification.Preference, n notification.Notification) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// construct message with minimal headers
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", c.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", preference.Email)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", n.Title)
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=UTF-8\r\n")
+	fmt.Fprintf(&msg, "\r\n%s\r\n", n.Message)
+
+	return c.sender.SendMail(c.from, []string{preference.Email}, []byte(msg.String()))
+}
@@ -0,0 +1,75 @@
+package channel
+
+import (
+	"context"
+	"sync"
+
+	"github.com/x-sports/internal/notification"
+)
+
+// Fake implements notification/service.Channel by keeping
+// the sent notifications in memory. It is meant for local
+// runs and tests.
+type Fake struct {
+	mu       sync.Mutex
+	sent     []notification.Notification
+	err      error
+	disabled bool
+}
+
+// NewFake creates a new fake channel, enabled for every
+// preference.
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+func (c *Fake) Name() string {
+	return "fake"
+}
+
+func (c *Fake) Enabled(preference notification.Preference) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return !c.disabled
+}
+
+func (c *Fake) Send(ctx context.Context, preference notification.Preference, n notification.Notification) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err != nil {
+		return c.err
+	}
+
+	c.sent = append(c.sent, n)
+	return nil
+}
+
+// Sent returns the notifications sent so far.
+func (c *Fake) Sent() []notification.Notification {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sent := make([]notification.Notification, len(c.sent))
+	copy(sent, c.sent)
+	return sent
+}
+
+// SetError makes the following Send calls return the given
+// error, nil to make them succeed again.
+func (c *Fake) SetError(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.err = err
+}
+
+// SetEnabled enables or disables the channel for every
+// preference.
+func (c *Fake) SetEnabled(enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.disabled = !enabled
+}
@@ -0,0 +1,81 @@
+package channel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/x-sports/internal/notification"
+)
+
+// Webhook implements notification/service.Channel to send
+// notifications to the webhook URL set by the user.
+type Webhook struct {
+	client *http.Client
+}
+
+// NewWebhook creates a new webhook channel using the given
+// HTTP client. A client with a default timeout is used if
+// the given client is nil.
+func NewWebhook(client *http.Client) *Webhook {
+	if client == nil {
+		client = &http.Client{
+			Timeout: 5 * time.Second,
+		}
+	}
+
+	return &Webhook{
+		client: client,
+	}
+}
+
+// webhookPayload is the JSON body posted to the webhook URL.
+type webhookPayload struct {
+	Type       string `json:"type"`
+	Title      string `json:"title"`
+	Message    string `json:"message"`
+	MatchID    int64  `json:"match_id"`
+	CreateTime string `json:"create_time"`
+}
+
+func (c *Webhook) Name() string {
+	return "webhook"
+}
+
+func (c *Webhook) Enabled(preference notification.Preference) bool {
+	return preference.WebhookEnabled && preference.WebhookURL != ""
+}
+
+func (c *Webhook) Send(ctx context.Context, preference notification.Preference, n notification.Notification) error {
+	body, err := json.Marshal(webhookPayload{
+		Type:       n.Type.String(),
+		Title:      n.Title,
+		Message:    n.Message,
+		MatchID:    n.MatchID,
+		CreateTime: n.CreateTime.Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, preference.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+
+	return nil
+}
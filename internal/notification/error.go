@@ -0,0 +1,25 @@
+package notification
+
+import "errors"
+
+var (
+	// ErrInvalidNotificationID is returned when the given
+	// notification id is invalid.
+	ErrInvalidNotificationID = errors.New("invalid notification id")
+
+	// ErrInvalidUserID is returned when the given user id is
+	// invalid.
+	ErrInvalidUserID = errors.New("invalid user id")
+
+	// ErrInvalidEmail is returned when the given email is
+	// invalid.
+	ErrInvalidEmail = errors.New("invalid email")
+
+	// ErrInvalidWebhookURL is returned when the given webhook
+	// url is invalid.
+	ErrInvalidWebhookURL = errors.New("invalid webhook url")
+
+	// ErrNotificationNotFound is returned when the
+	// notification with the given ID does not exist.
+	ErrNotificationNotFound = errors.New("notification not found")
+)
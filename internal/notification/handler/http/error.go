@@ -0,0 +1,76 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/notification"
+)
+
+// Followings are the known errors from Notification HTTP
+// handlers.
+var (
+	// errInvalidNotificationID is returned when the given
+	// notification ID is invalid.
+	errInvalidNotificationID = errors.New("INVALID_NOTIFICATION_ID")
+
+	// errInvalidUserID is returned when the given user ID is
+	// invalid.
+	errInvalidUserID = errors.New("INVALID_USER_ID")
+
+	// errInvalidEmail is returned when the given email is
+	// invalid.
+	errInvalidEmail = errors.New("INVALID_EMAIL")
+
+	// errInvalidWebhookURL is returned when the given webhook
+	// URL is invalid.
+	errInvalidWebhookURL = errors.New("INVALID_WEBHOOK_URL")
+
+	// errMethodNotAllowed is returned when accessing not
+	// allowed HTTP method.
+	errMethodNotAllowed = errors.New("METHOD_NOT_ALLOWED")
+
+	// errNotificationNotFound is returned when the
+	// notification with the given ID does not exist.
+	errNotificationNotFound = errors.New("NOTIFICATION_NOT_FOUND")
+
+	// errQueryTimeout is returned when a database query has
+	// reached its timeout limit.
+	errQueryTimeout = errors.New("QUERY_TIMEOUT")
+)
+
+var (
+	// mapHTTPError maps service error into HTTP error that
+	// categorize as bad request error, unless its status code
+	// is set in mapHTTPStatus.
+	//
+	// Internal server error-related should not be mapped
+	// here, and the handler should just return `errInternal`
+	// as the error instead
+	mapHTTPError = map[error]error{
+		notification.ErrInvalidNotificationID: errInvalidNotificationID,
+		notification.ErrInvalidUserID:         errInvalidUserID,
+		notification.ErrInvalidEmail:          errInvalidEmail,
+		notification.ErrInvalidWebhookURL:     errInvalidWebhookURL,
+		notification.ErrNotificationNotFound:  errNotificationNotFound,
+		helper.ErrQueryTimeout:                errQueryTimeout,
+	}
+
+	// mapHTTPStatus maps HTTP error into its status code when
+	// it is not a bad request error.
+	mapHTTPStatus = map[error]int{
+		errQueryTimeout:         http.StatusGatewayTimeout,
+		errNotificationNotFound: http.StatusNotFound,
+	}
+)
+
+// newEndpoint returns helper.Endpoint of the handler with the
+// given name.
+func newEndpoint(name string) helper.Endpoint {
+	return helper.Endpoint{
+		Name:      "[Notification HTTP][" + name + "]",
+		MapError:  mapHTTPError,
+		MapStatus: mapHTTPStatus,
+	}
+}
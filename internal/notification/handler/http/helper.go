@@ -0,0 +1,34 @@
+package http
+
+import "github.com/x-sports/internal/notification"
+
+// formatNotification formats the given notification into
+// the respective HTTP-format object.
+func formatNotification(n notification.Notification) (notificationHTTP, error) {
+	notificationType := n.Type.String()
+	createTime := n.CreateTime.Format(dateFormat)
+
+	return notificationHTTP{
+		ID:         &n.ID,
+		Type:       &notificationType,
+		Title:      &n.Title,
+		Message:    &n.Message,
+		MatchID:    &n.MatchID,
+		IsRead:     &n.IsRead,
+		CreateTime: &createTime,
+	}, nil
+}
+
+// formatPreference formats the given preference into the
+// respective HTTP-format object.
+func formatPreference(p notification.Preference) (preferenceHTTP, error) {
+	return preferenceHTTP{
+		InApp:          &p.InApp,
+		EmailEnabled:   &p.EmailEnabled,
+		Email:          &p.Email,
+		WebhookEnabled: &p.WebhookEnabled,
+		WebhookURL:     &p.WebhookURL,
+		MatchStart:     &p.MatchStart,
+		MatchResult:    &p.MatchResult,
+	}, nil
+}
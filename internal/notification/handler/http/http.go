@@ -0,0 +1,148 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/notification"
+)
+
+var (
+	errUnknownConfig = errors.New("unknown config name")
+)
+
+// dateFormat denotes the standard date format used in
+// notification HTTP request and response.
+var dateFormat = "2006-01-02 15:04:05 -07:00"
+
+// Handler contains notification HTTP-handlers.
+type Handler struct {
+	handlers     map[string]*handler
+	notification notification.Service
+	admin        admin.Service
+}
+
+// handler is the HTTP handler wrapper.
+type handler struct {
+	h        http.Handler
+	identity HandlerIdentity
+}
+
+// HandlerIdentity denotes the identity of an HTTP hanlder.
+type HandlerIdentity struct {
+	Name string
+	URL  string
+}
+
+// Followings are the known HTTP handler identities
+var (
+	// HandlerNotification denotes HTTP handler to interact
+	// with a notification
+	HandlerNotification = HandlerIdentity{
+		Name: "notification",
+		URL:  "/notifications/{id:[0-9]+}",
+	}
+
+	// HandlerNotifications denotes HTTP handler to interact
+	// with notifications
+	HandlerNotifications = HandlerIdentity{
+		Name: "notifications",
+		URL:  "/notifications",
+	}
+
+	// HandlerPreference denotes HTTP handler to interact
+	// with a notification preference
+	HandlerPreference = HandlerIdentity{
+		Name: "preference",
+		URL:  "/notifications/preference",
+	}
+)
+
+// New creates a new Handler.
+func New(notification notification.Service, admin admin.Service, identities []HandlerIdentity) (*Handler, error) {
+	h := &Handler{
+		handlers:     make(map[string]*handler),
+		notification: notification,
+		admin:        admin,
+	}
+
+	// apply options
+	for _, identity := range identities {
+		if h.handlers == nil {
+			h.handlers = map[string]*handler{}
+		}
+
+		h.handlers[identity.Name] = &handler{
+			identity: identity,
+		}
+
+		handler, err := h.createHTTPHandler(identity.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		h.handlers[identity.Name].h = handler
+	}
+
+	return h, nil
+}
+
+// createHTTPHandler creates a new HTTP handler that
+// implements http.Handler.
+func (h *Handler) createHTTPHandler(configName string) (http.Handler, error) {
+	var httpHandler http.Handler
+	switch configName {
+	case HandlerNotification.Name:
+		httpHandler = &notificationHandler{
+			notification: h.notification,
+			admin:        h.admin,
+		}
+	case HandlerNotifications.Name:
+		httpHandler = &notificationsHandler{
+			notification: h.notification,
+			admin:        h.admin,
+		}
+	case HandlerPreference.Name:
+		httpHandler = &preferenceHandler{
+			notification: h.notification,
+			admin:        h.admin,
+		}
+	default:
+		return httpHandler, errUnknownConfig
+	}
+	return httpHandler, nil
+}
+
+// Start starts all HTTP handlers.
+func (h *Handler) Start(multiplexer *mux.Router) error {
+	for _, handler := range h.handlers {
+		multiplexer.Handle(handler.identity.URL, handler.h).Name(handler.identity.Name)
+	}
+	return nil
+}
+
+// notificationHTTP denotes notification object in HTTP
+// response body.
+type notificationHTTP struct {
+	ID         *int64  `json:"id"`
+	Type       *string `json:"type"`
+	Title      *string `json:"title"`
+	Message    *string `json:"message"`
+	MatchID    *int64  `json:"match_id"`
+	IsRead     *bool   `json:"is_read"`
+	CreateTime *string `json:"create_time"`
+}
+
+// preferenceHTTP denotes notification preference object in
+// HTTP request or response body.
+type preferenceHTTP struct {
+	InApp          *bool   `json:"in_app"`
+	EmailEnabled   *bool   `json:"email_enabled"`
+	Email          *string `json:"email"`
+	WebhookEnabled *bool   `json:"webhook_enabled"`
+	WebhookURL     *string `json:"webhook_url"`
+	MatchStart     *bool   `json:"match_start"`
+	MatchResult    *bool   `json:"match_result"`
+}
@@ -0,0 +1,52 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/notification"
+)
+
+type notificationHandler struct {
+	notification notification.Service
+	admin        admin.Service
+}
+
+func (h *notificationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	notificationID, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		slog.InfoContext(r.Context(), "failed to parse notification ID", slog.String("handler", "[Notification HTTP][notificationHandler]"), slog.String("id", vars["id"]), slog.String("error", err.Error()))
+		helper.WriteErrorResponse(w, http.StatusBadRequest, []string{errInvalidNotificationID.Error()})
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPatch:
+		h.handleReadNotification(w, r, notificationID)
+	default:
+		helper.WriteErrorResponse(w, http.StatusMethodNotAllowed, []string{errMethodNotAllowed.Error()})
+	}
+}
+
+func (h *notificationHandler) handleReadNotification(w http.ResponseWriter, r *http.Request, notificationID int64) {
+	helper.Serve(w, r, newEndpoint("handleReadNotification"), func(ctx context.Context) (int64, error) {
+		// check access token
+		tokenData, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return 0, err
+		}
+
+		err = h.notification.ReadNotification(ctx, tokenData.AdminID, notificationID)
+		if err != nil {
+			return 0, err
+		}
+
+		return notificationID, nil
+	})
+}
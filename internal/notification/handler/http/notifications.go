@@ -0,0 +1,51 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/notification"
+)
+
+type notificationsHandler struct {
+	notification notification.Service
+	admin        admin.Service
+}
+
+func (h *notificationsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.handleGetNotifications(w, r)
+	default:
+		helper.WriteErrorResponse(w, http.StatusMethodNotAllowed, []string{errMethodNotAllowed.Error()})
+	}
+}
+
+func (h *notificationsHandler) handleGetNotifications(w http.ResponseWriter, r *http.Request) {
+	helper.Serve(w, r, newEndpoint("handleGetNotifications"), func(ctx context.Context) ([]notificationHTTP, error) {
+		// check access token
+		tokenData, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := h.notification.GetNotifications(ctx, tokenData.AdminID)
+		if err != nil {
+			return nil, err
+		}
+
+		// format each notifications
+		notifications := make([]notificationHTTP, 0)
+		for _, r := range res {
+			n, err := formatNotification(r)
+			if err != nil {
+				return nil, err
+			}
+			notifications = append(notifications, n)
+		}
+
+		return notifications, nil
+	})
+}
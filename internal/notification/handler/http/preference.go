@@ -0,0 +1,113 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/notification"
+)
+
+type preferenceHandler struct {
+	notification notification.Service
+	admin        admin.Service
+}
+
+func (h *preferenceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.handleGetPreference(w, r)
+	case http.MethodPatch:
+		h.handleUpdatePreference(w, r)
+	default:
+		helper.WriteErrorResponse(w, http.StatusMethodNotAllowed, []string{errMethodNotAllowed.Error()})
+	}
+}
+
+func (h *preferenceHandler) handleGetPreference(w http.ResponseWriter, r *http.Request) {
+	helper.Serve(w, r, newEndpoint("handleGetPreference"), func(ctx context.Context) (preferenceHTTP, error) {
+		// check access token
+		tokenData, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return preferenceHTTP{}, err
+		}
+
+		res, err := h.notification.GetPreference(ctx, tokenData.AdminID)
+		if err != nil {
+			return preferenceHTTP{}, err
+		}
+
+		return formatPreference(res)
+	})
+}
+
+func (h *preferenceHandler) handleUpdatePreference(w http.ResponseWriter, r *http.Request) {
+	helper.Serve(w, r, newEndpoint("handleUpdatePreference"), func(ctx context.Context) (int64, error) {
+		// check access token
+		tokenData, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return 0, err
+		}
+
+		// decode request body
+		request := preferenceHTTP{}
+		err = helper.DecodeJSON(r, &request)
+		if err != nil {
+			return 0, err
+		}
+
+		// get current preference
+		current, err := h.notification.GetPreference(ctx, tokenData.AdminID)
+		if err != nil {
+			return 0, err
+		}
+
+		// format HTTP request into service object
+		reqPreference := parsePreferenceFromUpdateRequest(request, current)
+
+		err = h.notification.UpdatePreference(ctx, reqPreference)
+		if err != nil {
+			return 0, err
+		}
+
+		return tokenData.AdminID, nil
+	})
+}
+
+// parsePreferenceFromUpdateRequest returns
+// notification.Preference from the given HTTP request
+// object.
+func parsePreferenceFromUpdateRequest(ph preferenceHTTP, current notification.Preference) notification.Preference {
+	result := current
+
+	if ph.InApp != nil {
+		result.InApp = *ph.InApp
+	}
+
+	if ph.EmailEnabled != nil {
+		result.EmailEnabled = *ph.EmailEnabled
+	}
+
+	if ph.Email != nil {
+		result.Email = *ph.Email
+	}
+
+	if ph.WebhookEnabled != nil {
+		result.WebhookEnabled = *ph.WebhookEnabled
+	}
+
+	if ph.WebhookURL != nil {
+		result.WebhookURL = *ph.WebhookURL
+	}
+
+	if ph.MatchStart != nil {
+		result.MatchStart = *ph.MatchStart
+	}
+
+	if ph.MatchResult != nil {
+		result.MatchResult = *ph.MatchResult
+	}
+
+	return result
+}
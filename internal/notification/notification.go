@@ -0,0 +1,132 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/outbox"
+)
+
+type Service interface {
+	// NotifyMatchUpdated notifies users following the game
+	// or the teams of the given match when the match status
+	// transitions, e.g. the match starts or has a result.
+	// A notification is delivered once per user and channel,
+	// even if called again for the same transition. Failing
+	// users or channels are logged, an error is only returned
+	// when the followers cannot be found.
+	NotifyMatchUpdated(ctx context.Context, previous match.Match, current match.Match) error
+
+	// HandleEvent handles match events delivered from the
+	// outbox by notifying the status transition.
+	HandleEvent(ctx context.Context, event outbox.Event) error
+
+	// GetNotifications returns all in-app notifications of
+	// the given user.
+	GetNotifications(ctx context.Context, userID int64) ([]Notification, error)
+
+	// ReadNotification marks a notification with the given
+	// notification ID owned by the given user as read.
+	ReadNotification(ctx context.Context, userID int64, notificationID int64) error
+
+	// GetPreference returns notification preference of the
+	// given user. Default preference is returned if the
+	// user has not set any.
+	GetPreference(ctx context.Context, userID int64) (Preference, error)
+
+	// UpdatePreference updates notification preference of
+	// the user with the given preference data.
+	UpdatePreference(ctx context.Context, preference Preference) error
+}
+
+// Notification denotes a message sent to a user.
+type Notification struct {
+	ID         int64
+	UserID     int64
+	Type       Type
+	Title      string
+	Message    string
+	MatchID    int64
+	IsRead     bool
+	CreateTime time.Time
+	ReadTime   time.Time
+}
+
+// Delivery denotes a match notification delivered to a user
+// through a channel. It is recorded so that the notification
+// is not delivered again when the match event is retried.
+type Delivery struct {
+	UserID     int64
+	MatchID    int64
+	Type       Type
+	Channel    string
+	CreateTime time.Time
+}
+
+// Preference denotes how a user wants to be notified.
+type Preference struct {
+	UserID         int64
+	InApp          bool
+	EmailEnabled   bool
+	Email          string
+	WebhookEnabled bool
+	WebhookURL     string
+	MatchStart     bool
+	MatchResult    bool
+	CreateTime     time.Time
+	UpdateTime     time.Time
+}
+
+// DefaultPreference returns preference used for users who
+// have not set any.
+func DefaultPreference(userID int64) Preference {
+	return Preference{
+		UserID:      userID,
+		InApp:       true,
+		MatchStart:  true,
+		MatchResult: true,
+	}
+}
+
+// Allows reports whether the preference allows the given
+// notification type to be sent.
+func (p Preference) Allows(t Type) bool {
+	switch t {
+	case TypeMatchStart:
+		return p.MatchStart
+	case TypeMatchResult:
+		return p.MatchResult
+	}
+	return false
+}
+
+// Type denotes type of a notification.
+type Type int
+
+// Followings are the known notification types.
+const (
+	TypeUnknown     Type = 0
+	TypeMatchStart  Type = 1
+	TypeMatchResult Type = 2
+)
+
+var (
+	// typeName maps notification type to it's string
+	// representation.
+	typeName = map[Type]string{
+		TypeMatchStart:  "match_start",
+		TypeMatchResult: "match_result",
+	}
+)
+
+// Value returns int value of a notification type.
+func (t Type) Value() int {
+	return int(t)
+}
+
+// String returns string representaion of a notification
+// type.
+func (t Type) String() string {
+	return typeName[t]
+}
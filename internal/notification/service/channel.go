@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	"github.com/x-sports/internal/notification"
+)
+
+// Channel delivers notifications to users, e.g. through
+// in-app inbox, email, or webhook.
+type Channel interface {
+	// Name returns the name of the channel.
+	Name() string
+
+	// Enabled reports whether the given user preference
+	// allows notifications to be sent through the channel.
+	Enabled(preference notification.Preference) bool
+
+	// Send sends the given notification to the user owning
+	// the given preference.
+	Send(ctx context.Context, preference notification.Preference, n notification.Notification) error
+}
+
+// inboxChannel implements Channel by storing notifications
+// in the PostgreSQL store, so it can be read in-app.
+type inboxChannel struct {
+	pgStore PGStore
+}
+
+func (c *inboxChannel) Name() string {
+	return "inbox"
+}
+
+func (c *inboxChannel) Enabled(preference notification.Preference) bool {
+	return preference.InApp
+}
+
+func (c *inboxChannel) Send(ctx context.Context, preference notification.Preference, n notification.Notification) error {
+	// get pg store client without using transaction
+	pgStoreClient, err := c.pgStore.NewClient(false)
+	if err != nil {
+		return err
+	}
+
+	_, err = pgStoreClient.CreateNotification(ctx, n)
+	return err
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+
+	"github.com/x-sports/global/tracing"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/outbox"
+)
+
+func (s *service) HandleEvent(ctx context.Context, event outbox.Event) error {
+	ctx, span := tracing.Start(ctx, "notification.Service.HandleEvent")
+	defer span.End()
+
+	switch event.Type {
+	case match.EventTypeUpdated:
+		var data match.UpdatedEvent
+		err := event.Decode(&data)
+		if err != nil {
+			return err
+		}
+
+		return s.NotifyMatchUpdated(ctx, data.Previous, data.Current)
+	}
+
+	return outbox.ErrUnknownEventType
+}
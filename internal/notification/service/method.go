@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"net/url"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/global/tracing"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/notification"
+)
+
+func (s *service) NotifyMatchUpdated(ctx context.Context, previous match.Match, current match.Match) error {
+	ctx, span := tracing.Start(ctx, "notification.Service.NotifyMatchUpdated")
+	defer span.End()
+
+	// only status transitions are notified
+	n, ok := buildMatchNotification(previous, current)
+	if !ok {
+		return nil
+	}
+	n.CreateTime = s.timeNow()
+
+	// get pg store client without using transaction
+	pgStoreClient, err := s.pgStore.NewClient(false)
+	if err != nil {
+		return err
+	}
+
+	// get users following the match
+	userIDs, err := pgStoreClient.GetFollowerIDs(ctx, current.GameID, []int64{current.TeamAID, current.TeamBID})
+	if err != nil {
+		return err
+	}
+
+	// deliver to every user through the enabled channels the
+	// notification has not been delivered through yet, so that
+	// a retried event is not delivered twice. Failures of a
+	// user or channel are logged instead of returned, so that
+	// they neither prevent the others nor retry the event.
+	for _, userID := range userIDs {
+		s.notifyUser(ctx, pgStoreClient, userID, n)
+	}
+
+	return nil
+}
+
+// notifyUser delivers the given notification to the given user
+// through the channels allowed by the user preference, logging
+// the failures.
+func (s *service) notifyUser(ctx context.Context, pgStoreClient PGStoreClient, userID int64, n notification.Notification) {
+	logFailure := func(msg string, err error, attrs ...slog.Attr) {
+		tracing.RecordError(ctx, err)
+		attrs = append(attrs, slog.Int64("user_id", userID), slog.Int64("match_id", n.MatchID), slog.String("error", err.Error()))
+		slog.LogAttrs(ctx, slog.LevelError, msg, attrs...)
+	}
+
+	preference, err := pgStoreClient.GetPreference(ctx, userID)
+	if err != nil {
+		logFailure("failed to get notification preference", err)
+		return
+	}
+
+	if !preference.Allows(n.Type) {
+		return
+	}
+
+	channels, err := pgStoreClient.GetDeliveredChannels(ctx, userID, n.MatchID, n.Type)
+	if err != nil {
+		logFailure("failed to get delivered notification channels", err)
+		return
+	}
+
+	delivered := make(map[string]bool, len(channels))
+	for _, channel := range channels {
+		delivered[channel] = true
+	}
+
+	n.UserID = userID
+	for _, channel := range s.channels {
+		if delivered[channel.Name()] || !channel.Enabled(preference) {
+			continue
+		}
+
+		err = channel.Send(ctx, preference, n)
+		if err != nil {
+			logFailure("failed to send notification", err, slog.String("channel", channel.Name()))
+			continue
+		}
+
+		err = pgStoreClient.CreateDelivery(ctx, notification.Delivery{
+			UserID:     userID,
+			MatchID:    n.MatchID,
+			Type:       n.Type,
+			Channel:    channel.Name(),
+			CreateTime: s.timeNow(),
+		})
+		if err != nil {
+			logFailure("failed to record notification delivery", err, slog.String("channel", channel.Name()))
+		}
+	}
+}
+
+func (s *service) GetNotifications(ctx context.Context, userID int64) ([]notification.Notification, error) {
+	ctx, span := tracing.Start(ctx, "notification.Service.GetNotifications")
+	defer span.End()
+
+	// validate user id
+	if userID <= 0 {
+		return nil, notification.ErrInvalidUserID
+	}
+
+	// get pg store client without using transaction
+	pgStoreClient, err := s.pgStore.NewClient(false)
+	if err != nil {
+		return nil, err
+	}
+
+	// get all notifications from postgre
+	notifications, err := pgStoreClient.GetNotifications(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (s *service) ReadNotification(ctx context.Context, userID int64, notificationID int64) error {
+	ctx, span := tracing.Start(ctx, "notification.Service.ReadNotification")
+	defer span.End()
+
+	// validate ids
+	if userID <= 0 {
+		return notification.ErrInvalidUserID
+	}
+
+	if notificationID <= 0 {
+		return notification.ErrInvalidNotificationID
+	}
+
+	// updates notification in pgstore in a transaction
+	return helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		return pgStoreClient.ReadNotification(ctx, userID, notificationID, s.timeNow())
+	})
+}
+
+func (s *service) GetPreference(ctx context.Context, userID int64) (notification.Preference, error) {
+	ctx, span := tracing.Start(ctx, "notification.Service.GetPreference")
+	defer span.End()
+
+	// validate user id
+	if userID <= 0 {
+		return notification.Preference{}, notification.ErrInvalidUserID
+	}
+
+	// get pg store client without using transaction
+	pgStoreClient, err := s.pgStore.NewClient(false)
+	if err != nil {
+		return notification.Preference{}, err
+	}
+
+	// get preference from pgstore
+	result, err := pgStoreClient.GetPreference(ctx, userID)
+	if err != nil {
+		return notification.Preference{}, err
+	}
+
+	return result, nil
+}
+
+func (s *service) UpdatePreference(ctx context.Context, reqPreference notification.Preference) error {
+	ctx, span := tracing.Start(ctx, "notification.Service.UpdatePreference")
+	defer span.End()
+
+	// validate field
+	err := validatePreference(reqPreference)
+	if err != nil {
+		return err
+	}
+
+	// modify fields
+	reqPreference.CreateTime = s.timeNow()
+	reqPreference.UpdateTime = s.timeNow()
+
+	// updates preference in pgstore in a transaction
+	return helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		return pgStoreClient.UpsertPreference(ctx, reqPreference)
+	})
+}
+
+// buildMatchNotification returns the notification for the
+// status transition of the given match. It returns false
+// if the transition should not be notified.
+func buildMatchNotification(previous match.Match, current match.Match) (notification.Notification, bool) {
+	if previous.Status == current.Status {
+		return notification.Notification{}, false
+	}
+
+	versus := fmt.Sprintf("%s vs %s", current.TeamANames, current.TeamBNames)
+
+	switch current.Status {
+	case match.StatusOngoing:
+		return notification.Notification{
+			Type:    notification.TypeMatchStart,
+			Title:   "Match started",
+			Message: fmt.Sprintf("%s (%s) has started.", versus, current.TournamentNames),
+			MatchID: current.ID,
+		}, true
+	case match.StatusCompleted:
+		winner, loser := current.TeamANames, current.TeamBNames
+		if current.Winner == current.TeamBID {
+			winner, loser = loser, winner
+		}
+		return notification.Notification{
+			Type:    notification.TypeMatchResult,
+			Title:   "Match result",
+			Message: fmt.Sprintf("%s won against %s (%s).", winner, loser, current.TournamentNames),
+			MatchID: current.ID,
+		}, true
+	}
+
+	return notification.Notification{}, false
+}
+
+// validatePreference validates fields of the given
+// Preference whether its comply the predetermined rules.
+func validatePreference(reqPreference notification.Preference) error {
+	if reqPreference.UserID <= 0 {
+		return notification.ErrInvalidUserID
+	}
+
+	if reqPreference.EmailEnabled {
+		if _, err := mail.ParseAddress(reqPreference.Email); err != nil {
+			return notification.ErrInvalidEmail
+		}
+	}
+
+	if reqPreference.WebhookEnabled {
+		u, err := url.Parse(reqPreference.WebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return notification.ErrInvalidWebhookURL
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package service
+
+import "time"
+
+// service implements notification.Service.
+type service struct {
+	pgStore  PGStore
+	channels []Channel
+	timeNow  func() time.Time
+}
+
+// New creates a new service.
+//
+// The in-app inbox channel is always registered, other
+// channels are registered using WithChannel option.
+func New(pgStore PGStore, options ...Option) (*service, error) {
+	s := &service{
+		pgStore: pgStore,
+		channels: []Channel{
+			&inboxChannel{pgStore: pgStore},
+		},
+		timeNow: time.Now,
+	}
+
+	// apply options
+	for _, opt := range options {
+		if err := opt(s); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// Option controls the behavior of service.
+type Option func(*service) error
+
+// WithChannel returns Option to register an additional
+// channel used to deliver notifications.
+func WithChannel(channel Channel) Option {
+	return func(s *service) error {
+		if channel != nil {
+			s.channels = append(s.channels, channel)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/x-sports/internal/notification"
+)
+
+// PGStore is the PostgreSQL store for notification service.
+type PGStore interface {
+	NewClient(useTx bool) (PGStoreClient, error)
+}
+
+type PGStoreClient interface {
+	// Commit commits the transaction.
+	Commit() error
+
+	// Rollback aborts the transaction.
+	Rollback() error
+
+	// CreateNotification creates a new notification and
+	// return the created notification ID.
+	CreateNotification(ctx context.Context, n notification.Notification) (int64, error)
+
+	// GetNotifications returns all notifications of the
+	// given user.
+	GetNotifications(ctx context.Context, userID int64) ([]notification.Notification, error)
+
+	// ReadNotification marks a notification with the given
+	// notification ID owned by the given user as read.
+	ReadNotification(ctx context.Context, userID int64, notificationID int64, readTime time.Time) error
+
+	// GetPreference returns notification preference of the
+	// given user, or the default preference if the user has
+	// not set any.
+	GetPreference(ctx context.Context, userID int64) (notification.Preference, error)
+
+	// UpsertPreference creates or updates notification
+	// preference of the user.
+	UpsertPreference(ctx context.Context, preference notification.Preference) error
+
+	// GetFollowerIDs returns ID of users following the given
+	// game or any of the given teams.
+	GetFollowerIDs(ctx context.Context, gameID int64, teamIDs []int64) ([]int64, error)
+
+	// GetDeliveredChannels returns name of the channels the
+	// notification of the given type and match has been
+	// delivered through to the given user.
+	GetDeliveredChannels(ctx context.Context, userID int64, matchID int64, notificationType notification.Type) ([]string, error)
+
+	// CreateDelivery records a notification delivered to a
+	// user through a channel. Recording a delivery that
+	// already exists is a no-op.
+	CreateDelivery(ctx context.Context, delivery notification.Delivery) error
+}
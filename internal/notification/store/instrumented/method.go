@@ -0,0 +1,64 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/x-sports/internal/notification"
+)
+
+func (sc *storeClient) CreateNotification(ctx context.Context, n notification.Notification) (int64, error) {
+	ctx, done := observe(ctx, "CreateNotification")
+	result, err := sc.next.CreateNotification(ctx, n)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetNotifications(ctx context.Context, userID int64) ([]notification.Notification, error) {
+	ctx, done := observe(ctx, "GetNotifications")
+	result, err := sc.next.GetNotifications(ctx, userID)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) ReadNotification(ctx context.Context, userID int64, notificationID int64, readTime time.Time) error {
+	ctx, done := observe(ctx, "ReadNotification")
+	err := sc.next.ReadNotification(ctx, userID, notificationID, readTime)
+	done(err)
+	return err
+}
+
+func (sc *storeClient) GetPreference(ctx context.Context, userID int64) (notification.Preference, error) {
+	ctx, done := observe(ctx, "GetPreference")
+	result, err := sc.next.GetPreference(ctx, userID)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) UpsertPreference(ctx context.Context, preference notification.Preference) error {
+	ctx, done := observe(ctx, "UpsertPreference")
+	err := sc.next.UpsertPreference(ctx, preference)
+	done(err)
+	return err
+}
+
+func (sc *storeClient) GetFollowerIDs(ctx context.Context, gameID int64, teamIDs []int64) ([]int64, error) {
+	ctx, done := observe(ctx, "GetFollowerIDs")
+	result, err := sc.next.GetFollowerIDs(ctx, gameID, teamIDs)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetDeliveredChannels(ctx context.Context, userID int64, matchID int64, notificationType notification.Type) ([]string, error) {
+	ctx, done := observe(ctx, "GetDeliveredChannels")
+	result, err := sc.next.GetDeliveredChannels(ctx, userID, matchID, notificationType)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) CreateDelivery(ctx context.Context, delivery notification.Delivery) error {
+	ctx, done := observe(ctx, "CreateDelivery")
+	err := sc.next.CreateDelivery(ctx, delivery)
+	done(err)
+	return err
+}
@@ -0,0 +1,162 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/x-sports/internal/feed"
+	"github.com/x-sports/internal/memory"
+	"github.com/x-sports/internal/notification"
+)
+
+func (sc *storeClient) CreateNotification(ctx context.Context, reqNotification notification.Notification) (int64, error) {
+	var notificationID int64
+	err := sc.c.Do(func(data *memory.Data) error {
+		notificationID = data.Notifications.NextID()
+
+		reqNotification.ID = notificationID
+		reqNotification.IsRead = false
+		reqNotification.ReadTime = time.Time{}
+		data.Notifications.Put(notificationID, reqNotification)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return notificationID, nil
+}
+
+func (sc *storeClient) GetNotifications(ctx context.Context, userID int64) ([]notification.Notification, error) {
+	notifications := make([]notification.Notification, 0)
+	err := sc.c.Do(func(data *memory.Data) error {
+		for _, n := range data.Notifications.All() {
+			if n.UserID == userID {
+				notifications = append(notifications, n)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(notifications, func(i, j int) bool {
+		return notifications[i].CreateTime.After(notifications[j].CreateTime)
+	})
+
+	return notifications, nil
+}
+
+func (sc *storeClient) ReadNotification(ctx context.Context, userID int64, notificationID int64, readTime time.Time) error {
+	return sc.c.Do(func(data *memory.Data) error {
+		n, ok := data.Notifications.Get(notificationID)
+		if !ok || n.UserID != userID {
+			return notification.ErrNotificationNotFound
+		}
+
+		n.IsRead = true
+		n.ReadTime = readTime
+		data.Notifications.Put(notificationID, n)
+
+		return nil
+	})
+}
+
+func (sc *storeClient) GetPreference(ctx context.Context, userID int64) (notification.Preference, error) {
+	var result notification.Preference
+	err := sc.c.Do(func(data *memory.Data) error {
+		p, ok := data.Preferences.Get(userID)
+		if !ok {
+			p = notification.DefaultPreference(userID)
+		}
+
+		result = p
+		return nil
+	})
+	if err != nil {
+		return notification.Preference{}, err
+	}
+
+	return result, nil
+}
+
+func (sc *storeClient) UpsertPreference(ctx context.Context, reqPreference notification.Preference) error {
+	return sc.c.Do(func(data *memory.Data) error {
+		// keep the create time of an existing preference, the
+		// update time is only set on update
+		p, ok := data.Preferences.Get(reqPreference.UserID)
+		if ok {
+			reqPreference.CreateTime = p.CreateTime
+		} else {
+			reqPreference.UpdateTime = time.Time{}
+		}
+
+		data.Preferences.Put(reqPreference.UserID, reqPreference)
+		return nil
+	})
+}
+
+func (sc *storeClient) GetFollowerIDs(ctx context.Context, gameID int64, teamIDs []int64) ([]int64, error) {
+	teams := make(map[int64]bool, len(teamIDs))
+	for _, id := range teamIDs {
+		teams[id] = true
+	}
+
+	userIDs := make([]int64, 0)
+	err := sc.c.Do(func(data *memory.Data) error {
+		seen := make(map[int64]bool)
+		for _, f := range data.Follows.All() {
+			followed := (f.Type == feed.FollowTypeGame && f.TargetID == gameID) ||
+				(f.Type == feed.FollowTypeTeam && teams[f.TargetID])
+			if !followed || seen[f.UserID] {
+				continue
+			}
+
+			seen[f.UserID] = true
+			userIDs = append(userIDs, f.UserID)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+func (sc *storeClient) GetDeliveredChannels(ctx context.Context, userID int64, matchID int64, notificationType notification.Type) ([]string, error) {
+	channels := make([]string, 0)
+	err := sc.c.Do(func(data *memory.Data) error {
+		for _, d := range data.NotificationDeliveries.All() {
+			if d.UserID == userID && d.MatchID == matchID && d.Type == notificationType {
+				channels = append(channels, d.Channel)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+func (sc *storeClient) CreateDelivery(ctx context.Context, delivery notification.Delivery) error {
+	return sc.c.Do(func(data *memory.Data) error {
+		// mimic the unique constraint ignoring conflicts
+		for _, d := range data.NotificationDeliveries.All() {
+			if d.UserID == delivery.UserID && d.MatchID == delivery.MatchID && d.Type == delivery.Type && d.Channel == delivery.Channel {
+				return nil
+			}
+		}
+
+		data.NotificationDeliveries.Put(data.NotificationDeliveries.NextID(), delivery)
+		return nil
+	})
+}
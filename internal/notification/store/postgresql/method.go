@@ -0,0 +1,284 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/x-sports/internal/feed"
+	"github.com/x-sports/internal/notification"
+)
+
+func (sc *storeClient) CreateNotification(ctx context.Context, reqNotification notification.Notification) (int64, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// construct arguments filled with fields for the query
+	argsKV := map[string]interface{}{
+		"user_id":           reqNotification.UserID,
+		"notification_type": reqNotification.Type,
+		"title":             reqNotification.Title,
+		"message":           reqNotification.Message,
+		"match_id":          reqNotification.MatchID,
+		"create_time":       reqNotification.CreateTime,
+	}
+
+	// prepare query
+	query, args, err := sqlx.Named(queryCreateNotification, argsKV)
+	if err != nil {
+		return 0, err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return 0, err
+	}
+	query = sc.q.Rebind(query)
+
+	// execute query
+	var notificationID int64
+	err = sc.q.QueryRowxContext(ctx, query, args...).Scan(&notificationID)
+	if err != nil {
+		return 0, err
+	}
+
+	return notificationID, nil
+}
+
+func (sc *storeClient) GetNotifications(ctx context.Context, userID int64) ([]notification.Notification, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// query to database
+	rows, err := sc.q.QueryxContext(ctx, queryGetNotifications, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// read notifications
+	notifications := make([]notification.Notification, 0)
+	for rows.Next() {
+		var row notificationDB
+		err = rows.StructScan(&row)
+		if err != nil {
+			return nil, err
+		}
+
+		notifications = append(notifications, row.format())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (sc *storeClient) ReadNotification(ctx context.Context, userID int64, notificationID int64, readTime time.Time) error {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// construct arguments filled with fields for the query
+	argsKV := map[string]interface{}{
+		"id":        notificationID,
+		"user_id":   userID,
+		"read_time": readTime,
+	}
+
+	// prepare query
+	query, args, err := sqlx.Named(queryReadNotification, argsKV)
+	if err != nil {
+		return err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return err
+	}
+	query = sc.q.Rebind(query)
+
+	// execute query
+	res, err := sc.q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	// check whether the notification exists
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return notification.ErrNotificationNotFound
+	}
+
+	return nil
+}
+
+func (sc *storeClient) GetPreference(ctx context.Context, userID int64) (notification.Preference, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// query single row
+	var pdb preferenceDB
+	err := sc.q.QueryRowxContext(ctx, queryGetPreference, userID).StructScan(&pdb)
+	if errors.Is(err, sql.ErrNoRows) {
+		return notification.DefaultPreference(userID), nil
+	}
+	if err != nil {
+		return notification.Preference{}, err
+	}
+
+	return pdb.format(), nil
+}
+
+func (sc *storeClient) UpsertPreference(ctx context.Context, reqPreference notification.Preference) error {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// construct arguments filled with fields for the query
+	argsKV := map[string]interface{}{
+		"user_id":         reqPreference.UserID,
+		"in_app":          reqPreference.InApp,
+		"email_enabled":   reqPreference.EmailEnabled,
+		"email":           reqPreference.Email,
+		"webhook_enabled": reqPreference.WebhookEnabled,
+		"webhook_url":     reqPreference.WebhookURL,
+		"match_start":     reqPreference.MatchStart,
+		"match_result":    reqPreference.MatchResult,
+		"create_time":     reqPreference.CreateTime,
+		"update_time":     reqPreference.UpdateTime,
+	}
+
+	// prepare query
+	query, args, err := sqlx.Named(queryUpsertPreference, argsKV)
+	if err != nil {
+		return err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return err
+	}
+	query = sc.q.Rebind(query)
+
+	// execute query
+	_, err = sc.q.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (sc *storeClient) GetFollowerIDs(ctx context.Context, gameID int64, teamIDs []int64) ([]int64, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// construct arguments filled with fields for the query
+	argsKV := map[string]interface{}{
+		"game_id":          gameID,
+		"team_ids":         teamIDs,
+		"follow_type_game": feed.FollowTypeGame,
+		"follow_type_team": feed.FollowTypeTeam,
+	}
+
+	// prepare query
+	query, args, err := sqlx.Named(queryGetFollowerIDs, argsKV)
+	if err != nil {
+		return nil, err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	query = sc.q.Rebind(query)
+
+	// query to database
+	rows, err := sc.q.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// read user ids
+	userIDs := make([]int64, 0)
+	for rows.Next() {
+		var userID int64
+		err = rows.Scan(&userID)
+		if err != nil {
+			return nil, err
+		}
+
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+func (sc *storeClient) GetDeliveredChannels(ctx context.Context, userID int64, matchID int64, notificationType notification.Type) ([]string, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// query to database
+	rows, err := sc.q.QueryxContext(ctx, queryGetDeliveredChannels, userID, matchID, notificationType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// read channels
+	channels := make([]string, 0)
+	for rows.Next() {
+		var channel string
+		err = rows.Scan(&channel)
+		if err != nil {
+			return nil, err
+		}
+
+		channels = append(channels, channel)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+func (sc *storeClient) CreateDelivery(ctx context.Context, delivery notification.Delivery) error {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// construct arguments filled with fields for the query
+	argsKV := map[string]interface{}{
+		"user_id":           delivery.UserID,
+		"match_id":          delivery.MatchID,
+		"notification_type": delivery.Type,
+		"channel":           delivery.Channel,
+		"create_time":       delivery.CreateTime,
+	}
+
+	// prepare query
+	query, args, err := sqlx.Named(queryCreateDelivery, argsKV)
+	if err != nil {
+		return err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return err
+	}
+	query = sc.q.Rebind(query)
+
+	// execute query
+	_, err = sc.q.ExecContext(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,140 @@
+package postgresql
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/x-sports/internal/notification"
+	"github.com/x-sports/internal/notification/service"
+)
+
+var (
+	errInvalidCommit   = errors.New("cannot do commit on non-transactional querier")
+	errInvalidRollback = errors.New("cannot do rollback on non-transactional querier")
+)
+
+// store implements notification/service.PGStore
+type store struct {
+	db           *sqlx.DB
+	queryTimeout time.Duration
+}
+
+// storeClient implements notification/service.PGStoreClient
+type storeClient struct {
+	q            sqlx.ExtContext
+	queryTimeout time.Duration
+}
+
+// New creates a new store whose queries are limited to the
+// given timeout.
+func New(db *sqlx.DB, queryTimeout time.Duration) (*store, error) {
+	s := &store{
+		db:           db,
+		queryTimeout: queryTimeout,
+	}
+
+	return s, nil
+}
+
+func (s *store) NewClient(useTx bool) (service.PGStoreClient, error) {
+	var q sqlx.ExtContext
+
+	// determine what object should be use as querier
+	q = s.db
+	if useTx {
+		var err error
+		q, err = s.db.Beginx()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &storeClient{
+		q:            q,
+		queryTimeout: s.queryTimeout,
+	}, nil
+}
+
+func (sc *storeClient) Commit() error {
+	if tx, ok := sc.q.(*sqlx.Tx); ok {
+		return tx.Commit()
+	}
+	return errInvalidCommit
+}
+
+func (sc *storeClient) Rollback() error {
+	if tx, ok := sc.q.(*sqlx.Tx); ok {
+		return tx.Rollback()
+	}
+	return errInvalidRollback
+}
+
+// notificationDB denotes a notification data in the store.
+type notificationDB struct {
+	ID         int64             `db:"id"`
+	UserID     int64             `db:"user_id"`
+	Type       notification.Type `db:"notification_type"`
+	Title      string            `db:"title"`
+	Message    string            `db:"message"`
+	MatchID    int64             `db:"match_id"`
+	IsRead     bool              `db:"is_read"`
+	CreateTime time.Time         `db:"create_time"`
+	ReadTime   *time.Time        `db:"read_time"`
+}
+
+// format formats database struct into domain struct.
+func (ndb *notificationDB) format() notification.Notification {
+	n := notification.Notification{
+		ID:         ndb.ID,
+		UserID:     ndb.UserID,
+		Type:       ndb.Type,
+		Title:      ndb.Title,
+		Message:    ndb.Message,
+		MatchID:    ndb.MatchID,
+		IsRead:     ndb.IsRead,
+		CreateTime: ndb.CreateTime,
+	}
+
+	if ndb.ReadTime != nil {
+		n.ReadTime = *ndb.ReadTime
+	}
+
+	return n
+}
+
+// preferenceDB denotes a notification preference data in
+// the store.
+type preferenceDB struct {
+	UserID         int64      `db:"user_id"`
+	InApp          bool       `db:"in_app"`
+	EmailEnabled   bool       `db:"email_enabled"`
+	Email          string     `db:"email"`
+	WebhookEnabled bool       `db:"webhook_enabled"`
+	WebhookURL     string     `db:"webhook_url"`
+	MatchStart     bool       `db:"match_start"`
+	MatchResult    bool       `db:"match_result"`
+	CreateTime     time.Time  `db:"create_time"`
+	UpdateTime     *time.Time `db:"update_time"`
+}
+
+// format formats database struct into domain struct.
+func (pdb *preferenceDB) format() notification.Preference {
+	p := notification.Preference{
+		UserID:         pdb.UserID,
+		InApp:          pdb.InApp,
+		EmailEnabled:   pdb.EmailEnabled,
+		Email:          pdb.Email,
+		WebhookEnabled: pdb.WebhookEnabled,
+		WebhookURL:     pdb.WebhookURL,
+		MatchStart:     pdb.MatchStart,
+		MatchResult:    pdb.MatchResult,
+		CreateTime:     pdb.CreateTime,
+	}
+
+	if pdb.UpdateTime != nil {
+		p.UpdateTime = *pdb.UpdateTime
+	}
+
+	return p
+}
@@ -0,0 +1,145 @@
+package postgresql
+
+const queryCreateNotification = `
+	INSERT INTO
+		notification
+	(
+		user_id,
+		notification_type,
+		title,
+		message,
+		match_id,
+		is_read,
+		create_time
+	) VALUES (
+		:user_id,
+		:notification_type,
+		:title,
+		:message,
+		:match_id,
+		FALSE,
+		:create_time
+	)  RETURNING
+		id
+`
+
+const queryGetNotifications = `
+	SELECT
+		n.id,
+		n.user_id,
+		n.notification_type,
+		n.title,
+		n.message,
+		COALESCE(n.match_id, 0) AS match_id,
+		n.is_read,
+		n.create_time,
+		n.read_time
+	FROM
+		notification n
+	WHERE
+		n.user_id = $1
+	ORDER BY
+		n.create_time DESC
+`
+
+const queryReadNotification = `
+	UPDATE
+		notification
+	SET
+		is_read = TRUE,
+		read_time = :read_time
+	WHERE
+		id = :id AND
+		user_id = :user_id
+`
+
+const queryGetPreference = `
+	SELECT
+		p.user_id,
+		p.in_app,
+		p.email_enabled,
+		p.email,
+		p.webhook_enabled,
+		p.webhook_url,
+		p.match_start,
+		p.match_result,
+		p.create_time,
+		p.update_time
+	FROM
+		notification_preference p
+	WHERE
+		p.user_id = $1
+`
+
+const queryUpsertPreference = `
+	INSERT INTO
+		notification_preference
+	(
+		user_id,
+		in_app,
+		email_enabled,
+		email,
+		webhook_enabled,
+		webhook_url,
+		match_start,
+		match_result,
+		create_time
+	) VALUES (
+		:user_id,
+		:in_app,
+		:email_enabled,
+		:email,
+		:webhook_enabled,
+		:webhook_url,
+		:match_start,
+		:match_result,
+		:create_time
+	) ON CONFLICT (user_id) DO UPDATE SET
+		in_app = EXCLUDED.in_app,
+		email_enabled = EXCLUDED.email_enabled,
+		email = EXCLUDED.email,
+		webhook_enabled = EXCLUDED.webhook_enabled,
+		webhook_url = EXCLUDED.webhook_url,
+		match_start = EXCLUDED.match_start,
+		match_result = EXCLUDED.match_result,
+		update_time = :update_time
+`
+
+const queryGetFollowerIDs = `
+	SELECT DISTINCT
+		f.user_id
+	FROM
+		follow f
+	WHERE
+		(f.follow_type = :follow_type_game AND f.target_id = :game_id) OR
+		(f.follow_type = :follow_type_team AND f.target_id IN (:team_ids))
+`
+
+const queryGetDeliveredChannels = `
+	SELECT
+		d.channel
+	FROM
+		notification_delivery d
+	WHERE
+		d.user_id = $1 AND
+		d.match_id = $2 AND
+		d.notification_type = $3
+`
+
+const queryCreateDelivery = `
+	INSERT INTO
+		notification_delivery
+	(
+		user_id,
+		match_id,
+		notification_type,
+		channel,
+		create_time
+	) VALUES (
+		:user_id,
+		:match_id,
+		:notification_type,
+		:channel,
+		:create_time
+	) ON CONFLICT (user_id, match_id, notification_type, channel) DO NOTHING
+`
@@ -0,0 +1,9 @@
+package outbox
+
+import "errors"
+
+var (
+	// ErrUnknownEventType is returned when a subscriber
+	// receives an event type it does not handle.
+	ErrUnknownEventType = errors.New("unknown event type")
+)
@@ -0,0 +1,90 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+type Service interface {
+	// Subscribe registers the given subscriber to receive
+	// events of the given event type. The name identifies the
+	// subscriber in the recorded deliveries, so it must be
+	// unique and kept the same across restarts.
+	Subscribe(eventType string, name string, subscriber Subscriber)
+
+	// Dispatch delivers a batch of pending events to their
+	// subscribers and return the number of events delivered.
+	//
+	// The events are claimed before they are delivered, so
+	// that other dispatchers skip them. An event is marked as
+	// dispatched only after all of its subscribers succeed,
+	// otherwise it is retried later for the failed subscribers
+	// only, until it fails too many times. So, a subscriber
+	// may receive the same event more than once only when its
+	// success is not recorded.
+	Dispatch(ctx context.Context) (int, error)
+
+	// Run dispatches pending events periodically until the
+	// given context is done.
+	Run(ctx context.Context)
+}
+
+// Subscriber handles events delivered by the dispatcher.
+type Subscriber interface {
+	// HandleEvent handles the given event. Returning an error
+	// makes the event to be delivered again later.
+	HandleEvent(ctx context.Context, event Event) error
+}
+
+// Event denotes a domain event recorded in the outbox.
+type Event struct {
+	ID              int64
+	Type            string
+	Payload         []byte
+	Attempts        int
+	LastError       string
+	CreateTime      time.Time
+	NextAttemptTime time.Time
+	DispatchTime    time.Time
+
+	// Delivered are the names of the subscribers the event
+	// is already delivered to.
+	Delivered []string
+
+	// FailTime is the time the event stops being attempted
+	// after failing too many times.
+	FailTime time.Time
+}
+
+// NewEvent returns a new event of the given type with the
+// given data encoded as JSON payload.
+func NewEvent(eventType string, data interface{}, createTime time.Time) (Event, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, err
+	}
+
+	return Event{
+		Type:            eventType,
+		Payload:         payload,
+		CreateTime:      createTime,
+		NextAttemptTime: createTime,
+	}, nil
+}
+
+// IsDelivered returns whether the event is already delivered
+// to the subscriber with the given name.
+func (e Event) IsDelivered(name string) bool {
+	for _, delivered := range e.Delivered {
+		if delivered == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Decode decodes payload of the event into the given value.
+func (e Event) Decode(v interface{}) error {
+	return json.Unmarshal(e.Payload, v)
+}
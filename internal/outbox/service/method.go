@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/x-sports/global/tracing"
+	"github.com/x-sports/internal/outbox"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (s *service) Subscribe(eventType string, name string, sub outbox.Subscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.subscribers[eventType] = append(s.subscribers[eventType], subscriber{
+		name:       name,
+		Subscriber: sub,
+	})
+}
+
+func (s *service) Dispatch(ctx context.Context) (int, error) {
+	ctx, span := tracing.Start(ctx, "outbox.Service.Dispatch")
+	defer span.End()
+
+	// the events are delivered without transaction, so that
+	// no lock is held while the subscribers are handling them
+	pgStoreClient, err := s.pgStore.NewClient(false)
+	if err != nil {
+		return 0, err
+	}
+
+	// claim the pending events, so that other dispatchers skip
+	// them until the lease time
+	now := s.timeNow()
+	events, err := pgStoreClient.ClaimPendingEvents(ctx, now, now.Add(s.config.LeaseTimeout), s.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	dispatched := 0
+	for _, event := range events {
+		err := s.deliver(ctx, pgStoreClient, event)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to deliver event", slog.Int64("event_id", event.ID), slog.String("event_type", event.Type), slog.String("error", err.Error()))
+
+			event.Attempts++
+			event.LastError = err.Error()
+			event.NextAttemptTime = s.timeNow().Add(s.backoff(event.Attempts))
+
+			// stop attempting the event, it is kept to be
+			// inspected
+			if event.Attempts >= s.config.MaxAttempts {
+				slog.ErrorContext(ctx, "event failed too many times", slog.Int64("event_id", event.ID), slog.String("event_type", event.Type), slog.Int("attempts", event.Attempts))
+				event.FailTime = s.timeNow()
+			}
+
+			err = pgStoreClient.MarkEventFailed(ctx, event)
+			if err != nil {
+				return dispatched, err
+			}
+			continue
+		}
+
+		err = pgStoreClient.MarkEventDispatched(ctx, event.ID, s.timeNow())
+		if err != nil {
+			return dispatched, err
+		}
+		dispatched++
+	}
+
+	return dispatched, nil
+}
+
+func (s *service) Run(ctx context.Context) {
+	for {
+		dispatched, err := s.Dispatch(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to dispatch events", slog.String("error", err.Error()))
+		}
+
+		// keep dispatching without waiting while there might
+		// be more pending events
+		if err == nil && dispatched >= s.config.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.config.PollInterval):
+		}
+	}
+}
+
+// deliver delivers the given event to its subscribers it is
+// not delivered to yet, and records each success using the
+// given store client. Failures are collected so that one
+// failing subscriber does not prevent the others.
+func (s *service) deliver(ctx context.Context, pgStoreClient PGStoreClient, event outbox.Event) (err error) {
+	ctx, span := tracing.Start(ctx, "outbox.Service.deliver",
+		attribute.Int64("outbox.event_id", event.ID),
+		attribute.String("outbox.event_type", event.Type),
+		attribute.Int("outbox.attempts", event.Attempts),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	s.mu.RLock()
+	subscribers := s.subscribers[event.Type]
+	s.mu.RUnlock()
+
+	var errs []error
+	for _, sub := range subscribers {
+		if event.IsDelivered(sub.name) {
+			continue
+		}
+
+		err := sub.HandleEvent(ctx, event)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sub.name, err))
+			continue
+		}
+
+		// the subscriber receives the event again if its
+		// success is not recorded
+		err = pgStoreClient.MarkEventDelivered(ctx, event.ID, sub.name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sub.name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// backoff returns the wait time before the next attempt of
+// an event that has failed the given number of attempts.
+func (s *service) backoff(attempts int) time.Duration {
+	backoff := s.config.InitialBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= s.config.MaxBackoff {
+			return s.config.MaxBackoff
+		}
+	}
+	return backoff
+}
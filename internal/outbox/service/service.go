@@ -0,0 +1,125 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/x-sports/internal/outbox"
+)
+
+// Following constans are config default values.
+const (
+	defaultPollInterval   = 1 * time.Second
+	defaultBatchSize      = 100
+	defaultInitialBackoff = 1 * time.Second
+	defaultMaxBackoff     = 5 * time.Minute
+	defaultMaxAttempts    = 10
+	defaultLeaseTimeout   = 5 * time.Minute
+)
+
+// service implements outbox.Service.
+type service struct {
+	pgStore     PGStore
+	config      Config
+	mu          sync.RWMutex
+	subscribers map[string][]subscriber
+	timeNow     func() time.Time
+}
+
+// subscriber is a named outbox.Subscriber.
+type subscriber struct {
+	name string
+	outbox.Subscriber
+}
+
+// Config denotes service configuration
+//
+// Adding a new field should also add the corresponding default
+// value in getDefaultConfig().
+type Config struct {
+	// PollInterval is the wait time between dispatches when
+	// there is no pending event.
+	PollInterval time.Duration
+
+	// BatchSize is the maximum number of events delivered in
+	// a single dispatch.
+	BatchSize int
+
+	// InitialBackoff is the wait time before the first retry
+	// of a failed event, it is doubled on every next retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the upper bound of the wait time between
+	// retries of a failed event.
+	MaxBackoff time.Duration
+
+	// MaxAttempts is the number of failed attempts after
+	// which an event is marked as failed and not attempted
+	// anymore.
+	MaxAttempts int
+
+	// LeaseTimeout is the time a dispatcher has to deliver
+	// the events it claims, after which they are claimed
+	// again, e.g. when the dispatcher has crashed.
+	LeaseTimeout time.Duration
+}
+
+// getDefaultConfig returns service configuration with the
+// predefined default values.
+func getDefaultConfig() Config {
+	return Config{
+		PollInterval:   defaultPollInterval,
+		BatchSize:      defaultBatchSize,
+		InitialBackoff: defaultInitialBackoff,
+		MaxBackoff:     defaultMaxBackoff,
+		MaxAttempts:    defaultMaxAttempts,
+		LeaseTimeout:   defaultLeaseTimeout,
+	}
+}
+
+// New creates a new service.
+func New(pgStore PGStore, options ...Option) (*service, error) {
+	s := &service{
+		pgStore:     pgStore,
+		config:      getDefaultConfig(),
+		subscribers: make(map[string][]subscriber),
+		timeNow:     time.Now,
+	}
+
+	// apply options
+	for _, opt := range options {
+		if err := opt(s); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// Option controls the behavior of service.
+type Option func(*service) error
+
+// WithConfig returns Option to set service configuration.
+func WithConfig(config Config) Option {
+	return func(s *service) error {
+		if config.PollInterval > 0 {
+			s.config.PollInterval = config.PollInterval
+		}
+		if config.BatchSize > 0 {
+			s.config.BatchSize = config.BatchSize
+		}
+		if config.InitialBackoff > 0 {
+			s.config.InitialBackoff = config.InitialBackoff
+		}
+		if config.MaxBackoff > 0 {
+			s.config.MaxBackoff = config.MaxBackoff
+		}
+		if config.MaxAttempts > 0 {
+			s.config.MaxAttempts = config.MaxAttempts
+		}
+		if config.LeaseTimeout > 0 {
+			s.config.LeaseTimeout = config.LeaseTimeout
+		}
+		return nil
+	}
+}
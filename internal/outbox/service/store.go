@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/x-sports/internal/outbox"
+)
+
+// PGStore is the PostgreSQL store for outbox service.
+type PGStore interface {
+	NewClient(useTx bool) (PGStoreClient, error)
+}
+
+type PGStoreClient interface {
+	// Commit commits the transaction.
+	Commit() error
+
+	// Rollback aborts the transaction.
+	Rollback() error
+
+	// ClaimPendingEvents returns at most limit events that
+	// are not dispatched nor failed yet and due to be
+	// attempted at the given time. The returned events are not
+	// due until the given lease time, so that they are not
+	// claimed by others while they are delivered.
+	ClaimPendingEvents(ctx context.Context, now time.Time, leaseTime time.Time, limit int) ([]outbox.Event, error)
+
+	// MarkEventDelivered records that an event with the given
+	// event ID is delivered to the subscriber with the given
+	// name.
+	MarkEventDelivered(ctx context.Context, eventID int64, subscriber string) error
+
+	// MarkEventDispatched marks an event with the given event
+	// ID as dispatched.
+	MarkEventDispatched(ctx context.Context, eventID int64, dispatchTime time.Time) error
+
+	// MarkEventFailed records a failed attempt of an event
+	// and schedules its next attempt, or marks it as failed
+	// if its fail time is set.
+	MarkEventFailed(ctx context.Context, event outbox.Event) error
+}
@@ -0,0 +1,36 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/x-sports/internal/outbox"
+)
+
+func (sc *storeClient) ClaimPendingEvents(ctx context.Context, now time.Time, leaseTime time.Time, limit int) ([]outbox.Event, error) {
+	ctx, done := observe(ctx, "ClaimPendingEvents")
+	result, err := sc.next.ClaimPendingEvents(ctx, now, leaseTime, limit)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) MarkEventDelivered(ctx context.Context, eventID int64, subscriber string) error {
+	ctx, done := observe(ctx, "MarkEventDelivered")
+	err := sc.next.MarkEventDelivered(ctx, eventID, subscriber)
+	done(err)
+	return err
+}
+
+func (sc *storeClient) MarkEventDispatched(ctx context.Context, eventID int64, dispatchTime time.Time) error {
+	ctx, done := observe(ctx, "MarkEventDispatched")
+	err := sc.next.MarkEventDispatched(ctx, eventID, dispatchTime)
+	done(err)
+	return err
+}
+
+func (sc *storeClient) MarkEventFailed(ctx context.Context, event outbox.Event) error {
+	ctx, done := observe(ctx, "MarkEventFailed")
+	err := sc.next.MarkEventFailed(ctx, event)
+	done(err)
+	return err
+}
@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/x-sports/internal/memory"
+	"github.com/x-sports/internal/outbox"
+)
+
+// CreateEvent records the given event in the outbox of the
+// given data and return the created event ID.
+//
+// It is used by stores of other domains to record events in
+// the same transaction as the change that emits them.
+func CreateEvent(data *memory.Data, reqEvent outbox.Event) int64 {
+	eventID := data.Events.NextID()
+
+	data.Events.Put(eventID, outbox.Event{
+		ID:              eventID,
+		Type:            reqEvent.Type,
+		Payload:         reqEvent.Payload,
+		CreateTime:      reqEvent.CreateTime,
+		NextAttemptTime: reqEvent.NextAttemptTime,
+	})
+
+	return eventID
+}
+
+func (sc *storeClient) ClaimPendingEvents(ctx context.Context, now time.Time, leaseTime time.Time, limit int) ([]outbox.Event, error) {
+	events := make([]outbox.Event, 0)
+	err := sc.c.Do(func(data *memory.Data) error {
+		for _, e := range data.Events.All() {
+			if len(events) >= limit {
+				break
+			}
+			if !e.DispatchTime.IsZero() || !e.FailTime.IsZero() || e.NextAttemptTime.After(now) {
+				continue
+			}
+
+			e.NextAttemptTime = leaseTime
+			data.Events.Put(e.ID, e)
+			events = append(events, e)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (sc *storeClient) MarkEventDelivered(ctx context.Context, eventID int64, subscriber string) error {
+	return sc.c.Do(func(data *memory.Data) error {
+		e, ok := data.Events.Get(eventID)
+		if !ok || e.IsDelivered(subscriber) {
+			return nil
+		}
+
+		// copy the subscribers so that the snapshots do not
+		// share them
+		e.Delivered = append(append([]string(nil), e.Delivered...), subscriber)
+		data.Events.Put(eventID, e)
+
+		return nil
+	})
+}
+
+func (sc *storeClient) MarkEventDispatched(ctx context.Context, eventID int64, dispatchTime time.Time) error {
+	return sc.c.Do(func(data *memory.Data) error {
+		e, ok := data.Events.Get(eventID)
+		if !ok {
+			return nil
+		}
+
+		e.DispatchTime = dispatchTime
+		data.Events.Put(eventID, e)
+
+		return nil
+	})
+}
+
+func (sc *storeClient) MarkEventFailed(ctx context.Context, reqEvent outbox.Event) error {
+	return sc.c.Do(func(data *memory.Data) error {
+		e, ok := data.Events.Get(reqEvent.ID)
+		if !ok {
+			return nil
+		}
+
+		e.Attempts = reqEvent.Attempts
+		e.LastError = reqEvent.LastError
+		e.NextAttemptTime = reqEvent.NextAttemptTime
+		e.FailTime = reqEvent.FailTime
+		data.Events.Put(e.ID, e)
+
+		return nil
+	})
+}
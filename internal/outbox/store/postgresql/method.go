@@ -0,0 +1,160 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/x-sports/internal/outbox"
+)
+
+// CreateEvent records the given event in the outbox using
+// the given querier and return the created event ID.
+//
+// It is used by stores of other domains to record events in
+// the same transaction as the change that emits them.
+func CreateEvent(ctx context.Context, q sqlx.ExtContext, reqEvent outbox.Event) (int64, error) {
+	// construct arguments filled with fields for the query
+	argsKV := map[string]interface{}{
+		"event_type":        reqEvent.Type,
+		"payload":           reqEvent.Payload,
+		"create_time":       reqEvent.CreateTime,
+		"next_attempt_time": reqEvent.NextAttemptTime,
+	}
+
+	// prepare query
+	query, args, err := sqlx.Named(queryCreateEvent, argsKV)
+	if err != nil {
+		return 0, err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return 0, err
+	}
+	query = q.Rebind(query)
+
+	// execute query
+	var eventID int64
+	err = q.QueryRowxContext(ctx, query, args...).Scan(&eventID)
+	if err != nil {
+		return 0, err
+	}
+
+	return eventID, nil
+}
+
+func (sc *storeClient) ClaimPendingEvents(ctx context.Context, now time.Time, leaseTime time.Time, limit int) ([]outbox.Event, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// query to database
+	rows, err := sc.q.QueryxContext(ctx, queryClaimPendingEvents, now, leaseTime, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// read events
+	events := make([]outbox.Event, 0)
+	for rows.Next() {
+		var row eventDB
+		err = rows.StructScan(&row)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, row.format())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (sc *storeClient) MarkEventDelivered(ctx context.Context, eventID int64, subscriber string) error {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// construct arguments filled with fields for the query
+	argsKV := map[string]interface{}{
+		"id":         eventID,
+		"subscriber": subscriber,
+	}
+
+	// prepare query
+	query, args, err := sqlx.Named(queryMarkEventDelivered, argsKV)
+	if err != nil {
+		return err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return err
+	}
+	query = sc.q.Rebind(query)
+
+	// execute query
+	_, err = sc.q.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (sc *storeClient) MarkEventDispatched(ctx context.Context, eventID int64, dispatchTime time.Time) error {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// construct arguments filled with fields for the query
+	argsKV := map[string]interface{}{
+		"id":            eventID,
+		"dispatch_time": dispatchTime,
+	}
+
+	// prepare query
+	query, args, err := sqlx.Named(queryMarkEventDispatched, argsKV)
+	if err != nil {
+		return err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return err
+	}
+	query = sc.q.Rebind(query)
+
+	// execute query
+	_, err = sc.q.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (sc *storeClient) MarkEventFailed(ctx context.Context, reqEvent outbox.Event) error {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// construct arguments filled with fields for the query
+	argsKV := map[string]interface{}{
+		"id":                reqEvent.ID,
+		"attempts":          reqEvent.Attempts,
+		"last_error":        reqEvent.LastError,
+		"next_attempt_time": reqEvent.NextAttemptTime,
+		"fail_time":         sql.NullTime{Time: reqEvent.FailTime, Valid: !reqEvent.FailTime.IsZero()},
+	}
+
+	// prepare query
+	query, args, err := sqlx.Named(queryMarkEventFailed, argsKV)
+	if err != nil {
+		return err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return err
+	}
+	query = sc.q.Rebind(query)
+
+	// execute query
+	_, err = sc.q.ExecContext(ctx, query, args...)
+	return err
+}
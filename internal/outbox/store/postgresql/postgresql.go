@@ -0,0 +1,110 @@
+package postgresql
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/x-sports/internal/outbox"
+	"github.com/x-sports/internal/outbox/service"
+)
+
+var (
+	errInvalidCommit   = errors.New("cannot do commit on non-transactional querier")
+	errInvalidRollback = errors.New("cannot do rollback on non-transactional querier")
+)
+
+// store implements outbox/service.PGStore
+type store struct {
+	db           *sqlx.DB
+	queryTimeout time.Duration
+}
+
+// storeClient implements outbox/service.PGStoreClient
+type storeClient struct {
+	q            sqlx.ExtContext
+	queryTimeout time.Duration
+}
+
+// New creates a new store whose queries are limited to the
+// given timeout.
+func New(db *sqlx.DB, queryTimeout time.Duration) (*store, error) {
+	s := &store{
+		db:           db,
+		queryTimeout: queryTimeout,
+	}
+
+	return s, nil
+}
+
+func (s *store) NewClient(useTx bool) (service.PGStoreClient, error) {
+	var q sqlx.ExtContext
+
+	// determine what object should be use as querier
+	q = s.db
+	if useTx {
+		var err error
+		q, err = s.db.Beginx()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &storeClient{
+		q:            q,
+		queryTimeout: s.queryTimeout,
+	}, nil
+}
+
+func (sc *storeClient) Commit() error {
+	if tx, ok := sc.q.(*sqlx.Tx); ok {
+		return tx.Commit()
+	}
+	return errInvalidCommit
+}
+
+func (sc *storeClient) Rollback() error {
+	if tx, ok := sc.q.(*sqlx.Tx); ok {
+		return tx.Rollback()
+	}
+	return errInvalidRollback
+}
+
+// eventDB denotes an outbox event data in the store.
+type eventDB struct {
+	ID              int64          `db:"id"`
+	Type            string         `db:"event_type"`
+	Payload         []byte         `db:"payload"`
+	Attempts        int            `db:"attempts"`
+	LastError       string         `db:"last_error"`
+	CreateTime      time.Time      `db:"create_time"`
+	NextAttemptTime time.Time      `db:"next_attempt_time"`
+	DispatchTime    *time.Time     `db:"dispatch_time"`
+	Delivered       pq.StringArray `db:"delivered"`
+	FailTime        *time.Time     `db:"fail_time"`
+}
+
+// format formats database struct into domain struct.
+func (edb *eventDB) format() outbox.Event {
+	e := outbox.Event{
+		ID:              edb.ID,
+		Type:            edb.Type,
+		Payload:         edb.Payload,
+		Attempts:        edb.Attempts,
+		LastError:       edb.LastError,
+		CreateTime:      edb.CreateTime,
+		NextAttemptTime: edb.NextAttemptTime,
+		Delivered:       edb.Delivered,
+	}
+
+	if edb.DispatchTime != nil {
+		e.DispatchTime = *edb.DispatchTime
+	}
+
+	if edb.FailTime != nil {
+		e.FailTime = *edb.FailTime
+	}
+
+	return e
+}
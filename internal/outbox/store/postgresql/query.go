@@ -0,0 +1,94 @@
+package postgresql
+
+const queryCreateEvent = `
+	INSERT INTO
+		outbox_event
+	(
+		event_type,
+		payload,
+		attempts,
+		last_error,
+		create_time,
+		next_attempt_time
+	) VALUES (
+		:event_type,
+		:payload,
+		0,
+		'',
+		:create_time,
+		:next_attempt_time
+	)  RETURNING
+		id
+`
+
+const queryClaimPendingEvents = `
+	WITH claimed AS (
+		UPDATE
+			outbox_event
+		SET
+			next_attempt_time = $2
+		WHERE
+			id IN (
+				SELECT
+					e.id
+				FROM
+					outbox_event e
+				WHERE
+					e.dispatch_time IS NULL AND
+					e.fail_time IS NULL AND
+					e.next_attempt_time <= $1
+				ORDER BY
+					e.id
+				LIMIT $3
+				FOR UPDATE SKIP LOCKED
+			)
+		RETURNING
+			id,
+			event_type,
+			payload,
+			attempts,
+			last_error,
+			create_time,
+			next_attempt_time,
+			dispatch_time,
+			delivered,
+			fail_time
+	)
+	SELECT
+		*
+	FROM
+		claimed
+	ORDER BY
+		id
+`
+
+const queryMarkEventDelivered = `
+	UPDATE
+		outbox_event
+	SET
+		delivered = array_append(delivered, :subscriber)
+	WHERE
+		id = :id AND
+		NOT (:subscriber = ANY(delivered))
+`
+
+const queryMarkEventDispatched = `
+	UPDATE
+		outbox_event
+	SET
+		dispatch_time = :dispatch_time
+	WHERE
+		id = :id
+`
+
+const queryMarkEventFailed = `
+	UPDATE
+		outbox_event
+	SET
+		attempts = :attempts,
+		last_error = :last_error,
+		next_attempt_time = :next_attempt_time,
+		fail_time = :fail_time
+	WHERE
+		id = :id
+`
@@ -0,0 +1,45 @@
+package schedule
+
+import "errors"
+
+var (
+	// ErrInvalidKind is returned when the given kind is
+	// invalid.
+	ErrInvalidKind = errors.New("invalid kind")
+
+	// ErrInvalidFormat is returned when the given format is
+	// invalid.
+	ErrInvalidFormat = errors.New("invalid format")
+
+	// ErrInvalidData is returned when the given data cannot
+	// be parsed in its format.
+	ErrInvalidData = errors.New("invalid data")
+
+	// ErrInvalidRecordCount is returned when there is no
+	// record or the number of records exceeds the limit.
+	ErrInvalidRecordCount = errors.New("invalid record count")
+
+	// ErrUnknownGame is returned when the given game name
+	// does not match any game.
+	ErrUnknownGame = errors.New("unknown game")
+
+	// ErrAmbiguousGame is returned when the given game name
+	// matches more than one game.
+	ErrAmbiguousGame = errors.New("ambiguous game")
+
+	// ErrUnknownTeam is returned when the given team name
+	// does not match any team of the game.
+	ErrUnknownTeam = errors.New("unknown team")
+
+	// ErrAmbiguousTeam is returned when the given team name
+	// matches more than one team of the game.
+	ErrAmbiguousTeam = errors.New("ambiguous team")
+
+	// ErrDuplicate is returned when the imported record
+	// already exists.
+	ErrDuplicate = errors.New("already exists")
+
+	// ErrInvalidValue is returned when the given column
+	// value cannot be parsed.
+	ErrInvalidValue = errors.New("invalid value")
+)
@@ -0,0 +1,74 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/schedule"
+)
+
+type calendarHandler struct {
+	schedule schedule.Service
+}
+
+func (h *calendarHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.handleGetCalendar(w, r)
+	default:
+		helper.WriteErrorResponse(w, http.StatusMethodNotAllowed, []string{errMethodNotAllowed.Error()})
+	}
+}
+
+// handleGetCalendar does not check access token, as calendar
+// applications subscribe to the feed without authorization.
+func (h *calendarHandler) handleGetCalendar(w http.ResponseWriter, r *http.Request) {
+	helper.Serve(w, r, newEndpoint("handleGetCalendar"), func(ctx context.Context) (helper.RawResponse, error) {
+		// parsed filter
+		filter, err := parseCalendarFilter(r.URL.Query())
+		if err != nil {
+			return helper.RawResponse{}, helper.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		var buf bytes.Buffer
+		err = h.schedule.ExportCalendar(ctx, &buf, filter)
+		if err != nil {
+			return helper.RawResponse{}, err
+		}
+
+		return helper.RawResponse{
+			ContentType: "text/calendar; charset=utf-8",
+			Body:        buf.Bytes(),
+		}, nil
+	})
+}
+
+// parseCalendarFilter returns schedule.CalendarFilter from
+// the given query.
+func parseCalendarFilter(request url.Values) (schedule.CalendarFilter, error) {
+	result := schedule.CalendarFilter{
+		TournamentNames: request.Get("tournament"),
+	}
+
+	if gameIDStr := request.Get("game_id"); gameIDStr != "" {
+		gameID, err := strconv.ParseInt(gameIDStr, 10, 64)
+		if err != nil {
+			return schedule.CalendarFilter{}, errInvalidGameID
+		}
+		result.GameID = gameID
+	}
+
+	if teamIDStr := request.Get("team_id"); teamIDStr != "" {
+		teamID, err := strconv.ParseInt(teamIDStr, 10, 64)
+		if err != nil {
+			return schedule.CalendarFilter{}, errInvalidTeamID
+		}
+		result.TeamID = teamID
+	}
+
+	return result, nil
+}
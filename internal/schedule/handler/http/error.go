@@ -0,0 +1,85 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/schedule"
+)
+
+// Followings are the known errors from Schedule HTTP handlers.
+var (
+	// errInvalidKind is returned when the given kind is
+	// invalid.
+	errInvalidKind = errors.New("INVALID_KIND")
+
+	// errInvalidFormat is returned when the given format is
+	// invalid.
+	errInvalidFormat = errors.New("INVALID_FORMAT")
+
+	// errInvalidData is returned when the given data cannot
+	// be parsed.
+	errInvalidData = errors.New("INVALID_DATA")
+
+	// errInvalidRecordCount is returned when the number of
+	// the given records is invalid.
+	errInvalidRecordCount = errors.New("INVALID_RECORD_COUNT")
+
+	// errInvalidDryRun is returned when the given dry run
+	// flag is invalid.
+	errInvalidDryRun = errors.New("INVALID_DRY_RUN")
+
+	// errInvalidGameID is returned when the given game id is
+	// invalid.
+	errInvalidGameID = errors.New("INVALID_GAME_ID")
+
+	// errInvalidTeamID is returned when the given team id is
+	// invalid.
+	errInvalidTeamID = errors.New("INVALID_TEAM_ID")
+
+	// errInvalidStatus is returned when the given status is
+	// invalid.
+	errInvalidStatus = errors.New("INVALID_STATUS")
+
+	// errMethodNotAllowed is returned when accessing not
+	// allowed HTTP method.
+	errMethodNotAllowed = errors.New("METHOD_NOT_ALLOWED")
+
+	// errQueryTimeout is returned when a database query has
+	// reached its timeout limit.
+	errQueryTimeout = errors.New("QUERY_TIMEOUT")
+)
+
+var (
+	// mapHTTPError maps service error into HTTP error that
+	// categorize as bad request error, unless its status code
+	// is set in mapHTTPStatus.
+	//
+	// Internal server error-related should not be mapped here,
+	// and the handler should just return `errInternal` as the
+	// error instead
+	mapHTTPError = map[error]error{
+		schedule.ErrInvalidKind:        errInvalidKind,
+		schedule.ErrInvalidFormat:      errInvalidFormat,
+		schedule.ErrInvalidData:        errInvalidData,
+		schedule.ErrInvalidRecordCount: errInvalidRecordCount,
+		helper.ErrQueryTimeout:         errQueryTimeout,
+	}
+
+	// mapHTTPStatus maps HTTP error into its status code when
+	// it is not a bad request error.
+	mapHTTPStatus = map[error]int{
+		errQueryTimeout: http.StatusGatewayTimeout,
+	}
+)
+
+// newEndpoint returns helper.Endpoint of the handler with the
+// given name.
+func newEndpoint(name string) helper.Endpoint {
+	return helper.Endpoint{
+		Name:      "[Schedule HTTP][" + name + "]",
+		MapError:  mapHTTPError,
+		MapStatus: mapHTTPStatus,
+	}
+}
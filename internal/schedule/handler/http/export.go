@@ -0,0 +1,84 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/schedule"
+)
+
+type exportHandler struct {
+	schedule schedule.Service
+	admin    admin.Service
+}
+
+func (h *exportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.handleExportMatches(w, r)
+	default:
+		helper.WriteErrorResponse(w, http.StatusMethodNotAllowed, []string{errMethodNotAllowed.Error()})
+	}
+}
+
+func (h *exportHandler) handleExportMatches(w http.ResponseWriter, r *http.Request) {
+	helper.Serve(w, r, newEndpoint("handleExportMatches"), func(ctx context.Context) (helper.RawResponse, error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return helper.RawResponse{}, err
+		}
+
+		// parsed filter
+		filter, err := parseExportFilter(r.URL.Query())
+		if err != nil {
+			return helper.RawResponse{}, helper.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		var buf bytes.Buffer
+		err = h.schedule.ExportMatches(ctx, &buf, filter)
+		if err != nil {
+			return helper.RawResponse{}, err
+		}
+
+		return helper.RawResponse{
+			ContentType: "text/csv",
+			Filename:    "matchs.csv",
+			Body:        buf.Bytes(),
+		}, nil
+	})
+}
+
+// parseExportFilter returns schedule.ExportFilter from the
+// given query, it takes the same filter as getting all
+// matchs.
+func parseExportFilter(request url.Values) (schedule.ExportFilter, error) {
+	var result schedule.ExportFilter
+
+	if gameIDStr := request.Get("game_id"); gameIDStr != "" {
+		gameID, err := strconv.ParseInt(gameIDStr, 10, 64)
+		if err != nil {
+			return schedule.ExportFilter{}, errInvalidGameID
+		}
+		result.GameID = gameID
+	}
+
+	if statusStr := request.Get("status"); statusStr != "" {
+		for status := range match.StatusList {
+			if status.String() == statusStr {
+				result.Status = status
+			}
+		}
+		if result.Status == match.StatusUnknown {
+			return schedule.ExportFilter{}, errInvalidStatus
+		}
+	}
+
+	return result, nil
+}
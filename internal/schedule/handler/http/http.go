@@ -0,0 +1,150 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/schedule"
+)
+
+var (
+	errUnknownConfig = errors.New("unknown config name")
+)
+
+// Handler contains schedule HTTP-handlers.
+type Handler struct {
+	handlers map[string]*handler
+	schedule schedule.Service
+	admin    admin.Service
+}
+
+// handler is the HTTP handler wrapper.
+type handler struct {
+	h        http.Handler
+	identity HandlerIdentity
+}
+
+// HandlerIdentity denotes the identity of an HTTP hanlder.
+type HandlerIdentity struct {
+	Name string
+	URL  string
+}
+
+// Followings are the known HTTP handler identities
+var (
+	// HandlerImport denotes HTTP handler to import games,
+	// teams, or matchs
+	HandlerImport = HandlerIdentity{
+		Name: "import",
+		URL:  "/schedules/import",
+	}
+
+	// HandlerExport denotes HTTP handler to export matchs
+	HandlerExport = HandlerIdentity{
+		Name: "export",
+		URL:  "/schedules/export",
+	}
+
+	// HandlerCalendar denotes HTTP handler to get matchs as
+	// iCalendar feed
+	HandlerCalendar = HandlerIdentity{
+		Name: "calendar",
+		URL:  "/schedules/calendar.ics",
+	}
+)
+
+// New creates a new Handler.
+func New(schedule schedule.Service, admin admin.Service, identities []HandlerIdentity) (*Handler, error) {
+	h := &Handler{
+		handlers: make(map[string]*handler),
+		schedule: schedule,
+		admin:    admin,
+	}
+
+	// apply options
+	for _, identity := range identities {
+		if h.handlers == nil {
+			h.handlers = map[string]*handler{}
+		}
+
+		h.handlers[identity.Name] = &handler{
+			identity: identity,
+		}
+
+		handler, err := h.createHTTPHandler(identity.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		h.handlers[identity.Name].h = handler
+	}
+
+	return h, nil
+}
+
+// createHTTPHandler creates a new HTTP handler that
+// implements http.Handler.
+func (h *Handler) createHTTPHandler(configName string) (http.Handler, error) {
+	var httpHandler http.Handler
+	switch configName {
+	case HandlerImport.Name:
+		httpHandler = &importHandler{
+			schedule: h.schedule,
+			admin:    h.admin,
+		}
+	case HandlerExport.Name:
+		httpHandler = &exportHandler{
+			schedule: h.schedule,
+			admin:    h.admin,
+		}
+	case HandlerCalendar.Name:
+		httpHandler = &calendarHandler{
+			schedule: h.schedule,
+		}
+	default:
+		return httpHandler, errUnknownConfig
+	}
+	return httpHandler, nil
+}
+
+// Start starts all HTTP handlers.
+func (h *Handler) Start(multiplexer *mux.Router) error {
+	for _, handler := range h.handlers {
+		multiplexer.Handle(handler.identity.URL, handler.h).Name(handler.identity.Name)
+	}
+	return nil
+}
+
+// importReportHTTP denotes import report object in HTTP
+// response body.
+type importReportHTTP struct {
+	DryRun   bool               `json:"dry_run"`
+	Imported bool               `json:"imported"`
+	Records  []recordReportHTTP `json:"records"`
+}
+
+// recordReportHTTP denotes report of an imported record in
+// HTTP response body.
+type recordReportHTTP struct {
+	Row         int              `json:"row"`
+	ID          int64            `json:"id"`
+	Resolutions []resolutionHTTP `json:"resolutions"`
+	Problems    []problemHTTP    `json:"problems"`
+}
+
+// resolutionHTTP denotes resolved name object in HTTP
+// response body.
+type resolutionHTTP struct {
+	Column string `json:"column"`
+	Name   string `json:"name"`
+	ID     int64  `json:"id"`
+}
+
+// problemHTTP denotes problem object in HTTP response body.
+type problemHTTP struct {
+	Column  string `json:"column"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,118 @@
+package http
+
+import (
+	"context"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/schedule"
+)
+
+type importHandler struct {
+	schedule schedule.Service
+	admin    admin.Service
+}
+
+func (h *importHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		h.handleImport(w, r)
+	default:
+		helper.WriteErrorResponse(w, http.StatusMethodNotAllowed, []string{errMethodNotAllowed.Error()})
+	}
+}
+
+func (h *importHandler) handleImport(w http.ResponseWriter, r *http.Request) {
+	helper.Serve(w, r, newEndpoint("handleImport"), func(ctx context.Context) (importReportHTTP, error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return importReportHTTP{}, err
+		}
+
+		// parse import request, the records are read from
+		// the request body
+		req, err := parseImportRequest(r)
+		if err != nil {
+			return importReportHTTP{}, helper.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		res, err := h.schedule.Import(ctx, req)
+		if err != nil {
+			return importReportHTTP{}, err
+		}
+
+		return formatImportReport(res), nil
+	})
+}
+
+// parseImportRequest returns schedule.ImportRequest from the
+// given HTTP request.
+//
+// The format is taken from "format" query, or from the
+// Content-Type header if it is not set.
+func parseImportRequest(r *http.Request) (schedule.ImportRequest, error) {
+	query := r.URL.Query()
+	result := schedule.ImportRequest{
+		Kind:   schedule.Kind(query.Get("kind")),
+		Format: schedule.Format(query.Get("format")),
+		Data:   r.Body,
+	}
+
+	if result.Format == "" {
+		result.Format = schedule.FormatJSON
+		mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if mediaType == "text/csv" {
+			result.Format = schedule.FormatCSV
+		}
+	}
+
+	if dryRunStr := query.Get("dry_run"); dryRunStr != "" {
+		dryRun, err := strconv.ParseBool(dryRunStr)
+		if err != nil {
+			return schedule.ImportRequest{}, errInvalidDryRun
+		}
+		result.DryRun = dryRun
+	}
+
+	return result, nil
+}
+
+// formatImportReport formats the given import report into
+// its HTTP response object.
+func formatImportReport(report schedule.ImportReport) importReportHTTP {
+	result := importReportHTTP{
+		DryRun:   report.DryRun,
+		Imported: report.Imported,
+		Records:  make([]recordReportHTTP, 0, len(report.Records)),
+	}
+
+	for _, rr := range report.Records {
+		record := recordReportHTTP{
+			Row:         rr.Row,
+			ID:          rr.ID,
+			Resolutions: make([]resolutionHTTP, 0, len(rr.Resolutions)),
+			Problems:    make([]problemHTTP, 0, len(rr.Problems)),
+		}
+		for _, v := range rr.Resolutions {
+			record.Resolutions = append(record.Resolutions, resolutionHTTP{
+				Column: v.Column,
+				Name:   v.Name,
+				ID:     v.ID,
+			})
+		}
+		for _, v := range rr.Problems {
+			record.Problems = append(record.Problems, problemHTTP{
+				Column:  v.Column,
+				Code:    v.Code,
+				Message: v.Message,
+			})
+		}
+		result.Records = append(result.Records, record)
+	}
+
+	return result
+}
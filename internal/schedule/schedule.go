@@ -0,0 +1,140 @@
+package schedule
+
+import (
+	"context"
+	"io"
+
+	"github.com/x-sports/internal/match"
+)
+
+type Service interface {
+	// Import imports the records of the given request and
+	// returns the report of each record.
+	//
+	// All records are validated and their game and team
+	// names are resolved before any of them is imported, so
+	// nothing is imported if any record has a problem. In dry
+	// run, the report is returned without importing anything.
+	Import(ctx context.Context, req ImportRequest) (ImportReport, error)
+
+	// ExportMatches writes the matches filtered by the given
+	// filter into w as CSV, with the match ID followed by the
+	// columns of the matches import.
+	ExportMatches(ctx context.Context, w io.Writer, filter ExportFilter) error
+
+	// ExportCalendar writes the matches filtered by the given
+	// filter into w as an iCalendar feed.
+	//
+	// Each match is written as an event whose UID is derived
+	// from the match ID, so updates and reschedules of the
+	// match replace the event in subscribed calendars.
+	ExportCalendar(ctx context.Context, w io.Writer, filter CalendarFilter) error
+}
+
+// Kind denotes the kind of imported records.
+type Kind string
+
+// Followings are the known kinds.
+const (
+	KindGames   Kind = "games"
+	KindTeams   Kind = "teams"
+	KindMatches Kind = "matches"
+)
+
+// Format denotes the format of imported data.
+type Format string
+
+// Followings are the known formats.
+const (
+	FormatCSV  Format = "csv"
+	FormatJSON Format = "json"
+)
+
+// ImportRequest denotes a request to import records.
+//
+// CSV data must have a header row with the column names,
+// while JSON data must be an array of objects with the column
+// names as keys. The columns of each kind are:
+//   - games: game_names, game_icons.
+//   - teams: team_names, team_icons, game.
+//   - matches: tournament_names, game, team_a, team_b,
+//     team_a_odds, team_b_odds, date, match_link, status,
+//     winner.
+//
+// Games and teams are referred by their names, case
+// insensitive, and the winner is referred by the name of one
+// of the match teams.
+type ImportRequest struct {
+	Kind   Kind
+	Format Format
+	Data   io.Reader
+	DryRun bool
+}
+
+// ImportReport denotes the result of an import.
+type ImportReport struct {
+	DryRun bool
+
+	// Imported is true if the records are imported, it is
+	// false in dry run or if any record has a problem.
+	Imported bool
+
+	Records []RecordReport
+}
+
+// RecordReport denotes the result of a single record.
+type RecordReport struct {
+	// Row is the number of the record starting from 1, not
+	// including CSV header.
+	Row int
+
+	// ID is the ID of the imported record, zero if it is not
+	// imported.
+	ID int64
+
+	// Resolutions are the resolved names of the record.
+	Resolutions []Resolution
+
+	// Problems are the validation problems of the record.
+	Problems []Problem
+}
+
+// Resolution denotes a name resolved into its ID.
+type Resolution struct {
+	Column string
+	Name   string
+	ID     int64
+}
+
+// Problem denotes a validation problem of a column.
+type Problem struct {
+	Column  string
+	Code    string
+	Message string
+}
+
+// ExportFilter denotes the filter of exported matches.
+type ExportFilter struct {
+	// GameID filters matches of the given game, zero to get
+	// matches of all games.
+	GameID int64
+
+	// Status filters matches with the given status, unknown
+	// status to get matches of all status.
+	Status match.Status
+}
+
+// CalendarFilter denotes the filter of matches in a calendar
+// feed, zero value fields are not used to filter.
+type CalendarFilter struct {
+	// GameID filters matches of the given game.
+	GameID int64
+
+	// TeamID filters matches where the given team plays as
+	// either team.
+	TeamID int64
+
+	// TournamentNames filters matches of the given
+	// tournament, case insensitive.
+	TournamentNames string
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/x-sports/global/tracing"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/schedule"
+)
+
+// Followings are the properties of calendar feed.
+const (
+	// calendarProductID is the identifier of the product
+	// generating the calendar.
+	calendarProductID = "-//x-sports//schedule//EN"
+
+	// calendarUIDDomain is the domain of event UIDs, it must
+	// not be changed, otherwise subscribed calendars would
+	// duplicate all events.
+	calendarUIDDomain = "x-sports"
+
+	// calendarEventDuration is the duration of a match event,
+	// as a match only has its start date.
+	calendarEventDuration = 2 * time.Hour
+
+	// calendarRefreshInterval is the suggested interval for
+	// subscribed calendars to refresh the feed.
+	calendarRefreshInterval = "PT1H"
+
+	// calendarTimeFormat is the UTC date-time format of
+	// iCalendar.
+	calendarTimeFormat = "20060102T150405Z"
+
+	// calendarLineLimit is the maximum octets of a content
+	// line, excluding the line break.
+	calendarLineLimit = 75
+)
+
+// icsEscaper escapes characters of iCalendar text value.
+var icsEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+func (s *service) ExportCalendar(ctx context.Context, w io.Writer, filter schedule.CalendarFilter) error {
+	ctx, span := tracing.Start(ctx, "schedule.Service.ExportCalendar")
+	defer span.End()
+
+	matches, err := s.match.GetAllMatchs(ctx, match.Filter{
+		GameID:          filter.GameID,
+		TeamID:          filter.TeamID,
+		TournamentNames: filter.TournamentNames,
+	})
+	if err != nil {
+		return err
+	}
+
+	cw := &calendarWriter{
+		w: bufio.NewWriter(w),
+	}
+
+	cw.writeLine("BEGIN", "VCALENDAR")
+	cw.writeLine("VERSION", "2.0")
+	cw.writeLine("PRODID", calendarProductID)
+	cw.writeLine("CALSCALE", "GREGORIAN")
+	cw.writeLine("METHOD", "PUBLISH")
+	cw.writeLine("X-WR-CALNAME", icsEscaper.Replace(calendarName(filter, matches)))
+	cw.writeLine("REFRESH-INTERVAL;VALUE=DURATION", calendarRefreshInterval)
+	cw.writeLine("X-PUBLISHED-TTL", calendarRefreshInterval)
+
+	for _, m := range matches {
+		cw.writeEvent(m)
+	}
+
+	cw.writeLine("END", "VCALENDAR")
+
+	if cw.err != nil {
+		return cw.err
+	}
+	return cw.w.Flush()
+}
+
+// calendarName returns the display name of a calendar with
+// the given filter, named after the filtered game, team, or
+// tournament of the given matches.
+func calendarName(filter schedule.CalendarFilter, matches []match.Match) string {
+	name := "x-sports matches"
+	for _, m := range matches {
+		switch {
+		case filter.TeamID != 0 && m.TeamAID == filter.TeamID:
+			name = m.TeamANames + " matches"
+		case filter.TeamID != 0:
+			name = m.TeamBNames + " matches"
+		case filter.TournamentNames != "":
+			name = m.TournamentNames
+		case filter.GameID != 0:
+			name = m.GameNames + " matches"
+		}
+		break
+	}
+
+	return name
+}
+
+// calendarWriter writes iCalendar content lines, keeping the
+// first error found so the lines can be written without
+// checking each error.
+type calendarWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+// writeEvent writes the given match as an event.
+func (cw *calendarWriter) writeEvent(m match.Match) {
+	summary := fmt.Sprintf("%s vs %s", m.TeamANames, m.TeamBNames)
+
+	description := []string{
+		m.TournamentNames,
+		m.GameNames,
+	}
+	if m.Status == match.StatusCompleted {
+		switch m.Winner {
+		case m.TeamAID:
+			description = append(description, "Winner: "+m.TeamANames)
+		case m.TeamBID:
+			description = append(description, "Winner: "+m.TeamBNames)
+		}
+	}
+
+	lastModified := m.UpdateTime
+	if lastModified.IsZero() {
+		lastModified = m.CreateTime
+	}
+
+	cw.writeLine("BEGIN", "VEVENT")
+	cw.writeLine("UID", fmt.Sprintf("match-%d@%s", m.ID, calendarUIDDomain))
+	cw.writeLine("DTSTAMP", lastModified.UTC().Format(calendarTimeFormat))
+	cw.writeLine("LAST-MODIFIED", lastModified.UTC().Format(calendarTimeFormat))
+	// sequence must increase on each update for the calendar
+	// to replace the event
+	cw.writeLine("SEQUENCE", strconv.FormatInt(m.Revision, 10))
+	cw.writeLine("DTSTART", m.Date.UTC().Format(calendarTimeFormat))
+	cw.writeLine("DTEND", m.Date.Add(calendarEventDuration).UTC().Format(calendarTimeFormat))
+	cw.writeLine("SUMMARY", icsEscaper.Replace(summary))
+	cw.writeLine("DESCRIPTION", icsEscaper.Replace(strings.Join(description, "\n")))
+	cw.writeLine("CATEGORIES", icsEscaper.Replace(m.GameNames))
+	if m.MatchLink != "" {
+		cw.writeLine("URL", m.MatchLink)
+	}
+	cw.writeLine("STATUS", "CONFIRMED")
+	cw.writeLine("END", "VEVENT")
+}
+
+// writeLine writes a content line of the given property name
+// and value, folded into multiple lines if it is too long.
+func (cw *calendarWriter) writeLine(name, value string) {
+	if cw.err != nil {
+		return
+	}
+
+	line := name + ":" + value
+	limit := calendarLineLimit
+	for len(line) > limit {
+		// do not split a multi-byte character
+		i := limit
+		for i > 0 && !utf8.RuneStart(line[i]) {
+			i--
+		}
+
+		_, cw.err = cw.w.WriteString(line[:i] + "\r\n ")
+		if cw.err != nil {
+			return
+		}
+		line = line[i:]
+
+		// continuation lines start with a space
+		limit = calendarLineLimit - 1
+	}
+
+	_, cw.err = cw.w.WriteString(line + "\r\n")
+}
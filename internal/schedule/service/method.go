@@ -0,0 +1,458 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/global/tracing"
+	"github.com/x-sports/internal/game"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/schedule"
+	"github.com/x-sports/internal/team"
+)
+
+// matchColumns are the columns of exported matches.
+var matchColumns = []string{
+	"id",
+	"tournament_names",
+	"game",
+	"team_a",
+	"team_b",
+	"team_a_odds",
+	"team_b_odds",
+	"date",
+	"match_link",
+	"status",
+	"winner",
+}
+
+// fieldColumns maps the fields of validation errors into
+// their import columns, fields not found here share the same
+// name as the columns.
+var fieldColumns = map[string]string{
+	"game_id":   "game",
+	"team_a_id": "team_a",
+	"team_b_id": "team_b",
+}
+
+func (s *service) Import(ctx context.Context, req schedule.ImportRequest) (schedule.ImportReport, error) {
+	ctx, span := tracing.Start(ctx, "schedule.Service.Import")
+	defer span.End()
+
+	// validate kind
+	switch req.Kind {
+	case schedule.KindGames, schedule.KindTeams, schedule.KindMatches:
+	default:
+		return schedule.ImportReport{}, schedule.ErrInvalidKind
+	}
+
+	records, err := parseRecords(req.Format, req.Data)
+	if err != nil {
+		return schedule.ImportReport{}, err
+	}
+	if len(records) == 0 || len(records) > maxImportRecords {
+		return schedule.ImportReport{}, schedule.ErrInvalidRecordCount
+	}
+
+	report := schedule.ImportReport{
+		DryRun:  req.DryRun,
+		Records: make([]schedule.RecordReport, len(records)),
+	}
+	for i := range report.Records {
+		report.Records[i].Row = i + 1
+	}
+
+	res := &resolver{
+		game: s.game,
+		team: s.team,
+	}
+
+	switch req.Kind {
+	case schedule.KindGames:
+		err = s.importGames(ctx, res, records, &report)
+	case schedule.KindTeams:
+		err = s.importTeams(ctx, res, records, &report)
+	case schedule.KindMatches:
+		err = s.importMatches(ctx, res, records, &report)
+	}
+	if err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
+
+func (s *service) ExportMatches(ctx context.Context, w io.Writer, filter schedule.ExportFilter) error {
+	ctx, span := tracing.Start(ctx, "schedule.Service.ExportMatches")
+	defer span.End()
+
+	matches, err := s.match.GetAllMatchs(ctx, match.Filter{
+		GameID: filter.GameID,
+		Status: filter.Status,
+	})
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	err = writer.Write(matchColumns)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range matches {
+		var winner string
+		switch {
+		case m.Winner == 0:
+		case m.Winner == m.TeamAID:
+			winner = m.TeamANames
+		case m.Winner == m.TeamBID:
+			winner = m.TeamBNames
+		}
+
+		err = writer.Write([]string{
+			strconv.FormatInt(m.ID, 10),
+			m.TournamentNames,
+			m.GameNames,
+			m.TeamANames,
+			m.TeamBNames,
+			strconv.FormatFloat(float64(m.TeamAOdds), 'f', -1, 32),
+			strconv.FormatFloat(float64(m.TeamBOdds), 'f', -1, 32),
+			m.Date.Format(dateFormat),
+			m.MatchLink,
+			m.Status.String(),
+			winner,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// importGames validates the given game records and creates
+// them in a single transaction if all of them are valid,
+// unless it is a dry run.
+func (s *service) importGames(ctx context.Context, res *resolver, records []record, report *schedule.ImportReport) error {
+	games := make([]game.Game, len(records))
+	for i, rec := range records {
+		rr := &report.Records[i]
+		games[i] = game.Game{
+			GameNames: rec["game_names"],
+			GameIcons: rec["game_icons"],
+		}
+
+		// imported game must not exist yet
+		if games[i].GameNames != "" {
+			_, err := res.resolveGame(ctx, games[i].GameNames)
+			switch {
+			case err == nil || errors.Is(err, schedule.ErrAmbiguousGame):
+				addProblem(rr, "game_names", helper.CodeConflict, schedule.ErrDuplicate)
+			case !errors.Is(err, schedule.ErrUnknownGame):
+				return err
+			}
+		}
+
+		err := addValidationProblems(rr, s.game.ValidateGame(ctx, games[i]))
+		if err != nil {
+			return err
+		}
+	}
+
+	if report.DryRun || hasProblems(report) {
+		return nil
+	}
+
+	// create all games in a single transaction
+	gameIDs, err := s.game.CreateGames(ctx, games)
+	if err != nil {
+		return err
+	}
+	for i, gameID := range gameIDs {
+		report.Records[i].ID = gameID
+	}
+	report.Imported = true
+
+	return nil
+}
+
+// importTeams validates the given team records and creates
+// them in a single transaction if all of them are valid,
+// unless it is a dry run.
+func (s *service) importTeams(ctx context.Context, res *resolver, records []record, report *schedule.ImportReport) error {
+	teams := make([]team.Team, len(records))
+	for i, rec := range records {
+		rr := &report.Records[i]
+		teams[i] = team.Team{
+			TeamNames: rec["team_names"],
+			TeamIcons: rec["team_icons"],
+		}
+
+		gameID, err := resolveGameColumn(ctx, res, rr, rec)
+		if err != nil {
+			return err
+		}
+		teams[i].GameID = gameID
+
+		// imported team must not exist yet in its game
+		if gameID != 0 && teams[i].TeamNames != "" {
+			_, err := res.resolveTeam(ctx, gameID, teams[i].TeamNames)
+			switch {
+			case err == nil || errors.Is(err, schedule.ErrAmbiguousTeam):
+				addProblem(rr, "team_names", helper.CodeConflict, schedule.ErrDuplicate)
+			case !errors.Is(err, schedule.ErrUnknownTeam):
+				return err
+			}
+		}
+
+		err = addValidationProblems(rr, s.team.ValidateTeam(ctx, teams[i]))
+		if err != nil {
+			return err
+		}
+	}
+
+	if report.DryRun || hasProblems(report) {
+		return nil
+	}
+
+	// create all teams in a single transaction
+	teamIDs, err := s.team.CreateTeams(ctx, teams)
+	if err != nil {
+		return err
+	}
+	for i, teamID := range teamIDs {
+		report.Records[i].ID = teamID
+	}
+	report.Imported = true
+
+	return nil
+}
+
+// importMatches validates the given match records and
+// creates them in a single transaction if all of them are
+// valid, unless it is a dry run.
+func (s *service) importMatches(ctx context.Context, res *resolver, records []record, report *schedule.ImportReport) error {
+	matches := make([]match.Match, len(records))
+	for i, rec := range records {
+		rr := &report.Records[i]
+		matches[i] = match.Match{
+			TournamentNames: rec["tournament_names"],
+			MatchLink:       rec["match_link"],
+			Status:          match.StatusUpcoming,
+		}
+
+		// resolve game, then the teams within the game
+		gameID, err := resolveGameColumn(ctx, res, rr, rec)
+		if err != nil {
+			return err
+		}
+		matches[i].GameID = gameID
+
+		if gameID != 0 {
+			matches[i].TeamAID, err = resolveTeamColumn(ctx, res, rr, gameID, "team_a", rec["team_a"])
+			if err != nil {
+				return err
+			}
+			matches[i].TeamBID, err = resolveTeamColumn(ctx, res, rr, gameID, "team_b", rec["team_b"])
+			if err != nil {
+				return err
+			}
+		}
+
+		// parse the other columns
+		matches[i].TeamAOdds = parseOddsColumn(rr, "team_a_odds", rec["team_a_odds"])
+		matches[i].TeamBOdds = parseOddsColumn(rr, "team_b_odds", rec["team_b_odds"])
+
+		if v := rec["date"]; v != "" {
+			date, err := time.Parse(dateFormat, v)
+			if err != nil {
+				addProblem(rr, "date", helper.CodeInvalidFormat, schedule.ErrInvalidValue)
+			}
+			matches[i].Date = date
+		}
+
+		if v := rec["status"]; v != "" {
+			matches[i].Status = match.StatusUnknown
+			for status := range match.StatusList {
+				if strings.EqualFold(status.String(), v) {
+					matches[i].Status = status
+				}
+			}
+		}
+
+		// winner is one of the match teams
+		if v := rec["winner"]; v != "" {
+			switch {
+			case strings.EqualFold(v, rec["team_a"]):
+				matches[i].Winner = matches[i].TeamAID
+			case strings.EqualFold(v, rec["team_b"]):
+				matches[i].Winner = matches[i].TeamBID
+			default:
+				addProblem(rr, "winner", helper.CodeInvalidValue, schedule.ErrUnknownTeam)
+			}
+		}
+
+		err = addValidationProblems(rr, s.match.ValidateMatch(ctx, matches[i]))
+		if err != nil {
+			return err
+		}
+	}
+
+	if report.DryRun || hasProblems(report) {
+		return nil
+	}
+
+	matchIDs, err := s.match.CreateMatches(ctx, matches)
+	if err != nil {
+		return err
+	}
+	for i, matchID := range matchIDs {
+		report.Records[i].ID = matchID
+	}
+	report.Imported = true
+
+	return nil
+}
+
+// resolveGameColumn resolves the game column of the given
+// record into the game ID, zero is returned if it cannot be
+// resolved and the problem is added to the record report.
+func resolveGameColumn(ctx context.Context, res *resolver, rr *schedule.RecordReport, rec record) (int64, error) {
+	name := rec["game"]
+	if name == "" {
+		addProblem(rr, "game", helper.CodeRequired, schedule.ErrUnknownGame)
+		return 0, nil
+	}
+
+	g, err := res.resolveGame(ctx, name)
+	switch {
+	case errors.Is(err, schedule.ErrUnknownGame):
+		addProblem(rr, "game", helper.CodeNotFound, err)
+		return 0, nil
+	case errors.Is(err, schedule.ErrAmbiguousGame):
+		addProblem(rr, "game", helper.CodeConflict, err)
+		return 0, nil
+	case err != nil:
+		return 0, err
+	}
+
+	rr.Resolutions = append(rr.Resolutions, schedule.Resolution{
+		Column: "game",
+		Name:   name,
+		ID:     g.ID,
+	})
+
+	return g.ID, nil
+}
+
+// resolveTeamColumn resolves the given team name of a team
+// column into the team ID, zero is returned if it cannot be
+// resolved and the problem is added to the record report.
+func resolveTeamColumn(ctx context.Context, res *resolver, rr *schedule.RecordReport, gameID int64, column, name string) (int64, error) {
+	if name == "" {
+		addProblem(rr, column, helper.CodeRequired, schedule.ErrUnknownTeam)
+		return 0, nil
+	}
+
+	t, err := res.resolveTeam(ctx, gameID, name)
+	switch {
+	case errors.Is(err, schedule.ErrUnknownTeam):
+		addProblem(rr, column, helper.CodeNotFound, err)
+		return 0, nil
+	case errors.Is(err, schedule.ErrAmbiguousTeam):
+		addProblem(rr, column, helper.CodeConflict, err)
+		return 0, nil
+	case err != nil:
+		return 0, err
+	}
+
+	rr.Resolutions = append(rr.Resolutions, schedule.Resolution{
+		Column: column,
+		Name:   name,
+		ID:     t.ID,
+	})
+
+	return t.ID, nil
+}
+
+// parseOddsColumn parses the given odds, zero is returned if
+// it cannot be parsed and the problem is added to the record
+// report.
+func parseOddsColumn(rr *schedule.RecordReport, column, value string) float32 {
+	if value == "" {
+		return 0
+	}
+
+	odds, err := strconv.ParseFloat(value, 32)
+	if err != nil {
+		addProblem(rr, column, helper.CodeInvalidFormat, schedule.ErrInvalidValue)
+		return 0
+	}
+
+	return float32(odds)
+}
+
+// addProblem adds a problem of the given column into the
+// record report.
+func addProblem(rr *schedule.RecordReport, column, code string, err error) {
+	rr.Problems = append(rr.Problems, schedule.Problem{
+		Column:  column,
+		Code:    code,
+		Message: err.Error(),
+	})
+}
+
+// addValidationProblems adds the violations of the given
+// validation error into the record report, except for the
+// columns that already have a problem, e.g. unresolved game.
+//
+// Errors other than validation error are returned as is.
+func addValidationProblems(rr *schedule.RecordReport, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var verr *helper.ValidationError
+	if !errors.As(err, &verr) {
+		return err
+	}
+
+	reported := make(map[string]struct{})
+	for _, p := range rr.Problems {
+		reported[p.Column] = struct{}{}
+	}
+
+	for _, fe := range verr.Fields {
+		column := fe.Field
+		if v, ok := fieldColumns[column]; ok {
+			column = v
+		}
+		if _, ok := reported[column]; ok {
+			continue
+		}
+		reported[column] = struct{}{}
+
+		addProblem(rr, column, fe.Code, fe.Err)
+	}
+
+	return nil
+}
+
+// hasProblems returns whether any record of the given report
+// has a problem.
+func hasProblems(report *schedule.ImportReport) bool {
+	for _, rr := range report.Records {
+		if len(rr.Problems) > 0 {
+			return true
+		}
+	}
+	return false
+}
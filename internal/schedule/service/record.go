@@ -0,0 +1,91 @@
+package service
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/x-sports/internal/schedule"
+)
+
+// record is an imported record, it maps column names into
+// their values.
+type record map[string]string
+
+// parseRecords parses the given data in the given format into
+// records.
+func parseRecords(format schedule.Format, data io.Reader) ([]record, error) {
+	switch format {
+	case schedule.FormatCSV:
+		return parseCSVRecords(data)
+	case schedule.FormatJSON:
+		return parseJSONRecords(data)
+	}
+	return nil, schedule.ErrInvalidFormat
+}
+
+// parseCSVRecords parses the given CSV data, whose first row
+// is the header, into records.
+func parseCSVRecords(data io.Reader) ([]record, error) {
+	reader := csv.NewReader(data)
+	reader.TrimLeadingSpace = true
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, schedule.ErrInvalidData
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	header := rows[0]
+	for i, column := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(column))
+	}
+
+	records := make([]record, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		rec := record{}
+		for i, column := range header {
+			rec[column] = strings.TrimSpace(row[i])
+		}
+		records = append(records, rec)
+	}
+
+	return records, nil
+}
+
+// parseJSONRecords parses the given JSON data, an array of
+// objects with string or number values, into records.
+func parseJSONRecords(data io.Reader) ([]record, error) {
+	decoder := json.NewDecoder(data)
+	decoder.UseNumber()
+
+	var objects []map[string]interface{}
+	err := decoder.Decode(&objects)
+	if err != nil {
+		return nil, schedule.ErrInvalidData
+	}
+
+	records := make([]record, 0, len(objects))
+	for _, object := range objects {
+		rec := record{}
+		for column, value := range object {
+			column = strings.ToLower(strings.TrimSpace(column))
+			switch v := value.(type) {
+			case nil:
+				rec[column] = ""
+			case string:
+				rec[column] = strings.TrimSpace(v)
+			case json.Number:
+				rec[column] = v.String()
+			default:
+				return nil, schedule.ErrInvalidData
+			}
+		}
+		records = append(records, rec)
+	}
+
+	return records, nil
+}
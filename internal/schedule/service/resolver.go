@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/x-sports/internal/game"
+	"github.com/x-sports/internal/schedule"
+	"github.com/x-sports/internal/team"
+)
+
+// resolver resolves game and team names into their IDs. The
+// games and teams are only fetched once per import.
+type resolver struct {
+	game  game.Service
+	team  team.Service
+	games []game.Game
+	teams map[int64][]team.Team
+}
+
+// resolveGame returns the game with the given name, case
+// insensitive.
+func (r *resolver) resolveGame(ctx context.Context, name string) (game.Game, error) {
+	if r.games == nil {
+		games, err := r.game.GetAllGames(ctx)
+		if err != nil {
+			return game.Game{}, err
+		}
+		r.games = games
+	}
+
+	var found []game.Game
+	for _, g := range r.games {
+		if strings.EqualFold(g.GameNames, name) {
+			found = append(found, g)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return game.Game{}, schedule.ErrUnknownGame
+	case 1:
+		return found[0], nil
+	}
+	return game.Game{}, schedule.ErrAmbiguousGame
+}
+
+// resolveTeam returns the team of the given game with the
+// given name, case insensitive.
+func (r *resolver) resolveTeam(ctx context.Context, gameID int64, name string) (team.Team, error) {
+	if r.teams == nil {
+		r.teams = make(map[int64][]team.Team)
+	}
+
+	teams, ok := r.teams[gameID]
+	if !ok {
+		var err error
+		teams, err = r.team.GetAllTeams(ctx, team.Filter{GameID: gameID})
+		if err != nil {
+			return team.Team{}, err
+		}
+		r.teams[gameID] = teams
+	}
+
+	var found []team.Team
+	for _, t := range teams {
+		if strings.EqualFold(t.TeamNames, name) {
+			found = append(found, t)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return team.Team{}, schedule.ErrUnknownTeam
+	case 1:
+		return found[0], nil
+	}
+	return team.Team{}, schedule.ErrAmbiguousTeam
+}
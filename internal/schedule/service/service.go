@@ -0,0 +1,31 @@
+package service
+
+import (
+	"github.com/x-sports/internal/game"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/team"
+)
+
+// maxImportRecords is the maximum number of records in an
+// import, it follows the limit of match bulk creation.
+const maxImportRecords = 100
+
+// dateFormat is the date format of imported and exported
+// matches, the same as the one of match HTTP request.
+const dateFormat = "2006-01-02 15:04:05 -07:00"
+
+// New construts a new service.
+type service struct {
+	game  game.Service
+	team  team.Service
+	match match.Service
+}
+
+// New returns a new service
+func New(game game.Service, team team.Service, match match.Service) (*service, error) {
+	return &service{
+		game:  game,
+		team:  team,
+		match: match,
+	}, nil
+}
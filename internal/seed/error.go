@@ -0,0 +1,17 @@
+package seed
+
+import "errors"
+
+var (
+	// ErrInvalidSize is returned when the given number of
+	// records is invalid.
+	ErrInvalidSize = errors.New("invalid size")
+
+	// ErrInvalidBaseTime is returned when the given base
+	// time is invalid.
+	ErrInvalidBaseTime = errors.New("invalid base time")
+
+	// ErrNotEmpty is returned when seeding a database that
+	// already has data.
+	ErrNotEmpty = errors.New("database is not empty")
+)
@@ -0,0 +1,49 @@
+package seed
+
+import (
+	"context"
+	"time"
+)
+
+type Service interface {
+	// Seed populates an empty database with fake games, and
+	// teams, matches, news and threads of each game, then
+	// returns the number of created records.
+	//
+	// The data is deterministic, the same options always
+	// create the same records. ErrNotEmpty is returned if
+	// there is already a game, so the data is not duplicated.
+	//
+	// The records are created in a single transaction, so
+	// nothing is created if any of them fails, and without
+	// the events of their creation, as no subscriber needs to
+	// react to fake data.
+	Seed(ctx context.Context, opts Options) (Report, error)
+}
+
+// Options denotes the size and the randomness of the seeded
+// data, the sizes are the number of records of each game.
+type Options struct {
+	// Seed is the source of the randomness.
+	Seed int64
+
+	// BaseTime is the time the match dates are spread around,
+	// completed matches are before it and upcoming matches
+	// are after it.
+	BaseTime time.Time
+
+	Games          int
+	TeamsPerGame   int
+	MatchesPerGame int
+	NewsPerGame    int
+	ThreadsPerGame int
+}
+
+// Report denotes the number of records created by a seed.
+type Report struct {
+	Games   int
+	Teams   int
+	Matches int
+	News    int
+	Threads int
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+// Followings are the pools the fake data is picked from, news
+// and thread templates have {team_a}, {team_b}, {tournament},
+// and {game} placeholders.
+var (
+	gameNames = []string{
+		"Mobile Legends",
+		"Dota 2",
+		"Valorant",
+		"Counter-Strike 2",
+		"League of Legends",
+		"PUBG Mobile",
+		"Free Fire",
+		"Honor of Kings",
+	}
+
+	teamNames = []string{
+		"Evos",
+		"RRQ",
+		"Onic",
+		"Alter Ego",
+		"Bigetron",
+		"Geek Fam",
+		"Aura Fire",
+		"Rebellion",
+		"Team Liquid",
+		"Fnatic",
+		"Paper Rex",
+		"Talon",
+		"Blacklist",
+		"Echo",
+		"Falcons",
+		"Natus Vincere",
+	}
+
+	tournamentKinds = []string{
+		"Pro League",
+		"Invitational",
+		"Masters",
+		"Championship",
+		"Open Series",
+	}
+
+	newsTemplates = []template{
+		{
+			title:       "{team_a} clinch a dominant win over {team_b}",
+			description: "The match went the full distance before {team_a} sealed it in the final round.",
+		},
+		{
+			title:       "{team_a} announce a new roster ahead of the {tournament}",
+			description: "Fans can expect a new look when the {tournament} kicks off next week.",
+		},
+		{
+			title:       "{team_b} part ways with their head coach",
+			description: "The organisation confirmed the change in a statement on social media.",
+		},
+		{
+			title:       "Everything you need to know about the {tournament}",
+			description: "Here is the schedule, the format and the teams to watch.",
+		},
+		{
+			title:       "{team_a} and {team_b} set up a rematch in the {tournament}",
+			description: "Both teams have traded wins this season and the stakes have never been higher.",
+		},
+	}
+
+	threadTemplates = []template{
+		{
+			title:       "Who wins {team_a} vs {team_b}?",
+			description: "Drop your predictions below, score included.",
+		},
+		{
+			title:       "Best picks in the current {game} meta",
+			description: "The last patch changed a lot, what are you picking now?",
+		},
+		{
+			title:       "Is {team_a} the favourite for the {tournament}?",
+			description: "They look unstoppable lately, but the bracket is stacked.",
+		},
+		{
+			title:       "Rate the {tournament} so far",
+			description: "Favourite moments, biggest upsets, and disappointments.",
+		},
+		{
+			title:       "Underrated players on {team_b}",
+			description: "Everyone talks about the star player, but the supports deserve credit too.",
+		},
+	}
+)
+
+// template denotes the title and the description templates
+// of a news or a thread.
+type template struct {
+	title       string
+	description string
+}
+
+// generator generates fake data deterministically from its
+// random source.
+type generator struct {
+	rand *rand.Rand
+}
+
+// pickNames returns n distinct names from the given pool in a
+// random order, numbered names are added if the pool is not
+// large enough.
+func (g *generator) pickNames(pool []string, n int) []string {
+	names := make([]string, 0, n)
+	for round := 1; len(names) < n; round++ {
+		for _, i := range g.rand.Perm(len(pool)) {
+			if len(names) == n {
+				break
+			}
+
+			name := pool[i]
+			if round > 1 {
+				name = fmt.Sprintf("%s %d", name, round)
+			}
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// pickTemplate returns a random template of the given pool.
+func (g *generator) pickTemplate(pool []template) template {
+	return pool[g.rand.Intn(len(pool))]
+}
+
+// pick returns a random item of the given pool.
+func (g *generator) pick(pool []string) string {
+	return pool[g.rand.Intn(len(pool))]
+}
+
+// odds returns random betting odds between 1.1 and 3.5 with
+// two decimal places.
+func (g *generator) odds() float32 {
+	return float32(110+g.rand.Intn(241)) / 100
+}
+
+// iconURL returns a placeholder image URL of the given name.
+func iconURL(name string) string {
+	return "https://picsum.photos/seed/" + slug(name) + "/200"
+}
+
+// imageURL returns a placeholder image URL of a news or a
+// thread.
+func imageURL(kind string, n int) string {
+	return fmt.Sprintf("https://picsum.photos/seed/%s-%d/800/450", kind, n)
+}
+
+// slug returns the given name in lower case with its spaces
+// replaced by dashes.
+func slug(name string) string {
+	return strings.ReplaceAll(strings.ToLower(name), " ", "-")
+}
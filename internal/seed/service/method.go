@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/global/tracing"
+	"github.com/x-sports/internal/game"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/news"
+	"github.com/x-sports/internal/seed"
+	"github.com/x-sports/internal/team"
+	"github.com/x-sports/internal/thread"
+)
+
+// seededGame denotes a created game and its teams, used to
+// generate the other records of the game.
+type seededGame struct {
+	game        game.Game
+	teams       []team.Team
+	tournaments []string
+}
+
+func (s *service) Seed(ctx context.Context, opts seed.Options) (seed.Report, error) {
+	ctx, span := tracing.Start(ctx, "seed.Service.Seed")
+	defer span.End()
+
+	// validate options
+	err := validateOptions(opts)
+	if err != nil {
+		return seed.Report{}, err
+	}
+
+	gen := &generator{
+		rand: rand.New(rand.NewSource(opts.Seed)),
+	}
+	createTime := s.timeNow()
+
+	// seed in a transaction, so that nothing is created if any
+	// of the records fails
+	var report seed.Report
+	err = helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		// only seed an empty database
+		exists, err := pgStoreClient.HasGames(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return seed.ErrNotEmpty
+		}
+
+		sc := seedClient{
+			pgStoreClient: pgStoreClient,
+			gen:           gen,
+			opts:          opts,
+			createTime:    createTime,
+		}
+
+		for _, name := range gen.pickNames(gameNames, opts.Games) {
+			sg, err := sc.seedGame(ctx, name)
+			if err != nil {
+				return err
+			}
+			report.Games++
+			report.Teams += len(sg.teams)
+
+			err = sc.seedMatches(ctx, sg)
+			if err != nil {
+				return err
+			}
+			report.Matches += opts.MatchesPerGame
+
+			err = sc.seedNews(ctx, sg)
+			if err != nil {
+				return err
+			}
+			report.News += opts.NewsPerGame
+
+			err = sc.seedThreads(ctx, sg)
+			if err != nil {
+				return err
+			}
+			report.Threads += opts.ThreadsPerGame
+		}
+
+		return nil
+	})
+	if err != nil {
+		return seed.Report{}, err
+	}
+
+	return report, nil
+}
+
+// seedClient creates the seeded records of the given options
+// using the given store client.
+type seedClient struct {
+	pgStoreClient PGStoreClient
+	gen           *generator
+	opts          seed.Options
+	createTime    time.Time
+}
+
+// seedGame creates a game with the given name and its teams.
+func (sc seedClient) seedGame(ctx context.Context, name string) (seededGame, error) {
+	g := game.Game{
+		GameNames:  name,
+		GameIcons:  iconURL(name),
+		CreateTime: sc.createTime,
+	}
+
+	gameID, err := sc.pgStoreClient.CreateGame(ctx, g)
+	if err != nil {
+		return seededGame{}, err
+	}
+	g.ID = gameID
+
+	result := seededGame{
+		game: g,
+	}
+
+	for _, teamName := range sc.gen.pickNames(teamNames, sc.opts.TeamsPerGame) {
+		t := team.Team{
+			TeamNames:  teamName,
+			TeamIcons:  iconURL(name + " " + teamName),
+			GameID:     gameID,
+			CreateTime: sc.createTime,
+		}
+
+		teamID, err := sc.pgStoreClient.CreateTeam(ctx, t)
+		if err != nil {
+			return seededGame{}, err
+		}
+		t.ID = teamID
+
+		result.teams = append(result.teams, t)
+	}
+
+	// every game has two tournaments this year
+	for _, kind := range sc.gen.pickNames(tournamentKinds, 2) {
+		result.tournaments = append(result.tournaments, fmt.Sprintf("%s %s %d", name, kind, sc.opts.BaseTime.Year()))
+	}
+
+	return result, nil
+}
+
+// seedMatches creates the matches of the given game, cycling
+// through all match status.
+func (sc seedClient) seedMatches(ctx context.Context, sg seededGame) error {
+	gen, opts := sc.gen, sc.opts
+	for i := 0; i < opts.MatchesPerGame; i++ {
+		teamA, teamB := pickOpponents(gen, sg.teams)
+
+		m := match.Match{
+			TournamentNames: gen.pick(sg.tournaments),
+			GameID:          sg.game.ID,
+			TeamAID:         teamA.ID,
+			TeamAOdds:       gen.odds(),
+			TeamBID:         teamB.ID,
+			TeamBOdds:       gen.odds(),
+			MatchLink:       fmt.Sprintf("https://www.twitch.tv/%s", slug(sg.game.GameNames)),
+			CreateTime:      sc.createTime,
+		}
+
+		// spread the dates around the base time based on the
+		// status, a day apart at different hours
+		days := time.Duration(i/3+1) * 24 * time.Hour
+		hours := time.Duration(gen.rand.Intn(12)) * time.Hour
+		switch i % 3 {
+		case 0:
+			m.Status = match.StatusCompleted
+			m.Date = opts.BaseTime.Add(-days + hours)
+			m.Winner = teamA.ID
+			if gen.rand.Intn(2) == 1 {
+				m.Winner = teamB.ID
+			}
+		case 1:
+			m.Status = match.StatusUpcoming
+			m.Date = opts.BaseTime.Add(days + hours)
+		case 2:
+			m.Status = match.StatusOngoing
+			m.Date = opts.BaseTime.Add(-time.Hour)
+		}
+
+		_, err := sc.pgStoreClient.CreateMatch(ctx, m)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// seedNews creates the news of the given game, a day apart
+// before the base time.
+func (sc seedClient) seedNews(ctx context.Context, sg seededGame) error {
+	gen, opts := sc.gen, sc.opts
+	for i := 0; i < opts.NewsPerGame; i++ {
+		tmpl, r := gen.pickTemplate(newsTemplates), templateReplacer(gen, sg)
+
+		n := news.News{
+			Title:       r.Replace(tmpl.title),
+			GameID:      sg.game.ID,
+			Description: r.Replace(tmpl.description),
+			ImageNews:   imageURL("news-"+slug(sg.game.GameNames), i+1),
+			Date:        opts.BaseTime.Add(-time.Duration(i*24+gen.rand.Intn(24)) * time.Hour),
+			CreateTime:  sc.createTime,
+		}
+
+		_, err := sc.pgStoreClient.CreateNews(ctx, n)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// seedThreads creates the threads of the given game, a day
+// apart before the base time.
+func (sc seedClient) seedThreads(ctx context.Context, sg seededGame) error {
+	gen, opts := sc.gen, sc.opts
+	for i := 0; i < opts.ThreadsPerGame; i++ {
+		tmpl, r := gen.pickTemplate(threadTemplates), templateReplacer(gen, sg)
+
+		t := thread.Thread{
+			Title:       r.Replace(tmpl.title),
+			GameID:      sg.game.ID,
+			Description: r.Replace(tmpl.description),
+			ImageThread: imageURL("thread-"+slug(sg.game.GameNames), i+1),
+			Date:        opts.BaseTime.Add(-time.Duration(i*24+gen.rand.Intn(24)) * time.Hour),
+			CreateTime:  sc.createTime,
+		}
+
+		_, err := sc.pgStoreClient.CreateThread(ctx, t)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pickOpponents returns two different random teams of the
+// given teams.
+func pickOpponents(gen *generator, teams []team.Team) (team.Team, team.Team) {
+	a := gen.rand.Intn(len(teams))
+	b := gen.rand.Intn(len(teams) - 1)
+	if b >= a {
+		b++
+	}
+	return teams[a], teams[b]
+}
+
+// templateReplacer returns the replacer of news and thread
+// template placeholders with random teams and tournament of
+// the given game. Games without two teams use placeholder
+// team names.
+func templateReplacer(gen *generator, sg seededGame) *strings.Replacer {
+	teamA, teamB := "the champions", "the challengers"
+	if len(sg.teams) >= 2 {
+		a, b := pickOpponents(gen, sg.teams)
+		teamA, teamB = a.TeamNames, b.TeamNames
+	}
+
+	return strings.NewReplacer(
+		"{team_a}", teamA,
+		"{team_b}", teamB,
+		"{tournament}", gen.pick(sg.tournaments),
+		"{game}", sg.game.GameNames,
+	)
+}
+
+// validateOptions validates the given seed options.
+func validateOptions(opts seed.Options) error {
+	if opts.BaseTime.IsZero() {
+		return seed.ErrInvalidBaseTime
+	}
+
+	if opts.Games <= 0 || opts.Games > maxSize {
+		return seed.ErrInvalidSize
+	}
+
+	for _, size := range []int{opts.TeamsPerGame, opts.MatchesPerGame, opts.NewsPerGame, opts.ThreadsPerGame} {
+		if size < 0 || size > maxSize {
+			return seed.ErrInvalidSize
+		}
+	}
+
+	// a match needs two teams
+	if opts.MatchesPerGame > 0 && opts.TeamsPerGame < 2 {
+		return seed.ErrInvalidSize
+	}
+
+	return nil
+}
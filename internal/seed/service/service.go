@@ -0,0 +1,21 @@
+package service
+
+import "time"
+
+// maxSize is the maximum number of records of each kind per
+// game, it follows the limit of match bulk creation.
+const maxSize = 100
+
+// New construts a new service.
+type service struct {
+	pgStore PGStore
+	timeNow func() time.Time
+}
+
+// New returns a new service
+func New(pgStore PGStore) (*service, error) {
+	return &service{
+		pgStore: pgStore,
+		timeNow: time.Now,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+
+	"github.com/x-sports/internal/game"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/news"
+	"github.com/x-sports/internal/team"
+	"github.com/x-sports/internal/thread"
+)
+
+// PGStore is the PostgreSQL store for seed service.
+type PGStore interface {
+	NewClient(useTx bool) (PGStoreClient, error)
+}
+
+// PGStoreClient writes the seeded records directly, without
+// recording the events of the domain services.
+type PGStoreClient interface {
+	// Commit commits the transaction.
+	Commit() error
+
+	// Rollback aborts the transaction.
+	Rollback() error
+
+	// HasGames returns whether there is any game.
+	HasGames(ctx context.Context) (bool, error)
+
+	// CreateGame creates a new game and return the created
+	// game ID.
+	CreateGame(ctx context.Context, game game.Game) (int64, error)
+
+	// CreateTeam creates a new team and return the created
+	// team ID.
+	CreateTeam(ctx context.Context, team team.Team) (int64, error)
+
+	// CreateMatch creates a new match, including its winner,
+	// and return the created match ID.
+	CreateMatch(ctx context.Context, match match.Match) (int64, error)
+
+	// CreateNews creates a new news and return the created
+	// news ID.
+	CreateNews(ctx context.Context, news news.News) (int64, error)
+
+	// CreateThread creates a new thread and return the
+	// created thread ID.
+	CreateThread(ctx context.Context, thread thread.Thread) (int64, error)
+}
@@ -0,0 +1,53 @@
+package instrumented
+
+import (
+	"context"
+
+	"github.com/x-sports/internal/game"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/news"
+	"github.com/x-sports/internal/team"
+	"github.com/x-sports/internal/thread"
+)
+
+func (sc *storeClient) HasGames(ctx context.Context) (bool, error) {
+	ctx, done := observe(ctx, "HasGames")
+	result, err := sc.next.HasGames(ctx)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) CreateGame(ctx context.Context, game game.Game) (int64, error) {
+	ctx, done := observe(ctx, "CreateGame")
+	result, err := sc.next.CreateGame(ctx, game)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) CreateTeam(ctx context.Context, team team.Team) (int64, error) {
+	ctx, done := observe(ctx, "CreateTeam")
+	result, err := sc.next.CreateTeam(ctx, team)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) CreateMatch(ctx context.Context, match match.Match) (int64, error) {
+	ctx, done := observe(ctx, "CreateMatch")
+	result, err := sc.next.CreateMatch(ctx, match)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) CreateNews(ctx context.Context, news news.News) (int64, error) {
+	ctx, done := observe(ctx, "CreateNews")
+	result, err := sc.next.CreateNews(ctx, news)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) CreateThread(ctx context.Context, thread thread.Thread) (int64, error) {
+	ctx, done := observe(ctx, "CreateThread")
+	result, err := sc.next.CreateThread(ctx, thread)
+	done(err)
+	return result, err
+}
@@ -0,0 +1,148 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/x-sports/internal/game"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/memory"
+	"github.com/x-sports/internal/news"
+	"github.com/x-sports/internal/team"
+	"github.com/x-sports/internal/thread"
+)
+
+func (sc *storeClient) HasGames(ctx context.Context) (bool, error) {
+	var exists bool
+	err := sc.c.Do(func(data *memory.Data) error {
+		exists = len(data.Games.All()) > 0
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (sc *storeClient) CreateGame(ctx context.Context, reqGame game.Game) (int64, error) {
+	var gameID int64
+	err := sc.c.Do(func(data *memory.Data) error {
+		gameID = data.Games.NextID()
+
+		// update time defaults to the create time
+		reqGame.ID = gameID
+		reqGame.CreateTime = memory.Time(reqGame.CreateTime)
+		reqGame.UpdateTime = reqGame.CreateTime
+		data.Games.Put(gameID, reqGame)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return gameID, nil
+}
+
+func (sc *storeClient) CreateTeam(ctx context.Context, reqTeam team.Team) (int64, error) {
+	var teamID int64
+	err := sc.c.Do(func(data *memory.Data) error {
+		teamID = data.Teams.NextID()
+
+		// update time defaults to the create time, game
+		// names and icons are derived when read
+		reqTeam.ID = teamID
+		reqTeam.GameNames = ""
+		reqTeam.GameIcons = ""
+		reqTeam.CreateTime = memory.Time(reqTeam.CreateTime)
+		reqTeam.UpdateTime = reqTeam.CreateTime
+		data.Teams.Put(teamID, reqTeam)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return teamID, nil
+}
+
+func (sc *storeClient) CreateMatch(ctx context.Context, reqMatch match.Match) (int64, error) {
+	var matchID int64
+	err := sc.c.Do(func(data *memory.Data) error {
+		matchID = data.Matches.NextID()
+
+		// update time defaults to the create time, names and
+		// icons are derived when read
+		data.Matches.Put(matchID, match.Match{
+			ID:              matchID,
+			TournamentNames: reqMatch.TournamentNames,
+			GameID:          reqMatch.GameID,
+			TeamAID:         reqMatch.TeamAID,
+			TeamAOdds:       reqMatch.TeamAOdds,
+			TeamBID:         reqMatch.TeamBID,
+			TeamBOdds:       reqMatch.TeamBOdds,
+			Date:            memory.Time(reqMatch.Date),
+			MatchLink:       reqMatch.MatchLink,
+			Status:          reqMatch.Status,
+			Winner:          reqMatch.Winner,
+			CreateTime:      memory.Time(reqMatch.CreateTime),
+			UpdateTime:      memory.Time(reqMatch.CreateTime),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return matchID, nil
+}
+
+func (sc *storeClient) CreateNews(ctx context.Context, reqNews news.News) (int64, error) {
+	var newsID int64
+	err := sc.c.Do(func(data *memory.Data) error {
+		newsID = data.News.NextID()
+
+		// update time defaults to the create time, game
+		// names and icons are derived when read
+		reqNews.ID = newsID
+		reqNews.GameNames = ""
+		reqNews.GameIcons = ""
+		reqNews.Date = memory.Time(reqNews.Date)
+		reqNews.CreateTime = memory.Time(reqNews.CreateTime)
+		reqNews.UpdateTime = reqNews.CreateTime
+		data.News.Put(newsID, reqNews)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return newsID, nil
+}
+
+func (sc *storeClient) CreateThread(ctx context.Context, reqThread thread.Thread) (int64, error) {
+	var threadID int64
+	err := sc.c.Do(func(data *memory.Data) error {
+		threadID = data.Threads.NextID()
+
+		// update time defaults to the create time, game
+		// names and icons are derived when read
+		reqThread.ID = threadID
+		reqThread.GameNames = ""
+		reqThread.GameIcons = ""
+		reqThread.Date = memory.Time(reqThread.Date)
+		reqThread.CreateTime = memory.Time(reqThread.CreateTime)
+		reqThread.UpdateTime = reqThread.CreateTime
+		data.Threads.Put(threadID, reqThread)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return threadID, nil
+}
@@ -0,0 +1,110 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/x-sports/internal/game"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/news"
+	"github.com/x-sports/internal/team"
+	"github.com/x-sports/internal/thread"
+)
+
+func (sc *storeClient) HasGames(ctx context.Context) (bool, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// query single row
+	var exists bool
+	err := sc.q.QueryRowxContext(ctx, queryHasGames).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (sc *storeClient) CreateGame(ctx context.Context, reqGame game.Game) (int64, error) {
+	return sc.insert(ctx, queryCreateGame, map[string]interface{}{
+		"game_names":  reqGame.GameNames,
+		"game_icons":  reqGame.GameIcons,
+		"create_time": reqGame.CreateTime,
+	})
+}
+
+func (sc *storeClient) CreateTeam(ctx context.Context, reqTeam team.Team) (int64, error) {
+	return sc.insert(ctx, queryCreateTeam, map[string]interface{}{
+		"team_names":  reqTeam.TeamNames,
+		"team_icons":  reqTeam.TeamIcons,
+		"game_id":     reqTeam.GameID,
+		"create_time": reqTeam.CreateTime,
+	})
+}
+
+func (sc *storeClient) CreateMatch(ctx context.Context, reqMatch match.Match) (int64, error) {
+	return sc.insert(ctx, queryCreateMatch, map[string]interface{}{
+		"tournament_names": reqMatch.TournamentNames,
+		"game_id":          reqMatch.GameID,
+		"team_a_id":        reqMatch.TeamAID,
+		"team_b_id":        reqMatch.TeamBID,
+		"team_a_odds":      reqMatch.TeamAOdds,
+		"team_b_odds":      reqMatch.TeamBOdds,
+		"date":             reqMatch.Date,
+		"match_link":       reqMatch.MatchLink,
+		"status":           reqMatch.Status,
+		"winner":           reqMatch.Winner,
+		"create_time":      reqMatch.CreateTime,
+	})
+}
+
+func (sc *storeClient) CreateNews(ctx context.Context, reqNews news.News) (int64, error) {
+	return sc.insert(ctx, queryCreateNews, map[string]interface{}{
+		"title":       reqNews.Title,
+		"game_id":     reqNews.GameID,
+		"description": reqNews.Description,
+		"image_news":  reqNews.ImageNews,
+		"date":        reqNews.Date,
+		"create_time": reqNews.CreateTime,
+	})
+}
+
+func (sc *storeClient) CreateThread(ctx context.Context, reqThread thread.Thread) (int64, error) {
+	return sc.insert(ctx, queryCreateThread, map[string]interface{}{
+		"title":        reqThread.Title,
+		"game_id":      reqThread.GameID,
+		"description":  reqThread.Description,
+		"image_thread": reqThread.ImageThread,
+		"date":         reqThread.Date,
+		"create_time":  reqThread.CreateTime,
+	})
+}
+
+// insert executes the given insert query with the given
+// arguments and returns the ID of the inserted row.
+func (sc *storeClient) insert(ctx context.Context, namedQuery string, argsKV map[string]interface{}) (int64, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// prepare query
+	query, args, err := sqlx.Named(namedQuery, argsKV)
+	if err != nil {
+		return 0, err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return 0, err
+	}
+	query = sc.q.Rebind(query)
+
+	// execute query
+	var id int64
+	err = sc.q.QueryRowxContext(ctx, query, args...).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
@@ -0,0 +1,125 @@
+package postgresql
+
+const queryHasGames = `
+	SELECT EXISTS (
+		SELECT
+			1
+		FROM
+			game
+	)
+`
+
+const queryCreateGame = `
+	INSERT INTO
+		game
+	(
+		game_names,
+		game_icons,
+		create_time,
+		update_time
+	) VALUES (
+		:game_names,
+		:game_icons,
+		:create_time,
+		:create_time
+	)  RETURNING
+		id
+`
+
+const queryCreateTeam = `
+	INSERT INTO
+		team
+	(
+		team_names,
+		team_icons,
+		game_id,
+		create_time,
+		update_time
+	) VALUES (
+		:team_names,
+		:team_icons,
+		:game_id,
+		:create_time,
+		:create_time
+	)  RETURNING
+		id
+`
+
+const queryCreateMatch = `
+	INSERT INTO
+		match
+	(
+		tournament_names,
+		game_id,
+		team_a_id,
+		team_b_id,
+		team_a_odds,
+		team_b_odds,
+		date,
+		match_link,
+		status,
+		winner,
+		create_time,
+		update_time
+	) VALUES (
+		:tournament_names,
+		:game_id,
+		:team_a_id,
+		:team_b_id,
+		:team_a_odds,
+		:team_b_odds,
+		:date,
+		:match_link,
+		:status,
+		NULLIF(:winner, 0),
+		:create_time,
+		:create_time
+	)  RETURNING
+		id
+`
+
+const queryCreateNews = `
+	INSERT INTO
+		news
+	(
+		title,
+		game_id,
+		description,
+		image_news,
+		date,
+		create_time,
+		update_time
+	) VALUES (
+		:title,
+		:game_id,
+		:description,
+		:image_news,
+		:date,
+		:create_time,
+		:create_time
+	)  RETURNING
+		id
+`
+
+const queryCreateThread = `
+	INSERT INTO
+		thread
+	(
+		title,
+		game_id,
+		description,
+		image_thread,
+		date,
+		create_time,
+		update_time
+	) VALUES (
+		:title,
+		:game_id,
+		:description,
+		:image_thread,
+		:date,
+		:create_time,
+		:create_time
+	)  RETURNING
+		id
+`
@@ -0,0 +1,9 @@
+package syndication
+
+import "errors"
+
+var (
+	// ErrInvalidSource is returned when the given source is
+	// invalid.
+	ErrInvalidSource = errors.New("invalid source")
+)
@@ -0,0 +1,110 @@
+package http
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"time"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/syndication"
+)
+
+type atomHandler struct {
+	syndication syndication.Service
+	siteURL     string
+}
+
+func (h *atomHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.handleGetAtom(w, r)
+	default:
+		helper.WriteErrorResponse(w, http.StatusMethodNotAllowed, []string{errMethodNotAllowed.Error()})
+	}
+}
+
+func (h *atomHandler) handleGetAtom(w http.ResponseWriter, r *http.Request) {
+	helper.Serve(w, r, newEndpoint("handleGetAtom"), func(ctx context.Context) (helper.Versioned[helper.RawResponse], error) {
+		source, gameID, err := parseFeedRequest(r)
+		if err != nil {
+			return helper.Versioned[helper.RawResponse]{}, helper.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		feed, err := h.syndication.GetFeed(ctx, source, gameID)
+		if err != nil {
+			return helper.Versioned[helper.RawResponse]{}, err
+		}
+
+		body, err := xml.Marshal(formatAtom(feed, getBaseURL(r, h.siteURL), getSelfURL(r)))
+		if err != nil {
+			return helper.Versioned[helper.RawResponse]{}, err
+		}
+
+		return helper.Versioned[helper.RawResponse]{
+			Data: helper.RawResponse{
+				ContentType: "application/atom+xml; charset=utf-8",
+				Body:        append([]byte(xml.Header), body...),
+			},
+			Version:  feed.UpdateTime,
+			Embedded: getFeedEmbedded(feed),
+		}, nil
+	})
+}
+
+// formatAtom formats the given feed into Atom document, with
+// links based on the given base URL.
+func formatAtom(feed syndication.Feed, baseURL, selfURL string) atomFeedHTTP {
+	// updated is required, even if there is no entry yet
+	updated := feed.UpdateTime
+	if updated.IsZero() {
+		updated = time.Now()
+	}
+
+	result := atomFeedHTTP{
+		ID:      getFeedID(feed),
+		Title:   getFeedTitle(feed),
+		Updated: updated.UTC().Format(time.RFC3339),
+		Author: atomAuthorHTTP{
+			Name: feedAuthor,
+		},
+		Links: []atomLinkHTTP{
+			{Href: selfURL, Rel: "self", Type: "application/atom+xml"},
+			{Href: getFeedLink(baseURL, feed), Rel: "alternate"},
+		},
+		Entries: make([]atomEntryHTTP, 0, len(feed.Items)),
+	}
+
+	for _, item := range feed.Items {
+		updated := item.UpdateTime
+		if updated.IsZero() {
+			updated = item.Date
+		}
+
+		entry := atomEntryHTTP{
+			ID:        getItemID(feed, item),
+			Title:     item.Title,
+			Published: item.Date.UTC().Format(time.RFC3339),
+			Updated:   updated.UTC().Format(time.RFC3339),
+			Summary:   item.Description,
+			Links: []atomLinkHTTP{
+				{Href: getItemLink(baseURL, feed, item), Rel: "alternate"},
+			},
+		}
+		if item.GameNames != "" {
+			entry.Category = &atomCategoryHTTP{
+				Term: item.GameNames,
+			}
+		}
+		if item.Image != "" {
+			entry.Links = append(entry.Links, atomLinkHTTP{
+				Href: item.Image,
+				Rel:  "enclosure",
+				Type: getImageType(item.Image),
+			})
+		}
+		result.Entries = append(result.Entries, entry)
+	}
+
+	return result
+}
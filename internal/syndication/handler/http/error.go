@@ -0,0 +1,66 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/game"
+	"github.com/x-sports/internal/syndication"
+)
+
+// Followings are the known errors from Syndication HTTP
+// handlers.
+var (
+	// errInvalidSource is returned when the given source is
+	// invalid.
+	errInvalidSource = errors.New("INVALID_SOURCE")
+
+	// errInvalidGameID is returned when the given game id is
+	// invalid.
+	errInvalidGameID = errors.New("INVALID_GAME_ID")
+
+	// errGameNotFound is returned when the given game is not
+	// found.
+	errGameNotFound = errors.New("GAME_NOT_FOUND")
+
+	// errMethodNotAllowed is returned when accessing not
+	// allowed HTTP method.
+	errMethodNotAllowed = errors.New("METHOD_NOT_ALLOWED")
+
+	// errQueryTimeout is returned when a database query has
+	// reached its timeout limit.
+	errQueryTimeout = errors.New("QUERY_TIMEOUT")
+)
+
+var (
+	// mapHTTPError maps service error into HTTP error that
+	// categorize as bad request error, unless its status code
+	// is set in mapHTTPStatus.
+	//
+	// Internal server error-related should not be mapped here,
+	// and the handler should just return `errInternal` as the
+	// error instead
+	mapHTTPError = map[error]error{
+		syndication.ErrInvalidSource: errInvalidSource,
+		game.ErrGameNotFound:         errGameNotFound,
+		helper.ErrQueryTimeout:       errQueryTimeout,
+	}
+
+	// mapHTTPStatus maps HTTP error into its status code when
+	// it is not a bad request error.
+	mapHTTPStatus = map[error]int{
+		errQueryTimeout: http.StatusGatewayTimeout,
+		errGameNotFound: http.StatusNotFound,
+	}
+)
+
+// newEndpoint returns helper.Endpoint of the handler with the
+// given name.
+func newEndpoint(name string) helper.Endpoint {
+	return helper.Endpoint{
+		Name:      "[Syndication HTTP][" + name + "]",
+		MapError:  mapHTTPError,
+		MapStatus: mapHTTPStatus,
+	}
+}
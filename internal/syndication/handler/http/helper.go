@@ -0,0 +1,134 @@
+package http
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/x-sports/internal/syndication"
+)
+
+// Followings are the properties shared by the feeds.
+const (
+	// feedAuthor is the author of the feeds.
+	feedAuthor = "x-sports"
+
+	// feedTTL is the suggested minutes for aggregators to
+	// cache the feeds.
+	feedTTL = 15
+
+	// feedIDPrefix is the prefix of feed and item IDs, so
+	// they stay the same regardless of the site URL.
+	feedIDPrefix = "tag:x-sports,2023:"
+)
+
+// getFeedEmbedded returns the items of the given feed along
+// with its name, so that the feed is modified when any item
+// is removed, not only when one is added or modified.
+func getFeedEmbedded(feed syndication.Feed) []interface{} {
+	result := make([]interface{}, 0, len(feed.Items)+2)
+
+	result = append(result, feed.GameNames, len(feed.Items))
+	for _, item := range feed.Items {
+		result = append(result, item.ID)
+	}
+
+	return result
+}
+
+// parseFeedRequest returns the source from the URL path and
+// the game ID from the query of the given request.
+func parseFeedRequest(r *http.Request) (syndication.Source, int64, error) {
+	source := syndication.Source(mux.Vars(r)["source"])
+
+	var gameID int64
+	if gameIDStr := r.URL.Query().Get("game_id"); gameIDStr != "" {
+		intGameID, err := strconv.ParseInt(gameIDStr, 10, 64)
+		if err != nil || intGameID <= 0 {
+			return "", 0, errInvalidGameID
+		}
+		gameID = intGameID
+	}
+
+	return source, gameID, nil
+}
+
+// getBaseURL returns the given site URL, or the URL of the
+// given request if it is empty.
+func getBaseURL(r *http.Request, siteURL string) string {
+	if siteURL != "" {
+		return strings.TrimSuffix(siteURL, "/")
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if v := r.Header.Get("X-Forwarded-Proto"); v != "" {
+		scheme = v
+	}
+
+	return scheme + "://" + r.Host
+}
+
+// getSelfURL returns the absolute URL of the given request.
+func getSelfURL(r *http.Request) string {
+	return getBaseURL(r, "") + r.URL.RequestURI()
+}
+
+// getFeedTitle returns the title of the given feed.
+func getFeedTitle(feed syndication.Feed) string {
+	title := "x-sports " + string(feed.Source)
+	if feed.GameNames != "" {
+		title += ": " + feed.GameNames
+	}
+	return title
+}
+
+// getFeedLink returns the link of the page listing the items
+// of the given feed.
+func getFeedLink(baseURL string, feed syndication.Feed) string {
+	link := baseURL + "/" + string(feed.Source)
+	if feed.GameID != 0 {
+		link += "?game_id=" + strconv.FormatInt(feed.GameID, 10)
+	}
+	return link
+}
+
+// getFeedID returns the stable ID of the given feed.
+func getFeedID(feed syndication.Feed) string {
+	id := feedIDPrefix + string(feed.Source)
+	if feed.GameID != 0 {
+		id += fmt.Sprintf("/games/%d", feed.GameID)
+	}
+	return id
+}
+
+// getItemLink returns the link of the given item of the given
+// feed.
+func getItemLink(baseURL string, feed syndication.Feed, item syndication.Item) string {
+	return fmt.Sprintf("%s/%s/%d", baseURL, feed.Source, item.ID)
+}
+
+// getItemID returns the stable ID of the given item of the
+// given feed.
+func getItemID(feed syndication.Feed, item syndication.Item) string {
+	return fmt.Sprintf("%s%s/%d", feedIDPrefix, feed.Source, item.ID)
+}
+
+// getImageType returns the media type of the given image URL
+// based on its extension.
+func getImageType(imageURL string) string {
+	u, err := url.Parse(imageURL)
+	if err == nil {
+		if v := mime.TypeByExtension(path.Ext(u.Path)); strings.HasPrefix(v, "image/") {
+			return v
+		}
+	}
+	return "image/jpeg"
+}
@@ -0,0 +1,195 @@
+package http
+
+import (
+	"encoding/xml"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/x-sports/internal/syndication"
+)
+
+var (
+	errUnknownConfig = errors.New("unknown config name")
+)
+
+// Handler contains syndication HTTP-handlers.
+type Handler struct {
+	handlers    map[string]*handler
+	syndication syndication.Service
+
+	// siteURL is the base URL of the links in the feeds, the
+	// URL of the request is used if it is empty.
+	siteURL string
+}
+
+// handler is the HTTP handler wrapper.
+type handler struct {
+	h        http.Handler
+	identity HandlerIdentity
+}
+
+// HandlerIdentity denotes the identity of an HTTP hanlder.
+type HandlerIdentity struct {
+	Name string
+	URL  string
+}
+
+// Followings are the known HTTP handler identities
+var (
+	// HandlerRSS denotes HTTP handler to get news or threads
+	// as RSS 2.0 feed
+	HandlerRSS = HandlerIdentity{
+		Name: "rss",
+		URL:  "/feeds/{source:news|threads}/rss",
+	}
+
+	// HandlerAtom denotes HTTP handler to get news or threads
+	// as Atom feed
+	HandlerAtom = HandlerIdentity{
+		Name: "atom",
+		URL:  "/feeds/{source:news|threads}/atom",
+	}
+)
+
+// New creates a new Handler.
+func New(syndication syndication.Service, siteURL string, identities []HandlerIdentity) (*Handler, error) {
+	h := &Handler{
+		handlers:    make(map[string]*handler),
+		syndication: syndication,
+		siteURL:     siteURL,
+	}
+
+	// apply options
+	for _, identity := range identities {
+		if h.handlers == nil {
+			h.handlers = map[string]*handler{}
+		}
+
+		h.handlers[identity.Name] = &handler{
+			identity: identity,
+		}
+
+		handler, err := h.createHTTPHandler(identity.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		h.handlers[identity.Name].h = handler
+	}
+
+	return h, nil
+}
+
+// createHTTPHandler creates a new HTTP handler that
+// implements http.Handler.
+func (h *Handler) createHTTPHandler(configName string) (http.Handler, error) {
+	var httpHandler http.Handler
+	switch configName {
+	case HandlerRSS.Name:
+		httpHandler = &rssHandler{
+			syndication: h.syndication,
+			siteURL:     h.siteURL,
+		}
+	case HandlerAtom.Name:
+		httpHandler = &atomHandler{
+			syndication: h.syndication,
+			siteURL:     h.siteURL,
+		}
+	default:
+		return httpHandler, errUnknownConfig
+	}
+	return httpHandler, nil
+}
+
+// Start starts all HTTP handlers.
+func (h *Handler) Start(multiplexer *mux.Router) error {
+	for _, handler := range h.handlers {
+		multiplexer.Handle(handler.identity.URL, handler.h).Name(handler.identity.Name)
+	}
+	return nil
+}
+
+// rssHTTP denotes RSS 2.0 document in HTTP response body.
+type rssHTTP struct {
+	XMLName xml.Name       `xml:"rss"`
+	Version string         `xml:"version,attr"`
+	AtomNS  string         `xml:"xmlns:atom,attr"`
+	Channel rssChannelHTTP `xml:"channel"`
+}
+
+// rssChannelHTTP denotes channel of RSS 2.0 document.
+type rssChannelHTTP struct {
+	Title         string        `xml:"title"`
+	Link          string        `xml:"link"`
+	Description   string        `xml:"description"`
+	LastBuildDate string        `xml:"lastBuildDate,omitempty"`
+	TTL           int           `xml:"ttl"`
+	SelfLink      atomLinkHTTP  `xml:"atom:link"`
+	Items         []rssItemHTTP `xml:"item"`
+}
+
+// rssItemHTTP denotes item of RSS 2.0 document.
+type rssItemHTTP struct {
+	Title       string            `xml:"title"`
+	Link        string            `xml:"link"`
+	Description string            `xml:"description"`
+	Category    string            `xml:"category,omitempty"`
+	GUID        rssGUIDHTTP       `xml:"guid"`
+	PubDate     string            `xml:"pubDate"`
+	Enclosure   *rssEnclosureHTTP `xml:"enclosure"`
+}
+
+// rssGUIDHTTP denotes guid of RSS 2.0 item.
+type rssGUIDHTTP struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// rssEnclosureHTTP denotes enclosure of RSS 2.0 item, i.e.
+// the image of the item.
+type rssEnclosureHTTP struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+// atomFeedHTTP denotes Atom document in HTTP response body.
+type atomFeedHTTP struct {
+	XMLName xml.Name        `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string          `xml:"id"`
+	Title   string          `xml:"title"`
+	Updated string          `xml:"updated"`
+	Author  atomAuthorHTTP  `xml:"author"`
+	Links   []atomLinkHTTP  `xml:"link"`
+	Entries []atomEntryHTTP `xml:"entry"`
+}
+
+// atomEntryHTTP denotes entry of Atom document.
+type atomEntryHTTP struct {
+	ID        string            `xml:"id"`
+	Title     string            `xml:"title"`
+	Published string            `xml:"published"`
+	Updated   string            `xml:"updated"`
+	Summary   string            `xml:"summary"`
+	Category  *atomCategoryHTTP `xml:"category"`
+	Links     []atomLinkHTTP    `xml:"link"`
+}
+
+// atomAuthorHTTP denotes author of Atom document.
+type atomAuthorHTTP struct {
+	Name string `xml:"name"`
+}
+
+// atomCategoryHTTP denotes category of Atom entry.
+type atomCategoryHTTP struct {
+	Term string `xml:"term,attr"`
+}
+
+// atomLinkHTTP denotes link of Atom document, also used as
+// self link of RSS 2.0 document.
+type atomLinkHTTP struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
@@ -0,0 +1,104 @@
+package http
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"time"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/syndication"
+)
+
+type rssHandler struct {
+	syndication syndication.Service
+	siteURL     string
+}
+
+func (h *rssHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.handleGetRSS(w, r)
+	default:
+		helper.WriteErrorResponse(w, http.StatusMethodNotAllowed, []string{errMethodNotAllowed.Error()})
+	}
+}
+
+func (h *rssHandler) handleGetRSS(w http.ResponseWriter, r *http.Request) {
+	helper.Serve(w, r, newEndpoint("handleGetRSS"), func(ctx context.Context) (helper.Versioned[helper.RawResponse], error) {
+		source, gameID, err := parseFeedRequest(r)
+		if err != nil {
+			return helper.Versioned[helper.RawResponse]{}, helper.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		feed, err := h.syndication.GetFeed(ctx, source, gameID)
+		if err != nil {
+			return helper.Versioned[helper.RawResponse]{}, err
+		}
+
+		body, err := xml.Marshal(formatRSS(feed, getBaseURL(r, h.siteURL), getSelfURL(r)))
+		if err != nil {
+			return helper.Versioned[helper.RawResponse]{}, err
+		}
+
+		return helper.Versioned[helper.RawResponse]{
+			Data: helper.RawResponse{
+				ContentType: "application/rss+xml; charset=utf-8",
+				Body:        append([]byte(xml.Header), body...),
+			},
+			Version:  feed.UpdateTime,
+			Embedded: getFeedEmbedded(feed),
+		}, nil
+	})
+}
+
+// formatRSS formats the given feed into RSS 2.0 document,
+// with links based on the given base URL.
+func formatRSS(feed syndication.Feed, baseURL, selfURL string) rssHTTP {
+	channel := rssChannelHTTP{
+		Title:       getFeedTitle(feed),
+		Link:        getFeedLink(baseURL, feed),
+		Description: "Latest " + getFeedTitle(feed),
+		TTL:         feedTTL,
+		SelfLink: atomLinkHTTP{
+			Href: selfURL,
+			Rel:  "self",
+			Type: "application/rss+xml",
+		},
+		Items: make([]rssItemHTTP, 0, len(feed.Items)),
+	}
+	if !feed.UpdateTime.IsZero() {
+		channel.LastBuildDate = feed.UpdateTime.UTC().Format(time.RFC1123Z)
+	}
+
+	for _, item := range feed.Items {
+		link := getItemLink(baseURL, feed, item)
+		rssItem := rssItemHTTP{
+			Title:       item.Title,
+			Link:        link,
+			Description: item.Description,
+			Category:    item.GameNames,
+			GUID: rssGUIDHTTP{
+				IsPermaLink: false,
+				Value:       getItemID(feed, item),
+			},
+			PubDate: item.Date.UTC().Format(time.RFC1123Z),
+		}
+		if item.Image != "" {
+			// the image size is unknown, zero length is the
+			// convention for it
+			rssItem.Enclosure = &rssEnclosureHTTP{
+				URL:    item.Image,
+				Length: 0,
+				Type:   getImageType(item.Image),
+			}
+		}
+		channel.Items = append(channel.Items, rssItem)
+	}
+
+	return rssHTTP{
+		Version: "2.0",
+		AtomNS:  "http://www.w3.org/2005/Atom",
+		Channel: channel,
+	}
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/x-sports/global/tracing"
+	"github.com/x-sports/internal/news"
+	"github.com/x-sports/internal/syndication"
+	"github.com/x-sports/internal/thread"
+)
+
+func (s *service) GetFeed(ctx context.Context, source syndication.Source, gameID int64) (syndication.Feed, error) {
+	ctx, span := tracing.Start(ctx, "syndication.Service.GetFeed")
+	defer span.End()
+
+	result := syndication.Feed{
+		Source: source,
+		GameID: gameID,
+	}
+
+	// the game must exist, also to name the feed
+	if gameID != 0 {
+		g, err := s.game.GetGameByID(ctx, gameID)
+		if err != nil {
+			return syndication.Feed{}, err
+		}
+		result.GameNames = g.GameNames
+	}
+
+	var items []syndication.Item
+	var err error
+	switch source {
+	case syndication.SourceNews:
+		items, err = s.getNewsItems(ctx, gameID)
+	case syndication.SourceThreads:
+		items, err = s.getThreadItems(ctx, gameID)
+	default:
+		return syndication.Feed{}, syndication.ErrInvalidSource
+	}
+	if err != nil {
+		return syndication.Feed{}, err
+	}
+
+	// newest first, the ID keeps the order stable
+	sort.Slice(items, func(i, j int) bool {
+		if !items[i].Date.Equal(items[j].Date) {
+			return items[i].Date.After(items[j].Date)
+		}
+		return items[i].ID > items[j].ID
+	})
+	if len(items) > maxFeedItems {
+		items = items[:maxFeedItems]
+	}
+	result.Items = items
+
+	for _, item := range items {
+		if item.UpdateTime.After(result.UpdateTime) {
+			result.UpdateTime = item.UpdateTime
+		}
+	}
+
+	return result, nil
+}
+
+// getNewsItems returns the latest news of the given game as
+// feed items.
+func (s *service) getNewsItems(ctx context.Context, gameID int64) ([]syndication.Item, error) {
+	newss, err := s.news.GetAllNews(ctx, news.Filter{
+		GameID: gameID,
+		Limit:  maxFeedItems,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]syndication.Item, 0, len(newss))
+	for _, n := range newss {
+		items = append(items, syndication.Item{
+			ID:          n.ID,
+			Title:       n.Title,
+			Description: n.Description,
+			Image:       n.ImageNews,
+			GameID:      n.GameID,
+			GameNames:   n.GameNames,
+			Date:        n.Date,
+			UpdateTime:  n.UpdateTime,
+		})
+	}
+
+	return items, nil
+}
+
+// getThreadItems returns the latest threads of the given
+// game as feed items.
+func (s *service) getThreadItems(ctx context.Context, gameID int64) ([]syndication.Item, error) {
+	threads, err := s.thread.GetAllThreads(ctx, thread.Filter{
+		GameID: gameID,
+		Limit:  maxFeedItems,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]syndication.Item, 0, len(threads))
+	for _, t := range threads {
+		items = append(items, syndication.Item{
+			ID:          t.ID,
+			Title:       t.Title,
+			Description: t.Description,
+			Image:       t.ImageThread,
+			GameID:      t.GameID,
+			GameNames:   t.GameNames,
+			Date:        t.Date,
+			UpdateTime:  t.UpdateTime,
+		})
+	}
+
+	return items, nil
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"github.com/x-sports/internal/game"
+	"github.com/x-sports/internal/news"
+	"github.com/x-sports/internal/thread"
+)
+
+// maxFeedItems is the maximum number of items in a feed.
+const maxFeedItems = 50
+
+// New construts a new service.
+type service struct {
+	game   game.Service
+	news   news.Service
+	thread thread.Service
+}
+
+// New returns a new service
+func New(game game.Service, news news.Service, thread thread.Service) (*service, error) {
+	return &service{
+		game:   game,
+		news:   news,
+		thread: thread,
+	}, nil
+}
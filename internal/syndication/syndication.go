@@ -0,0 +1,47 @@
+package syndication
+
+import (
+	"context"
+	"time"
+)
+
+type Service interface {
+	// GetFeed returns the latest items of the given source,
+	// newest first, filtered by the given game ID unless it
+	// is zero.
+	GetFeed(ctx context.Context, source Source, gameID int64) (Feed, error)
+}
+
+// Source denotes the source of feed items.
+type Source string
+
+// Followings are the known sources.
+const (
+	SourceNews    Source = "news"
+	SourceThreads Source = "threads"
+)
+
+// Feed denotes a syndication feed of a source.
+type Feed struct {
+	Source    Source
+	GameID    int64
+	GameNames string // derived
+
+	Items []Item
+
+	// UpdateTime is the latest update time of the items, zero
+	// if there is no item.
+	UpdateTime time.Time
+}
+
+// Item denotes an entry of a feed, i.e. a news or a thread.
+type Item struct {
+	ID          int64
+	Title       string
+	Description string
+	Image       string
+	GameID      int64
+	GameNames   string
+	Date        time.Time
+	UpdateTime  time.Time
+}
@@ -18,4 +18,20 @@ var (
 	// ErrInvalidGameID is returned when the given game id is
 	// invalid.
 	ErrInvalidGameID = errors.New("invalid game id")
+
+	// ErrTeamNotFound is returned when the
+	// team with the given ID does not exist.
+	ErrTeamNotFound = errors.New("team not found")
+
+	// ErrVersionConflict is returned when the team has
+	// been updated since the version the update is based on.
+	ErrVersionConflict = errors.New("team version conflict")
+
+	// ErrInvalidPage is returned when the given page is
+	// invalid.
+	ErrInvalidPage = errors.New("invalid page")
+
+	// ErrInvalidLimit is returned when the given limit is
+	// invalid.
+	ErrInvalidLimit = errors.New("invalid limit")
 )
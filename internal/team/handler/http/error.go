@@ -2,24 +2,14 @@ package http
 
 import (
 	"errors"
+	"net/http"
 
+	"github.com/x-sports/global/helper"
 	"github.com/x-sports/internal/team"
 )
 
 // Followings are the known errors from Game HTTP handlers.
 var (
-	// errBadRequest is returned when the given request is
-	// bad/invalid.
-	errBadRequest = errors.New("BAD_REQUEST")
-
-	// errInternalServer is returned when there is an
-	// unexpected error encountered when processing a request.
-	errInternalServer = errors.New("INTERNAL_SERVER_ERROR")
-
-	// errInvalidToken is returned when the given token is
-	// invalid.
-	errInvalidToken = errors.New("INVALID_TOKEN")
-
 	// errInvalidTeamNames is returned when the given team names is
 	// invalid.
 	errInvalidTeamNames = errors.New("INVALID_TEAM_NAMES")
@@ -32,22 +22,35 @@ var (
 	// invalid.
 	errInvalidTeamID = errors.New("INVALID_TEAM_ID")
 
+	// errInvalidPage is returned when the given page is
+	// invalid.
+	errInvalidPage = errors.New("INVALID_PAGE")
+
+	// errInvalidLimit is returned when the given limit is
+	// invalid.
+	errInvalidLimit = errors.New("INVALID_LIMIT")
+
 	// errMethodNotAllowed is returned when accessing not
 	// allowed HTTP method.
 	errMethodNotAllowed = errors.New("METHOD_NOT_ALLOWED")
 
-	// errRequestTimeout is returned when processing time
-	// has reached the timeout limit.
-	errRequestTimeout = errors.New("REQUEST_TIMEOUT")
+	// errTeamNotFound is returned when the
+	// team with the given ID does not exist.
+	errTeamNotFound = errors.New("TEAM_NOT_FOUND")
+
+	// errVersionConflict is returned when the team has
+	// been updated since the version in If-Match header.
+	errVersionConflict = errors.New("VERSION_CONFLICT")
 
-	// errUnauthorizedAccess is returned when the request
-	// is unaothorized.
-	errUnauthorizedAccess = errors.New("UNAUTHORIZED_ACCESS")
+	// errQueryTimeout is returned when a database query has
+	// reached its timeout limit.
+	errQueryTimeout = errors.New("QUERY_TIMEOUT")
 )
 
 var (
 	// mapHTTPError maps service error into HTTP error that
-	// categorize as bad request error.
+	// categorize as bad request error, unless its status code
+	// is set in mapHTTPStatus.
 	//
 	// Internal server error-related should not be mapped
 	// here, and the handler should just return `errInternal`
@@ -56,5 +59,28 @@ var (
 		team.ErrInvalidTeamNames: errInvalidTeamNames,
 		team.ErrInvalidTeamID:    errInvalidTeamID,
 		team.ErrInvalidGameID:    errInvalidGameID,
+		team.ErrInvalidPage:      errInvalidPage,
+		team.ErrInvalidLimit:     errInvalidLimit,
+		team.ErrTeamNotFound:     errTeamNotFound,
+		team.ErrVersionConflict:  errVersionConflict,
+		helper.ErrQueryTimeout:   errQueryTimeout,
+	}
+
+	// mapHTTPStatus maps HTTP error into its status code when
+	// it is not a bad request error.
+	mapHTTPStatus = map[error]int{
+		errQueryTimeout:    http.StatusGatewayTimeout,
+		errTeamNotFound:    http.StatusNotFound,
+		errVersionConflict: http.StatusConflict,
 	}
 )
+
+// newEndpoint returns helper.Endpoint of the handler with the
+// given name.
+func newEndpoint(name string) helper.Endpoint {
+	return helper.Endpoint{
+		Name:      "[Team HTTP][" + name + "]",
+		MapError:  mapHTTPError,
+		MapStatus: mapHTTPStatus,
+	}
+}
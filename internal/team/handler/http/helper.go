@@ -1,9 +1,46 @@
 package http
 
 import (
+	"github.com/x-sports/internal/match"
 	"github.com/x-sports/internal/team"
 )
 
+// recentMatchLimit is the maximum number of recent matches
+// embedded in a team detail.
+const recentMatchLimit = 5
+
+// defaultTeamLimit is the number of teams in a page when the
+// limit is not given.
+const defaultTeamLimit = 20
+
+// Followings are the possible match results of a team.
+const (
+	resultWin  = "win"
+	resultLoss = "loss"
+)
+
+// teamDetail denotes a team along with its record and
+// recent completed matches.
+type teamDetail struct {
+	team    team.Team
+	record  match.Record
+	matches []match.Match
+}
+
+// embedded returns the record and the update times of the
+// matches embedded in the team detail, so that the detail is
+// modified when any of them is modified, removed, or added.
+func (td teamDetail) embedded() []interface{} {
+	result := make([]interface{}, 0, 2*len(td.matches)+3)
+
+	result = append(result, td.record.Played, td.record.Wins, len(td.matches))
+	for _, m := range td.matches {
+		result = append(result, m.ID, m.UpdateTime)
+	}
+
+	return result
+}
+
 // formatTeam formats the given team into the
 // respective HTTP-format object.
 func formatTeam(t team.Team) (teamHTTP, error) {
@@ -16,3 +53,58 @@ func formatTeam(t team.Team) (teamHTTP, error) {
 		GameIcons: &t.GameIcons,
 	}, nil
 }
+
+// formatTeamDetail formats the given team detail into the
+// respective HTTP-format object.
+func formatTeamDetail(td teamDetail) (teamDetailHTTP, error) {
+	t, err := formatTeam(td.team)
+	if err != nil {
+		return teamDetailHTTP{}, err
+	}
+
+	result := teamDetailHTTP{
+		teamHTTP:      t,
+		RecentMatches: make([]matchHTTP, 0, len(td.matches)),
+	}
+
+	result.Record.Played = td.record.Played
+	result.Record.Wins = td.record.Wins
+	result.Record.Losses = td.record.Losses()
+
+	for _, m := range td.matches {
+		result.RecentMatches = append(result.RecentMatches, formatMatch(m, td.team.ID))
+	}
+
+	return result, nil
+}
+
+// formatMatch formats the given match into the respective
+// HTTP-format object from the point of view of the given
+// team ID.
+func formatMatch(m match.Match, teamID int64) matchHTTP {
+	date := m.Date.Format(dateFormat)
+
+	result := resultLoss
+	if m.Winner == teamID {
+		result = resultWin
+	}
+
+	mh := matchHTTP{
+		ID:              &m.ID,
+		TournamentNames: &m.TournamentNames,
+		OpponentID:      &m.TeamBID,
+		OpponentNames:   &m.TeamBNames,
+		OpponentIcons:   &m.TeamBIcons,
+		Date:            &date,
+		MatchLink:       &m.MatchLink,
+		Result:          &result,
+	}
+
+	if m.TeamBID == teamID {
+		mh.OpponentID = &m.TeamAID
+		mh.OpponentNames = &m.TeamANames
+		mh.OpponentIcons = &m.TeamAIcons
+	}
+
+	return mh
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/match"
 	"github.com/x-sports/internal/team"
 )
 
@@ -13,10 +14,13 @@ var (
 	errUnknownConfig = errors.New("unknown config name")
 )
 
+var dateFormat = "2006-01-02 15:04:05 -07:00"
+
 // Handler contains admin HTTP-handlers.
 type Handler struct {
 	handlers map[string]*handler
 	team     team.Service
+	match    match.Service
 	admin    admin.Service
 }
 
@@ -50,10 +54,11 @@ var (
 )
 
 // New creates a new Handler.
-func New(team team.Service, admin admin.Service, identities []HandlerIdentity) (*Handler, error) {
+func New(team team.Service, match match.Service, admin admin.Service, identities []HandlerIdentity) (*Handler, error) {
 	h := &Handler{
 		handlers: make(map[string]*handler),
 		team:     team,
+		match:    match,
 		admin:    admin,
 	}
 
@@ -91,6 +96,7 @@ func (h *Handler) createHTTPHandler(configName string) (http.Handler, error) {
 	case HandlerTeam.Name:
 		httpHandler = &teamHandler{
 			team:  h.team,
+			match: h.match,
 			admin: h.admin,
 		}
 	default:
@@ -102,7 +108,7 @@ func (h *Handler) createHTTPHandler(configName string) (http.Handler, error) {
 // Start starts all HTTP handlers.
 func (h *Handler) Start(multiplexer *mux.Router) error {
 	for _, handler := range h.handlers {
-		multiplexer.Handle(handler.identity.URL, handler.h)
+		multiplexer.Handle(handler.identity.URL, handler.h).Name(handler.identity.Name)
 	}
 	return nil
 }
@@ -117,3 +123,40 @@ type teamHTTP struct {
 	GameNames *string `json:"game_names"`
 	GameIcons *string `json:"game_icons"`
 }
+
+// teamPageHTTP denotes a page of teams in HTTP response body.
+type teamPageHTTP struct {
+	Items []teamHTTP `json:"items"`
+	Page  int        `json:"page"`
+	Limit int        `json:"limit"`
+	Total int        `json:"total"`
+}
+
+// teamDetailHTTP denotes a team along with its related data
+// in HTTP response body.
+type teamDetailHTTP struct {
+	teamHTTP
+	RecentMatches []matchHTTP `json:"recent_matches"`
+	Record        recordHTTP  `json:"record"`
+}
+
+// matchHTTP denotes match object of a team in HTTP response
+// body.
+type matchHTTP struct {
+	ID              *int64  `json:"id"`
+	TournamentNames *string `json:"tournament_names"`
+	OpponentID      *int64  `json:"opponent_id"`
+	OpponentNames   *string `json:"opponent_names"`
+	OpponentIcons   *string `json:"opponent_icons"`
+	Date            *string `json:"date"`
+	MatchLink       *string `json:"match_link"`
+	Result          *string `json:"result"`
+}
+
+// recordHTTP denotes win-loss record of a team in HTTP
+// response body.
+type recordHTTP struct {
+	Played int `json:"played"`
+	Wins   int `json:"wins"`
+	Losses int `json:"losses"`
+}
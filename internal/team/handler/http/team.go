@@ -2,21 +2,20 @@ package http
 
 import (
 	"context"
-	"encoding/json"
-	"io/ioutil"
-	"log"
+	"log/slog"
 	"net/http"
 	"strconv"
-	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/x-sports/global/helper"
 	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/match"
 	"github.com/x-sports/internal/team"
 )
 
 type teamHandler struct {
 	team  team.Service
+	match match.Service
 	admin admin.Service
 }
 
@@ -24,12 +23,14 @@ func (h *teamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	teamID, err := strconv.ParseInt(vars["id"], 10, 64)
 	if err != nil {
-		log.Printf("[Team HTTP][teamHandler] Failed to parse team ID. ID: %s. Err: %s\n", vars["id"], err.Error())
+		slog.InfoContext(r.Context(), "failed to parse team ID", slog.String("handler", "[Team HTTP][teamHandler]"), slog.String("id", vars["id"]), slog.String("error", err.Error()))
 		helper.WriteErrorResponse(w, http.StatusBadRequest, []string{errInvalidTeamID.Error()})
 		return
 	}
 
 	switch r.Method {
+	case http.MethodGet:
+		h.handleGetTeamByID(w, r, teamID)
 	case http.MethodPatch:
 		h.handleUpdateTeam(w, r, teamID)
 	default:
@@ -37,145 +38,99 @@ func (h *teamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (h *teamHandler) handleUpdateTeam(w http.ResponseWriter, r *http.Request, teamID int64) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
+func (h *teamHandler) handleGetTeamByID(w http.ResponseWriter, r *http.Request, teamID int64) {
+	helper.Serve(w, r, newEndpoint("handleGetTeamByID"), func(ctx context.Context) (helper.Versioned[teamDetailHTTP], error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			log.Printf("[Team HTTP][handleUpdateTeam] Failed to update team. teamID: %d, Err: %s\n", teamID, err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
+			return helper.Versioned[teamDetailHTTP]{}, err
 		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
 
-	// prepare channels for main go routine
-	resChan := make(chan struct{}, 1)
-	errChan := make(chan error, 1)
+		// TODO: add authorization flow with roles
 
-	go func() {
-		// read request body
-		body, err := ioutil.ReadAll(r.Body)
+		res, err := h.team.GetTeamByID(ctx, teamID)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
+			return helper.Versioned[teamDetailHTTP]{}, err
 		}
 
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
+		// get the team record from all completed matches
+		record, err := h.match.GetTeamRecord(ctx, teamID)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
+			return helper.Versioned[teamDetailHTTP]{}, err
 		}
 
-		// unmarshall body
-		request := teamHTTP{}
-		err = json.Unmarshal(body, &request)
+		// get recent completed matches of the team
+		matches, err := h.match.GetAllMatchs(ctx, match.Filter{
+			TeamID: teamID,
+			Status: match.StatusCompleted,
+			Order:  match.OrderByDateDesc,
+			Limit:  recentMatchLimit,
+		})
+		if err != nil {
+			return helper.Versioned[teamDetailHTTP]{}, err
+		}
+
+		detail := teamDetail{
+			team:    res,
+			record:  record,
+			matches: matches,
+		}
+
+		data, err := formatTeamDetail(detail)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
+			return helper.Versioned[teamDetailHTTP]{}, err
 		}
 
+		return helper.Versioned[teamDetailHTTP]{Data: data, Version: res.UpdateTime, Embedded: detail.embedded()}, nil
+	})
+}
+
+func (h *teamHandler) handleUpdateTeam(w http.ResponseWriter, r *http.Request, teamID int64) {
+	helper.Serve(w, r, newEndpoint("handleUpdateTeam"), func(ctx context.Context) (helper.Versioned[int64], error) {
 		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleUpdateTeam")
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
+			return helper.Versioned[int64]{}, err
 		}
 
-		// get current curriculum
-		current, err := h.team.GetTeamByID(ctx, teamID)
+		// get the version the update is based on
+		version, err := helper.ParseIfMatch(r)
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Team HTTP][handleUpdateTeam] Internal error from GetTeamByID. teamID: %d. Err: %s\n", teamID, err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return helper.Versioned[int64]{}, err
+		}
+
+		// decode request body
+		request := teamHTTP{}
+		err = helper.DecodeJSON(r, &request)
+		if err != nil {
+			return helper.Versioned[int64]{}, err
 		}
 
 		// format HTTP request into service object
-		reqTeam, err := parseTeamFromUpdateRequest(request, current)
+		update, err := parseTeamFromUpdateRequest(request)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- err
-			return
+			return helper.Versioned[int64]{}, helper.NewHTTPError(http.StatusBadRequest, err)
 		}
+		update.ID = teamID
+		update.Version = version
 
-		err = h.team.UpdateTeam(ctx, reqTeam)
+		res, err := h.team.UpdateTeam(ctx, update)
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Team HTTP][handleUpdateTeam] Internal error from UpdateTeam. Err: %s\n", err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return helper.Versioned[int64]{}, err
 		}
 
-		resChan <- struct{}{}
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case <-resChan:
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: teamID,
-		})
-	}
+		return helper.Versioned[int64]{Data: teamID, Version: res.UpdateTime}, nil
+	})
 }
 
-// parseTeamFromUpdateRequest returns team.Team from the
-// given HTTP request object.
-func parseTeamFromUpdateRequest(th teamHTTP, current team.Team) (team.Team, error) {
-	result := current
-
-	if th.TeamNames != nil {
-		result.TeamNames = *th.TeamNames
-	}
-
-	if th.TeamIcons != nil {
-		result.TeamIcons = *th.TeamIcons
-	}
-
-	if th.GameID != nil {
-		result.GameID = *th.GameID
+// parseTeamFromUpdateRequest returns team.TeamUpdate from
+// the given HTTP request object, only the fields set in the
+// request are updated.
+func parseTeamFromUpdateRequest(th teamHTTP) (team.TeamUpdate, error) {
+	result := team.TeamUpdate{
+		TeamNames: th.TeamNames,
+		TeamIcons: th.TeamIcons,
+		GameID:    th.GameID,
 	}
 
 	return result, nil
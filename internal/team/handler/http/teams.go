@@ -2,11 +2,10 @@ package http
 
 import (
 	"context"
-	"encoding/json"
-	"io/ioutil"
-	"log"
 	"net/http"
-	"time"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/x-sports/global/helper"
 	"github.com/x-sports/internal/admin"
@@ -30,201 +29,110 @@ func (h *teamsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *teamsHandler) handleGetAllTeams(w http.ResponseWriter, r *http.Request) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		source     string          // stores request source
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
+	helper.Serve(w, r, newEndpoint("handleGetAllTeams"), func(ctx context.Context) (teamPageHTTP, error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			log.Printf("[Team HTTP][handleGetAllTeams] Failed to get all teams. Source: %s, Err: %s\n", source, err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
+			return teamPageHTTP{}, err
 		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
 
-	// prepare channels for main go routine
-	resChan := make(chan []team.Team, 1)
-	errChan := make(chan error, 1)
+		// TODO: add authorization flow with roles
 
-	go func() {
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
+		// parse filter from query params
+		filter, err := parseGetTeamsFilter(r.URL.Query())
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
+			return teamPageHTTP{}, helper.NewHTTPError(http.StatusBadRequest, err)
 		}
 
-		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleGetAllTeams")
+		res, err := h.team.GetAllTeams(ctx, filter)
 		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
+			return teamPageHTTP{}, err
 		}
 
-		// TODO: add authorization flow with roles
-
-		res, err := h.team.GetAllTeams(ctx)
+		total, err := h.team.CountTeams(ctx, filter)
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Game HTTP][handleGetAllGames] Internal error from GetAllGames. Err: %s\n", err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return teamPageHTTP{}, err
 		}
 
-		resChan <- res
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case res := <-resChan:
 		// format each teams
 		teams := make([]teamHTTP, 0)
 		for _, r := range res {
-			var t teamHTTP
-			t, err = formatTeam(r)
+			t, err := formatTeam(r)
 			if err != nil {
-				return
+				return teamPageHTTP{}, err
 			}
 			teams = append(teams, t)
 		}
 
-		// construct response data
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: teams,
-		})
-	}
+		return teamPageHTTP{
+			Items: teams,
+			Page:  filter.Page,
+			Limit: filter.Limit,
+			Total: total,
+		}, nil
+	})
 }
 
-func (h *teamsHandler) handleCreateTeam(w http.ResponseWriter, r *http.Request) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
-		if err != nil {
-			log.Printf("[Team HTTP][handleCreateTeam] Failed to create team. Err: %s\n", err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
-		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
-
-	// prepare channels for main go routine
-	resChan := make(chan int64, 1)
-	errChan := make(chan error, 1)
+// parseGetTeamsFilter returns team.Filter from the given
+// query params, the first page of defaultTeamLimit teams if
+// the page and the limit are not set.
+func parseGetTeamsFilter(request url.Values) (team.Filter, error) {
+	filter := team.Filter{
+		Name:  strings.TrimSpace(request.Get("name")),
+		Page:  1,
+		Limit: defaultTeamLimit,
+	}
 
-	go func() {
-		// read request body
-		body, err := ioutil.ReadAll(r.Body)
+	if gameIDStr := request.Get("game_id"); gameIDStr != "" {
+		gameID, err := strconv.ParseInt(gameIDStr, 10, 64)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
+			return team.Filter{}, errInvalidGameID
 		}
+		filter.GameID = gameID
+	}
 
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
+	if pageStr := request.Get("page"); pageStr != "" {
+		page, err := strconv.Atoi(pageStr)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
+			return team.Filter{}, errInvalidPage
 		}
+		filter.Page = page
+	}
 
-		// unmarshall body
-		request := teamHTTP{}
-		err = json.Unmarshal(body, &request)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
+	if limitStr := request.Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit == 0 {
+			return team.Filter{}, errInvalidLimit
 		}
+		filter.Limit = limit
+	}
 
+	return filter, nil
+}
+
+func (h *teamsHandler) handleCreateTeam(w http.ResponseWriter, r *http.Request) {
+	helper.Serve(w, r, newEndpoint("handleCreateTeam"), func(ctx context.Context) (int64, error) {
 		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleCreateTeam")
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
+			return 0, err
 		}
 
-		// format HTTP request into service object
-		reqTeam, err := parseTeamFromCreateRequest(request)
+		// decode request body
+		request := teamHTTP{}
+		err = helper.DecodeJSON(r, &request)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- err
-			return
+			return 0, err
 		}
 
-		res, err := h.team.CreateTeam(ctx, reqTeam)
+		// format HTTP request into service object
+		reqTeam, err := parseTeamFromCreateRequest(request)
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Team HTTP][handleCreateTeam] Internal error from CreateTeam. Err: %s\n", err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return 0, helper.NewHTTPError(http.StatusBadRequest, err)
 		}
 
-		resChan <- res
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case teamID := <-resChan:
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: teamID,
-		})
-	}
+		return h.team.CreateTeam(ctx, reqTeam)
+	})
 }
 
 // parseTeamFromCreateRequest returns team.Team from the
@@ -2,11 +2,18 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/global/tracing"
 	"github.com/x-sports/internal/team"
 )
 
 func (s *service) CreateTeam(ctx context.Context, reqTeam team.Team) (int64, error) {
+	ctx, span := tracing.Start(ctx, "team.Service.CreateTeam")
+	defer span.End()
+
 	// validate field
 	err := validateTeam(reqTeam)
 	if err != nil {
@@ -15,29 +22,90 @@ func (s *service) CreateTeam(ctx context.Context, reqTeam team.Team) (int64, err
 
 	reqTeam.CreateTime = s.timeNow()
 
-	// get pg store client without using transaction
-	pgStoreClient, err := s.pgStore.NewClient(false)
+	// create team in a transaction
+	var teamID int64
+	err = helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		var err error
+		teamID, err = pgStoreClient.CreateTeam(ctx, reqTeam)
+		return err
+	})
 	if err != nil {
 		return 0, err
 	}
 
-	teamID, err := pgStoreClient.CreateTeam(ctx, reqTeam)
+	return teamID, nil
+}
+
+func (s *service) CreateTeams(ctx context.Context, reqTeams []team.Team) ([]int64, error) {
+	ctx, span := tracing.Start(ctx, "team.Service.CreateTeams")
+	defer span.End()
+
+	// validate all teams, so that all of the invalid teams
+	// are reported at once
+	var verr helper.ValidationError
+	for i, reqTeam := range reqTeams {
+		var itemErr *helper.ValidationError
+		if errors.As(validateTeam(reqTeam), &itemErr) {
+			verr.Merge(fmt.Sprintf("[%d].", i), itemErr)
+		}
+	}
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+
+	createTime := s.timeNow()
+
+	// create teams in a transaction
+	teamIDs := make([]int64, 0, len(reqTeams))
+	err := helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		for _, reqTeam := range reqTeams {
+			reqTeam.CreateTime = createTime
+			teamID, err := pgStoreClient.CreateTeam(ctx, reqTeam)
+			if err != nil {
+				return err
+			}
+			teamIDs = append(teamIDs, teamID)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	return teamID, nil
+	return teamIDs, nil
 }
 
-func (s *service) GetAllTeams(ctx context.Context) ([]team.Team, error) {
-	// get pg store client using transaction
+func (s *service) ValidateTeam(ctx context.Context, reqTeam team.Team) error {
+	ctx, span := tracing.Start(ctx, "team.Service.ValidateTeam")
+	defer span.End()
+
+	return validateTeam(reqTeam)
+}
+
+func (s *service) GetAllTeams(ctx context.Context, filter team.Filter) ([]team.Team, error) {
+	ctx, span := tracing.Start(ctx, "team.Service.GetAllTeams")
+	defer span.End()
+
+	// validate filter
+	err := validateFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// apply default page
+	if filter.Page == 0 {
+		filter.Page = 1
+	}
+
+	// get pg store client without using transaction
 	pgStoreClient, err := s.pgStore.NewClient(false)
 	if err != nil {
 		return nil, err
 	}
 
 	// get all teams from postgre
-	teams, err := pgStoreClient.GetAllTeams(ctx)
+	teams, err := pgStoreClient.GetAllTeams(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -45,32 +113,81 @@ func (s *service) GetAllTeams(ctx context.Context) ([]team.Team, error) {
 	return teams, nil
 }
 
-func (s *service) UpdateTeam(ctx context.Context, reqTeam team.Team) error {
-	// validate field
-	err := validateTeam(reqTeam)
+func (s *service) CountTeams(ctx context.Context, filter team.Filter) (int, error) {
+	ctx, span := tracing.Start(ctx, "team.Service.CountTeams")
+	defer span.End()
+
+	// validate filter
+	err := validateFilter(filter)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	// modify fields
-	reqTeam.UpdateTime = s.timeNow()
-
-	// get pg store client using transaction
+	// get pg store client without using transaction
 	pgStoreClient, err := s.pgStore.NewClient(false)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	// updates team in pgstore
-	err = pgStoreClient.UpdateTeam(ctx, reqTeam)
+	// count teams in postgre
+	count, err := pgStoreClient.CountTeams(ctx, filter)
 	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (s *service) UpdateTeam(ctx context.Context, update team.TeamUpdate) (team.Team, error) {
+	ctx, span := tracing.Start(ctx, "team.Service.UpdateTeam")
+	defer span.End()
+
+	// validate team id
+	if update.ID <= 0 {
+		return team.Team{}, team.ErrInvalidTeamID
+	}
+
+	// updates team in pgstore in a transaction
+	var result team.Team
+	err := helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		// get team before the update to check its version
+		current, err := pgStoreClient.GetTeamByID(ctx, update.ID)
+		if err != nil {
+			return err
+		}
+		if !update.Version.IsZero() && !update.Version.Equal(current.UpdateTime) {
+			return team.ErrVersionConflict
+		}
+
+		// validate the team with the update applied
+		err = validateTeam(update.Apply(current))
+		if err != nil {
+			return err
+		}
+
+		// updates team in pgstore, unless it is updated since
+		// it is read
+		update.Version = current.UpdateTime
+		err = pgStoreClient.UpdateTeam(ctx, update, s.timeNow())
+		if err != nil {
+			return err
+		}
+
+		// get updated team to have its update time
+		result, err = pgStoreClient.GetTeamByID(ctx, update.ID)
 		return err
+	})
+	if err != nil {
+		return team.Team{}, err
 	}
 
-	return nil
+	return result, nil
 }
 
 func (s *service) GetTeamByID(ctx context.Context, teamID int64) (team.Team, error) {
+	ctx, span := tracing.Start(ctx, "team.Service.GetTeamByID")
+	defer span.End()
+
 	// get pg store client without using transaction
 	pgStoreClient, err := s.pgStore.NewClient(false)
 	if err != nil {
@@ -87,19 +204,40 @@ func (s *service) GetTeamByID(ctx context.Context, teamID int64) (team.Team, err
 }
 
 // validateTeam validates fields of the given Team
-// whether its comply the predetermined rules.
+// whether its comply the predetermined rules, all violations
+// are collected in a helper.ValidationError.
 func validateTeam(reqTeam team.Team) error {
+	var verr helper.ValidationError
+
 	if reqTeam.TeamNames == "" {
-		return team.ErrInvalidTeamNames
+		verr.Add("team_names", helper.CodeRequired, team.ErrInvalidTeamNames)
 	}
 
 	if reqTeam.TeamIcons == "" {
-		return team.ErrInvalidTeamIcons
+		verr.Add("team_icons", helper.CodeRequired, team.ErrInvalidTeamIcons)
 	}
 
 	if reqTeam.GameID <= 0 {
+		verr.Add("game_id", helper.CodeRequired, team.ErrInvalidGameID)
+	}
+
+	return verr.Err()
+}
+
+// validateFilter validates fields of the given Filter
+// whether its comply the predetermined rules.
+func validateFilter(filter team.Filter) error {
+	if filter.GameID < 0 {
 		return team.ErrInvalidGameID
 	}
 
+	if filter.Page < 0 {
+		return team.ErrInvalidPage
+	}
+
+	if filter.Limit < 0 || filter.Limit > maxTeamLimit {
+		return team.ErrInvalidLimit
+	}
+
 	return nil
 }
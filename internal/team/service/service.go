@@ -2,6 +2,9 @@ package service
 
 import "time"
 
+// maxTeamLimit is the maximum number of teams in a page.
+const maxTeamLimit = 100
+
 // New construts a new service.
 type service struct {
 	pgStore PGStore
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/x-sports/internal/team"
 )
@@ -22,19 +23,24 @@ type PGStoreClient interface {
 	// created team ID.
 	CreateTeam(ctx context.Context, team team.Team) (int64, error)
 
-	// GetAllTeams returns all teams.
-	GetAllTeams(ctx context.Context) ([]team.Team, error)
+	// GetAllTeams returns all teams that match the given
+	// filter, sorted by team names.
+	GetAllTeams(ctx context.Context, filter team.Filter) ([]team.Team, error)
+
+	// CountTeams returns the number of teams that match the
+	// given filter, regardless of its page and limit.
+	CountTeams(ctx context.Context, filter team.Filter) (int, error)
 
 	// GetTeamsByID returns a team with the given
 	// team ID.
 	GetTeamByID(ctx context.Context, teamID int64) (team.Team, error)
 
-	// UpdateTeam updates existing team with the given
-	// team data.
+	// UpdateTeam updates the fields set in the given team
+	// update of an existing team, and sets its update time
+	// to the given time.
 	//
-	// Updateteams do updates on all main attributes
-	// except ID, CreateBy, and CreateTime. So, make sure to
-	// use current values in the given data if do not want
-	// to update some specific attributes.
-	UpdateTeam(ctx context.Context, team team.Team) error
+	// The team is only updated if its update time equals
+	// to the update version, otherwise ErrVersionConflict is
+	// returned, or ErrTeamNotFound if it does not exist.
+	UpdateTeam(ctx context.Context, update team.TeamUpdate, updateTime time.Time) error
 }
@@ -0,0 +1,43 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/x-sports/internal/team"
+)
+
+func (sc *storeClient) CreateTeam(ctx context.Context, team team.Team) (int64, error) {
+	ctx, done := observe(ctx, "CreateTeam")
+	result, err := sc.next.CreateTeam(ctx, team)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetAllTeams(ctx context.Context, filter team.Filter) ([]team.Team, error) {
+	ctx, done := observe(ctx, "GetAllTeams")
+	result, err := sc.next.GetAllTeams(ctx, filter)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) CountTeams(ctx context.Context, filter team.Filter) (int, error) {
+	ctx, done := observe(ctx, "CountTeams")
+	result, err := sc.next.CountTeams(ctx, filter)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetTeamByID(ctx context.Context, teamID int64) (team.Team, error) {
+	ctx, done := observe(ctx, "GetTeamByID")
+	result, err := sc.next.GetTeamByID(ctx, teamID)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) UpdateTeam(ctx context.Context, update team.TeamUpdate, updateTime time.Time) error {
+	ctx, done := observe(ctx, "UpdateTeam")
+	err := sc.next.UpdateTeam(ctx, update, updateTime)
+	done(err)
+	return err
+}
@@ -0,0 +1,152 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/x-sports/internal/memory"
+	"github.com/x-sports/internal/team"
+)
+
+func (sc *storeClient) CreateTeam(ctx context.Context, reqTeam team.Team) (int64, error) {
+	var teamID int64
+	err := sc.c.Do(func(data *memory.Data) error {
+		teamID = data.Teams.NextID()
+
+		// update time defaults to the create time, game
+		// names and icons are derived when read
+		reqTeam.ID = teamID
+		reqTeam.GameNames = ""
+		reqTeam.GameIcons = ""
+		reqTeam.CreateTime = memory.Time(reqTeam.CreateTime)
+		reqTeam.UpdateTime = reqTeam.CreateTime
+		data.Teams.Put(teamID, reqTeam)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return teamID, nil
+}
+
+func (sc *storeClient) GetAllTeams(ctx context.Context, filter team.Filter) ([]team.Team, error) {
+	name := strings.ToLower(filter.Name)
+
+	teams := make([]team.Team, 0)
+	err := sc.c.Do(func(data *memory.Data) error {
+		for _, t := range data.Teams.All() {
+			t, ok := joinGame(data, t)
+			if !ok {
+				continue
+			}
+			if filter.GameID > 0 && t.GameID != filter.GameID {
+				continue
+			}
+			if name != "" && !strings.Contains(strings.ToLower(t.TeamNames), name) {
+				continue
+			}
+
+			teams = append(teams, t)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// sort teams whose names start with the given name
+	// first, then by names
+	sort.SliceStable(teams, func(i, j int) bool {
+		if name != "" {
+			pi := strings.HasPrefix(strings.ToLower(teams[i].TeamNames), name)
+			pj := strings.HasPrefix(strings.ToLower(teams[j].TeamNames), name)
+			if pi != pj {
+				return pi
+			}
+		}
+		return teams[i].TeamNames < teams[j].TeamNames
+	})
+
+	if filter.Limit > 0 {
+		offset := (filter.Page - 1) * filter.Limit
+		if offset > len(teams) {
+			offset = len(teams)
+		}
+		teams = teams[offset:]
+		if len(teams) > filter.Limit {
+			teams = teams[:filter.Limit]
+		}
+	}
+
+	return teams, nil
+}
+
+func (sc *storeClient) CountTeams(ctx context.Context, filter team.Filter) (int, error) {
+	// count the teams of all pages
+	filter.Limit = 0
+	teams, err := sc.GetAllTeams(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(teams), nil
+}
+
+func (sc *storeClient) GetTeamByID(ctx context.Context, teamID int64) (team.Team, error) {
+	var result team.Team
+	err := sc.c.Do(func(data *memory.Data) error {
+		t, ok := data.Teams.Get(teamID)
+		if ok {
+			t, ok = joinGame(data, t)
+		}
+		if !ok {
+			return team.ErrTeamNotFound
+		}
+
+		result = t
+		return nil
+	})
+	if err != nil {
+		return team.Team{}, err
+	}
+
+	return result, nil
+}
+
+func (sc *storeClient) UpdateTeam(ctx context.Context, update team.TeamUpdate, updateTime time.Time) error {
+	return sc.c.Do(func(data *memory.Data) error {
+		// the team is not updated when its update time has
+		// changed
+		t, ok := data.Teams.Get(update.ID)
+		if !ok {
+			return team.ErrTeamNotFound
+		}
+		if !t.UpdateTime.Equal(update.Version) {
+			return team.ErrVersionConflict
+		}
+
+		t = update.Apply(t)
+		t.UpdateTime = memory.Time(updateTime)
+		data.Teams.Put(t.ID, t)
+
+		return nil
+	})
+}
+
+// joinGame returns the given team with the names and icons of
+// its game, and false if the game does not exist.
+func joinGame(data *memory.Data, t team.Team) (team.Team, bool) {
+	g, ok := data.Games.Get(t.GameID)
+	if !ok {
+		return team.Team{}, false
+	}
+
+	t.GameNames = g.GameNames
+	t.GameIcons = g.GameIcons
+	return t, true
+}
@@ -2,13 +2,21 @@ package postgresql
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/x-sports/internal/team"
 )
 
 func (sc *storeClient) CreateTeam(ctx context.Context, reqTeam team.Team) (int64, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	// construct arguments filled with fields for the query
 	argsKV := map[string]interface{}{
 		"team_names":  reqTeam.TeamNames,
@@ -30,7 +38,7 @@ func (sc *storeClient) CreateTeam(ctx context.Context, reqTeam team.Team) (int64
 
 	// execute query
 	var teamID int64
-	err = sc.q.QueryRowx(query, args...).Scan(&teamID)
+	err = sc.q.QueryRowxContext(ctx, query, args...).Scan(&teamID)
 	if err != nil {
 		return 0, err
 	}
@@ -38,11 +46,40 @@ func (sc *storeClient) CreateTeam(ctx context.Context, reqTeam team.Team) (int64
 	return teamID, nil
 }
 
-func (sc *storeClient) GetAllTeams(ctx context.Context) ([]team.Team, error) {
-	query := fmt.Sprintf(queryGetTeams, "")
+func (sc *storeClient) GetAllTeams(ctx context.Context, filter team.Filter) ([]team.Team, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// define variables to custom query
+	argsKV, addConditions := filterConditions(filter)
+	orderBy := "t.team_names, t.id"
+
+	// sort teams whose names start with the given name first
+	if filter.Name != "" {
+		argsKV["name_prefix"] = likeEscaper.Replace(filter.Name) + "%"
+		orderBy = "t.team_names ILIKE :name_prefix DESC, " + orderBy
+	}
+
+	// construct strings to custom query
+	addCondition := strings.Join(addConditions, " AND ")
+
+	// since the query does not contains "WHERE" yet, need
+	// to add it if needed
+	if len(addConditions) > 0 {
+		addCondition = fmt.Sprintf("WHERE %s", addCondition)
+	}
+	addCondition = fmt.Sprintf("%s ORDER BY %s", addCondition, orderBy)
+
+	if filter.Limit > 0 {
+		addCondition = fmt.Sprintf("%s LIMIT :limit OFFSET :offset", addCondition)
+		argsKV["limit"] = filter.Limit
+		argsKV["offset"] = (filter.Page - 1) * filter.Limit
+	}
+	query := fmt.Sprintf(queryGetTeams, addCondition)
 
 	// prepare query
-	query, args, err := sqlx.Named(query, map[string]interface{}{})
+	query, args, err := sqlx.Named(query, argsKV)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +90,7 @@ func (sc *storeClient) GetAllTeams(ctx context.Context) ([]team.Team, error) {
 	query = sc.q.Rebind(query)
 
 	// query to database
-	rows, err := sc.q.Queryx(query, args...)
+	rows, err := sc.q.QueryxContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -78,19 +115,95 @@ func (sc *storeClient) GetAllTeams(ctx context.Context) ([]team.Team, error) {
 	return teams, nil
 }
 
-func (sc *storeClient) UpdateTeam(ctx context.Context, reqTeam team.Team) error {
+func (sc *storeClient) CountTeams(ctx context.Context, filter team.Filter) (int, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// define variables to custom query
+	argsKV, addConditions := filterConditions(filter)
+
+	// construct strings to custom query
+	addCondition := strings.Join(addConditions, " AND ")
+
+	// since the query does not contains "WHERE" yet, need
+	// to add it if needed
+	if len(addConditions) > 0 {
+		addCondition = fmt.Sprintf("WHERE %s", addCondition)
+	}
+	query := fmt.Sprintf(queryCountTeams, addCondition)
+
+	// prepare query
+	query, args, err := sqlx.Named(query, argsKV)
+	if err != nil {
+		return 0, err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return 0, err
+	}
+	query = sc.q.Rebind(query)
+
+	// query single row
+	var count int
+	err = sc.q.QueryRowxContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// filterConditions returns the conditions of the given filter
+// and their arguments.
+func filterConditions(filter team.Filter) (map[string]interface{}, []string) {
+	argsKV := make(map[string]interface{})
+	addConditions := make([]string, 0)
+
+	if filter.GameID > 0 {
+		addConditions = append(addConditions, "t.game_id = :game_id")
+		argsKV["game_id"] = filter.GameID
+	}
+
+	if filter.Name != "" {
+		// escape wildcards so that the name is matched
+		// literally
+		addConditions = append(addConditions, "t.team_names ILIKE :name")
+		argsKV["name"] = "%" + likeEscaper.Replace(filter.Name) + "%"
+	}
+
+	return argsKV, addConditions
+}
+
+func (sc *storeClient) UpdateTeam(ctx context.Context, update team.TeamUpdate, updateTime time.Time) error {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	// construct arguments filled with fields for the query
 	argsKV := map[string]interface{}{
-		"id":          reqTeam.ID,
-		"team_names":  reqTeam.TeamNames,
-		"team_icons":  reqTeam.TeamIcons,
-		"game_id":     reqTeam.GameID,
-		"create_time": reqTeam.CreateTime,
-		"update_time": reqTeam.UpdateTime,
+		"id":          update.ID,
+		"version":     sql.NullTime{Time: update.Version, Valid: !update.Version.IsZero()},
+		"update_time": updateTime,
+	}
+
+	// only set the columns of the fields set in the update
+	sets := []string{"update_time = :update_time"}
+	if update.TeamNames != nil {
+		sets = append(sets, "team_names = :team_names")
+		argsKV["team_names"] = *update.TeamNames
+	}
+	if update.TeamIcons != nil {
+		sets = append(sets, "team_icons = :team_icons")
+		argsKV["team_icons"] = *update.TeamIcons
+	}
+	if update.GameID != nil {
+		sets = append(sets, "game_id = :game_id")
+		argsKV["game_id"] = *update.GameID
 	}
 
 	// prepare query
-	query, args, err := sqlx.Named(queryUpdateTeam, argsKV)
+	query, args, err := sqlx.Named(fmt.Sprintf(queryUpdateTeam, strings.Join(sets, ",\n\t\t")), argsKV)
 	if err != nil {
 		return err
 	}
@@ -101,16 +214,41 @@ func (sc *storeClient) UpdateTeam(ctx context.Context, reqTeam team.Team) error
 	query = sc.q.Rebind(query)
 
 	// execute query
-	_, err = sc.q.Exec(query, args...)
-	return err
+	res, err := sc.q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	// check whether the team is updated, it is not when
+	// it does not exist or its update time has changed
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		_, err = sc.GetTeamByID(ctx, update.ID)
+		if err != nil {
+			return err
+		}
+		return team.ErrVersionConflict
+	}
+
+	return nil
 }
 
 func (sc *storeClient) GetTeamByID(ctx context.Context, teamID int64) (team.Team, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	query := fmt.Sprintf(queryGetTeams, "WHERE t.id = $1")
 
 	// query single row
 	var tdb teamDB
-	err := sc.q.QueryRowx(query, teamID).StructScan(&tdb)
+	err := sc.q.QueryRowxContext(ctx, query, teamID).StructScan(&tdb)
+	if errors.Is(err, sql.ErrNoRows) {
+		return team.Team{}, team.ErrTeamNotFound
+	}
 	if err != nil {
 		return team.Team{}, err
 	}
@@ -7,11 +7,13 @@ const queryCreateTeam = `
 		team_names,
 		team_icons,
 		game_id,
-		create_time
+		create_time,
+		update_time
 	) VALUES (
 		:team_names,
 		:team_icons,
 		:game_id,
+		:create_time,
 		:create_time
 	)  RETURNING
 		id
@@ -36,15 +38,24 @@ const queryGetTeams = `
 	%s
 `
 
+const queryCountTeams = `
+	SELECT
+		COUNT(*)
+	FROM
+		team t
+	INNER JOIN
+		game g
+	ON
+		t.game_id = g.id
+	%s
+`
+
 const queryUpdateTeam = `
 	UPDATE
 		team
 	SET
-		team_names = :team_names,
-		team_icons = :team_icons,
-		game_id = :game_id,
-		create_time = :create_time,
-		update_time = :update_time
+		%s
 	WHERE
-		id = :id
+		id = :id AND
+		update_time IS NOT DISTINCT FROM :version
 `
@@ -10,21 +10,39 @@ type Service interface {
 	// created team ID.
 	CreateTeam(ctx context.Context, team Team) (int64, error)
 
-	// GetAllTeams returns all teams.
-	GetAllTeams(ctx context.Context) ([]Team, error)
+	// CreateTeams creates the given teams in a single
+	// transaction and returns the created team IDs in the
+	// same order.
+	//
+	// The teams are validated together and none of them is
+	// created if any of them is invalid. The violations are
+	// reported in a helper.ValidationError, with the index of
+	// the team prepended to the fields, e.g. "[2].team_icons".
+	CreateTeams(ctx context.Context, teams []Team) ([]int64, error)
+
+	// ValidateTeam validates the given team the same way as
+	// CreateTeam, without creating it.
+	ValidateTeam(ctx context.Context, team Team) error
+
+	// GetAllTeams returns all teams that match the given
+	// filter, sorted by team names.
+	GetAllTeams(ctx context.Context, filter Filter) ([]Team, error)
+
+	// CountTeams returns the number of teams that match the
+	// given filter, regardless of its page and limit.
+	CountTeams(ctx context.Context, filter Filter) (int, error)
 	
 	// GetTeamsByID returns a team with the given
 	// team ID.
 	GetTeamByID(ctx context.Context, teamID int64) (Team, error)
 
-	// UpdateTeam updates existing team with the given
-	// team data.
+	// UpdateTeam updates the fields set in the given team
+	// update of an existing team, and returns the updated
+	// team.
 	//
-	// Updateteams do updates on all main attributes
-	// except ID, CreateBy, and CreateTime. So, make sure to
-	// use current values in the given data if do not want
-	// to update some specific attributes.
-	UpdateTeam(ctx context.Context, team Team) error
+	// ErrVersionConflict is returned if the update has a
+	// version and the team has been updated since then.
+	UpdateTeam(ctx context.Context, update TeamUpdate) (Team, error)
 }
 
 type Team struct {
@@ -37,3 +55,51 @@ type Team struct {
 	CreateTime time.Time
 	UpdateTime time.Time
 }
+
+// TeamUpdate is a partial update of an existing team, only
+// its non-nil fields are updated.
+type TeamUpdate struct {
+	ID        int64
+	TeamNames *string
+	TeamIcons *string
+	GameID    *int64
+
+	// Version is the update time of the team the update is
+	// based on, zero value means no version check.
+	Version time.Time
+}
+
+// Apply returns the given team with the fields set in the
+// update applied.
+func (u TeamUpdate) Apply(t Team) Team {
+	if u.TeamNames != nil {
+		t.TeamNames = *u.TeamNames
+	}
+	if u.TeamIcons != nil {
+		t.TeamIcons = *u.TeamIcons
+	}
+	if u.GameID != nil {
+		t.GameID = *u.GameID
+	}
+
+	return t
+}
+
+// Filter denotes the filter used to get teams.
+type Filter struct {
+	// GameID filters teams of the given game, zero to get
+	// teams of all games.
+	GameID int64
+
+	// Name filters teams whose names contain the given name,
+	// case insensitive. Teams whose names start with the
+	// given name are sorted first.
+	Name string
+
+	// Page is the page number to get, starting from 1.
+	Page int
+
+	// Limit is the maximum number of teams in a page, zero
+	// to get all teams without pagination.
+	Limit int
+}
@@ -26,4 +26,12 @@ var (
 	// ErrInvalidDate is returned whrn the given date
 	// is invalid.
 	ErrInvalidDate = errors.New("invalid date")
+
+	// ErrThreadNotFound is returned when the
+	// thread with the given ID does not exist.
+	ErrThreadNotFound = errors.New("thread not found")
+
+	// ErrVersionConflict is returned when the thread has
+	// been updated since the version the update is based on.
+	ErrVersionConflict = errors.New("thread version conflict")
 )
@@ -0,0 +1,8 @@
+package thread
+
+// Followings are the types of events recorded when a thread
+// changes.
+const (
+	EventTypeCreated = "thread.created"
+	EventTypeUpdated = "thread.updated"
+)
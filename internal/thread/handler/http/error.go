@@ -2,24 +2,14 @@ package http
 
 import (
 	"errors"
+	"net/http"
 
+	"github.com/x-sports/global/helper"
 	"github.com/x-sports/internal/thread"
 )
 
 // Followings are the known errors from Thread HTTP handlers.
 var (
-	// errBadRequest is returned when the given request is
-	// bad/invalid.
-	errBadRequest = errors.New("BAD_REQUEST")
-
-	// errInternalServer is returned when there is an
-	// unexpected error encountered when processing a request.
-	errInternalServer = errors.New("INTERNAL_SERVER_ERROR")
-
-	// errInvalidToken is returned when the given token is
-	// invalid.
-	errInvalidToken = errors.New("INVALID_TOKEN")
-
 	// errInvalidTitle is returned when the given title is
 	// invalid.
 	errInvalidTitle = errors.New("INVALID_TITLE")
@@ -52,28 +42,54 @@ var (
 	// allowed HTTP method.
 	errMethodNotAllowed = errors.New("METHOD_NOT_ALLOWED")
 
-	// errRequestTimeout is returned when processing time
-	// has reached the timeout limit.
-	errRequestTimeout = errors.New("REQUEST_TIMEOUT")
+	// errThreadNotFound is returned when the
+	// thread with the given ID does not exist.
+	errThreadNotFound = errors.New("THREAD_NOT_FOUND")
+
+	// errVersionConflict is returned when the thread has
+	// been updated since the version in If-Match header.
+	errVersionConflict = errors.New("VERSION_CONFLICT")
 
-	// errUnauthorizedAccess is returned when the request
-	// is unaothorized.
-	errUnauthorizedAccess = errors.New("UNAUTHORIZED_ACCESS")
+	// errQueryTimeout is returned when a database query has
+	// reached its timeout limit.
+	errQueryTimeout = errors.New("QUERY_TIMEOUT")
 )
 
 var (
 	// mapHTTPError maps service error into HTTP error that
-	// categorize as bad request error.
+	// categorize as bad request error, unless its status code
+	// is set in mapHTTPStatus.
 	//
 	// Internal server error-related should not be mapped
 	// here, and the handler should just return `errInternal`
 	// as the error instead
 	mapHTTPError = map[error]error{
 		thread.ErrInvalidTitle:       errInvalidTitle,
-		thread.ErrInvalidThreadID:      errInvalidThreadID,
+		thread.ErrInvalidThreadID:    errInvalidThreadID,
 		thread.ErrInvalidGameID:      errInvalidGameID,
 		thread.ErrInvalidDescription: errInvalidDescription,
 		thread.ErrInvalidDate:        errInvalidDate,
-		thread.ErrInvalidImageThread:   errInvalidImageThread,
+		thread.ErrInvalidImageThread: errInvalidImageThread,
+		thread.ErrThreadNotFound:     errThreadNotFound,
+		thread.ErrVersionConflict:    errVersionConflict,
+		helper.ErrQueryTimeout:       errQueryTimeout,
+	}
+
+	// mapHTTPStatus maps HTTP error into its status code when
+	// it is not a bad request error.
+	mapHTTPStatus = map[error]int{
+		errQueryTimeout:    http.StatusGatewayTimeout,
+		errThreadNotFound:  http.StatusNotFound,
+		errVersionConflict: http.StatusConflict,
 	}
 )
+
+// newEndpoint returns helper.Endpoint of the handler with the
+// given name.
+func newEndpoint(name string) helper.Endpoint {
+	return helper.Endpoint{
+		Name:      "[Thread HTTP][" + name + "]",
+		MapError:  mapHTTPError,
+		MapStatus: mapHTTPStatus,
+	}
+}
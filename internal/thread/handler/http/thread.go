@@ -2,9 +2,7 @@ package http
 
 import (
 	"context"
-	"encoding/json"
-	"io/ioutil"
-	"log"
+	"log/slog"
 	"net/http"
 	"strconv"
 	"time"
@@ -24,7 +22,7 @@ func (h *threadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	threadID, err := strconv.ParseInt(vars["id"], 10, 64)
 	if err != nil {
-		log.Printf("[Thread HTTP][threadHandler] Failed to parse thread ID. ID: %s. Err: %s\n", vars["id"], err.Error())
+		slog.InfoContext(r.Context(), "failed to parse thread ID", slog.String("handler", "[Thread HTTP][threadHandler]"), slog.String("id", vars["id"]), slog.String("error", err.Error()))
 		helper.WriteErrorResponse(w, http.StatusBadRequest, []string{errInvalidThreadID.Error()})
 		return
 	}
@@ -40,244 +38,84 @@ func (h *threadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *threadHandler) handleGetThreadByID(w http.ResponseWriter, r *http.Request, threadID int64) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
-		if err != nil {
-			log.Printf("[Thread HTTP][handleGetThreadByID] Failed to get thread by ID. threadID: %d, Err: %s\n", threadID, err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
-		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
-
-	// prepare channels for main go routine
-	resChan := make(chan thread.Thread, 1)
-	errChan := make(chan error, 1)
-
-	go func() {
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
-		}
-
+	helper.Serve(w, r, newEndpoint("handleGetThreadByID"), func(ctx context.Context) (helper.Versioned[threadHTTP], error) {
 		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleGetThreadByID")
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
+			return helper.Versioned[threadHTTP]{}, err
 		}
 
 		// TODO: add authorization flow with roles
 
 		res, err := h.thread.GetThreadByID(ctx, threadID)
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Thread HTTP][handleGetThreadByID] Internal error from GetThreadByID. threadID: %d. Err: %s\n", threadID, err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return helper.Versioned[threadHTTP]{}, err
 		}
 
-		resChan <- res
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case res := <-resChan:
-		// format curriculum
-		var t threadHTTP
-		t, err = formatThread(res)
+		data, err := formatThread(res)
 		if err != nil {
-			return
+			return helper.Versioned[threadHTTP]{}, err
 		}
-		// construct response data
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: t,
-		})
-	}
+
+		return helper.Versioned[threadHTTP]{Data: data, Version: res.UpdateTime}, nil
+	})
 }
 
 func (h *threadHandler) handleUpdateThread(w http.ResponseWriter, r *http.Request, threadID int64) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
-		if err != nil {
-			log.Printf("[Thread HTTP][handleUpdateThread] Failed to update thread. threadID: %d, Err: %s\n", threadID, err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
-		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
-
-	// prepare channels for main go routine
-	resChan := make(chan struct{}, 1)
-	errChan := make(chan error, 1)
-
-	go func() {
-		// read request body
-		body, err := ioutil.ReadAll(r.Body)
+	helper.Serve(w, r, newEndpoint("handleUpdateThread"), func(ctx context.Context) (helper.Versioned[int64], error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
+			return helper.Versioned[int64]{}, err
 		}
 
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
+		// get the version the update is based on
+		version, err := helper.ParseIfMatch(r)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
+			return helper.Versioned[int64]{}, err
 		}
 
-		// unmarshall body
+		// decode request body
 		request := threadHTTP{}
-		err = json.Unmarshal(body, &request)
+		err = helper.DecodeJSON(r, &request)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
-		}
-
-		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleUpdateThread")
-		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
-		}
-
-		// get current thread
-		current, err := h.thread.GetThreadByID(ctx, threadID)
-		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Thread HTTP][handleUpdateThread] Internal error from GetThreadByID. threadID: %d. Err: %s\n", threadID, err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return helper.Versioned[int64]{}, err
 		}
 
 		// format HTTP request into service object
-		reqThread, err := parseThreadFromUpdateRequest(request, current)
+		update, err := parseThreadFromUpdateRequest(request)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- err
-			return
+			return helper.Versioned[int64]{}, helper.NewHTTPError(http.StatusBadRequest, err)
 		}
+		update.ID = threadID
+		update.Version = version
 
-		err = h.thread.UpdateThread(ctx, reqThread)
+		res, err := h.thread.UpdateThread(ctx, update)
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Thread HTTP][handleUpdateThread] Internal error from UpdateThread. Err: %s\n", err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return helper.Versioned[int64]{}, err
 		}
 
-		resChan <- struct{}{}
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case <-resChan:
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: threadID,
-		})
-	}
+		return helper.Versioned[int64]{Data: threadID, Version: res.UpdateTime}, nil
+	})
 }
 
-// parseThreadFromUpdateRequest returns Thread.Thread from the
-// given HTTP request object.
-func parseThreadFromUpdateRequest(nh threadHTTP, current thread.Thread) (thread.Thread, error) {
-	result := current
-
-	if nh.Title != nil {
-		result.Title = *nh.Title
-	}
-
-	if nh.GameID != nil {
-		result.GameID = *nh.GameID
-	}
-
-	if nh.Description != nil {
-		result.Description = *nh.Description
-	}
-
-	if nh.ImageThread != nil {
-		result.ImageThread = *nh.ImageThread
+// parseThreadFromUpdateRequest returns thread.ThreadUpdate from
+// the given HTTP request object, only the fields set in the
+// request are updated.
+func parseThreadFromUpdateRequest(nh threadHTTP) (thread.ThreadUpdate, error) {
+	result := thread.ThreadUpdate{
+		Title:       nh.Title,
+		GameID:      nh.GameID,
+		Description: nh.Description,
+		ImageThread: nh.ImageThread,
 	}
 
 	if nh.Date != nil && *nh.Date != "" {
 		date, err := time.Parse(dateFormat, *nh.Date)
 		if err != nil {
-			return thread.Thread{}, errInvalidTimeFormat
+			return thread.ThreadUpdate{}, errInvalidTimeFormat
 		}
-		result.Date = date
+		result.Date = &date
 	}
 
 	return result, nil
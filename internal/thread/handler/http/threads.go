@@ -2,9 +2,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
-	"io/ioutil"
-	"log"
 	"net/http"
 	"time"
 
@@ -30,198 +27,55 @@ func (h *threadsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *threadsHandler) handleGetAllThreads(w http.ResponseWriter, r *http.Request) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
-		if err != nil {
-			log.Printf("[Thread HTTP][handleGetAllThreads] Failed to get all thread. Err: %s\n", err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
-		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
-
-	// prepare channels for main go routine
-	resChan := make(chan []thread.Thread, 1)
-	errChan := make(chan error, 1)
-
-	go func() {
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
-		}
-
+	helper.Serve(w, r, newEndpoint("handleGetAllThreads"), func(ctx context.Context) ([]threadHTTP, error) {
 		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleGetAllThreads")
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
+			return nil, err
 		}
 
-		res, err := h.thread.GetAllThreads(ctx)
+		res, err := h.thread.GetAllThreads(ctx, thread.Filter{})
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Thread HTTP][handleGetAllGames] Internal error from GetAllThreads. Err: %s\n", err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return nil, err
 		}
 
-		resChan <- res
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case res := <-resChan:
-		// format each thread
-		thread := make([]threadHTTP, 0)
+		// format each threads
+		threads := make([]threadHTTP, 0)
 		for _, r := range res {
-			var t threadHTTP
-			t, err = formatThread(r)
+			v, err := formatThread(r)
 			if err != nil {
-				return
+				return nil, err
 			}
-			thread = append(thread, t)
+			threads = append(threads, v)
 		}
 
-		// construct response data
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: thread,
-		})
-	}
+		return threads, nil
+	})
 }
 
 func (h *threadsHandler) handleCreateThread(w http.ResponseWriter, r *http.Request) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 3000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
-
-	// write response
-	defer func() {
-		// error
-		if err != nil {
-			log.Printf("[Thread HTTP][handleCreateThread] Failed to create thread. Err: %s\n", err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
-		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
-
-	// prepare channels for main go routine
-	resChan := make(chan int64, 1)
-	errChan := make(chan error, 1)
-
-	go func() {
-		// read request body
-		body, err := ioutil.ReadAll(r.Body)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
-		}
-
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
+	helper.Serve(w, r, newEndpoint("handleCreateThread"), func(ctx context.Context) (int64, error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
+			return 0, err
 		}
 
-		// unmarshall body
+		// decode request body
 		request := threadHTTP{}
-		err = json.Unmarshal(body, &request)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
-		}
-
-		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleCreateThread")
+		err = helper.DecodeJSON(r, &request)
 		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
+			return 0, err
 		}
 
 		// format HTTP request into service object
 		reqThread, err := parseThreadFromCreateRequest(request)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- err
-			return
+			return 0, helper.NewHTTPError(http.StatusBadRequest, err)
 		}
 
-		res, err := h.thread.CreateThread(ctx, reqThread)
-		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServer
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Thread HTTP][handleCreateThread] Internal error from CreateThread. Err: %s\n", err.Error())
-			}
-
-			errChan <- parsedErr
-			return
-		}
-
-		resChan <- res
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case threadID := <-resChan:
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: threadID,
-		})
-	}
+		return h.thread.CreateThread(ctx, reqThread)
+	})
 }
 
 // parseThreadFromCreateRequest returns thread.Thread from the
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+
+	"github.com/x-sports/internal/outbox"
+)
+
+// recordEvent records an event of the given type with the
+// given data in the outbox using the given store client, so
+// that the event is committed along with the thread change.
+func (s *service) recordEvent(ctx context.Context, pgStoreClient PGStoreClient, eventType string, data interface{}) error {
+	event, err := outbox.NewEvent(eventType, data, s.timeNow())
+	if err != nil {
+		return err
+	}
+
+	_, err = pgStoreClient.CreateEvent(ctx, event)
+	return err
+}
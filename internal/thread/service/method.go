@@ -3,10 +3,15 @@ package service
 import (
 	"context"
 
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/global/tracing"
 	"github.com/x-sports/internal/thread"
 )
 
 func (s *service) CreateThread(ctx context.Context, reqThread thread.Thread) (int64, error) {
+	ctx, span := tracing.Start(ctx, "thread.Service.CreateThread")
+	defer span.End()
+
 	// validate field
 	err := validateThread(reqThread)
 	if err != nil {
@@ -15,13 +20,24 @@ func (s *service) CreateThread(ctx context.Context, reqThread thread.Thread) (in
 
 	reqThread.CreateTime = s.timeNow()
 
-	// get pg store client without using transaction
-	pgStoreClient, err := s.pgStore.NewClient(false)
-	if err != nil {
-		return 0, err
-	}
-
-	threadID, err := pgStoreClient.CreateThread(ctx, reqThread)
+	// create thread and record the event in a transaction
+	var threadID int64
+	err = helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		var err error
+		threadID, err = pgStoreClient.CreateThread(ctx, reqThread)
+		if err != nil {
+			return err
+		}
+
+		// get created thread to have the derived fields in
+		// the event
+		created, err := pgStoreClient.GetThreadByID(ctx, threadID)
+		if err != nil {
+			return err
+		}
+
+		return s.recordEvent(ctx, pgStoreClient, thread.EventTypeCreated, created)
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -29,7 +45,10 @@ func (s *service) CreateThread(ctx context.Context, reqThread thread.Thread) (in
 	return threadID, nil
 }
 
-func (s *service) GetAllThreads(ctx context.Context) ([]thread.Thread, error) {
+func (s *service) GetAllThreads(ctx context.Context, filter thread.Filter) ([]thread.Thread, error) {
+	ctx, span := tracing.Start(ctx, "thread.Service.GetAllThreads")
+	defer span.End()
+
 	// get pg store client without using transaction
 	pgStoreClient, err := s.pgStore.NewClient(false)
 	if err != nil {
@@ -37,7 +56,7 @@ func (s *service) GetAllThreads(ctx context.Context) ([]thread.Thread, error) {
 	}
 
 	// get all thread from postgre
-	thread, err := pgStoreClient.GetAllThreads(ctx)
+	thread, err := pgStoreClient.GetAllThreads(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -45,32 +64,61 @@ func (s *service) GetAllThreads(ctx context.Context) ([]thread.Thread, error) {
 	return thread, nil
 }
 
-func (s *service) UpdateThread(ctx context.Context, reqThread thread.Thread) error {
-	// validate field
-	err := validateThread(reqThread)
-	if err != nil {
-		return err
-	}
-
-	// modify fields
-	reqThread.UpdateTime = s.timeNow()
+func (s *service) UpdateThread(ctx context.Context, update thread.ThreadUpdate) (thread.Thread, error) {
+	ctx, span := tracing.Start(ctx, "thread.Service.UpdateThread")
+	defer span.End()
 
-	// get pg store client using transaction
-	pgStoreClient, err := s.pgStore.NewClient(false)
-	if err != nil {
-		return err
+	// validate thread id
+	if update.ID <= 0 {
+		return thread.Thread{}, thread.ErrInvalidThreadID
 	}
 
-	// updates thread in pgstore
-	err = pgStoreClient.UpdateThread(ctx, reqThread)
+	// update thread and record the event in a transaction
+	var result thread.Thread
+	err := helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		// get thread before the update to check its version
+		current, err := pgStoreClient.GetThreadByID(ctx, update.ID)
+		if err != nil {
+			return err
+		}
+		if !update.Version.IsZero() && !update.Version.Equal(current.UpdateTime) {
+			return thread.ErrVersionConflict
+		}
+
+		// validate the thread with the update applied
+		err = validateThread(update.Apply(current))
+		if err != nil {
+			return err
+		}
+
+		// updates thread in pgstore, unless it is updated since
+		// it is read
+		update.Version = current.UpdateTime
+		err = pgStoreClient.UpdateThread(ctx, update, s.timeNow())
+		if err != nil {
+			return err
+		}
+
+		// get updated thread to have the derived fields in
+		// the event
+		result, err = pgStoreClient.GetThreadByID(ctx, update.ID)
+		if err != nil {
+			return err
+		}
+
+		return s.recordEvent(ctx, pgStoreClient, thread.EventTypeUpdated, result)
+	})
 	if err != nil {
-		return err
+		return thread.Thread{}, err
 	}
 
-	return nil
+	return result, nil
 }
 
 func (s *service) GetThreadByID(ctx context.Context, threadID int64) (thread.Thread, error) {
+	ctx, span := tracing.Start(ctx, "thread.Service.GetThreadByID")
+	defer span.End()
+
 	// get pg store client without using transaction
 	pgStoreClient, err := s.pgStore.NewClient(false)
 	if err != nil {
@@ -87,27 +135,30 @@ func (s *service) GetThreadByID(ctx context.Context, threadID int64) (thread.Thr
 }
 
 // validateThread validates fields of the given Thread
-// whether its comply the predetermined rules.
+// whether its comply the predetermined rules, all violations
+// are collected in a helper.ValidationError.
 func validateThread(reqThread thread.Thread) error {
+	var verr helper.ValidationError
+
 	if reqThread.Title == "" {
-		return thread.ErrInvalidTitle
+		verr.Add("title", helper.CodeRequired, thread.ErrInvalidTitle)
 	}
 
 	if reqThread.GameID <= 0 {
-		return thread.ErrInvalidGameID
+		verr.Add("game_id", helper.CodeRequired, thread.ErrInvalidGameID)
 	}
 
 	if reqThread.Description == "" {
-		return thread.ErrInvalidDescription
+		verr.Add("description", helper.CodeRequired, thread.ErrInvalidDescription)
 	}
 
 	if reqThread.ImageThread == "" {
-		return thread.ErrInvalidImageThread
+		verr.Add("image_thread", helper.CodeRequired, thread.ErrInvalidImageThread)
 	}
 
 	if reqThread.Date.IsZero() {
-		return thread.ErrInvalidDate
+		verr.Add("date", helper.CodeRequired, thread.ErrInvalidDate)
 	}
 
-	return nil
+	return verr.Err()
 }
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"time"
 
+	"github.com/x-sports/internal/outbox"
 	"github.com/x-sports/internal/thread"
 )
 
@@ -22,19 +24,24 @@ type PGStoreClient interface {
 	// created thread ID.
 	CreateThread(ctx context.Context, thread thread.Thread) (int64, error)
 
-	// GetAllThreads returns all thread.
-	GetAllThreads(ctx context.Context) ([]thread.Thread, error)
+	// GetAllThreads returns all threads that match the given
+	// filter, sorted from the latest date.
+	GetAllThreads(ctx context.Context, filter thread.Filter) ([]thread.Thread, error)
 
 	// GetThreadByID returns a thread with the given
 	// thread ID.
 	GetThreadByID(ctx context.Context, threadID int64) (thread.Thread, error)
 
-	// UpdateThread updates existing thread with the given
-	// thread data.
+	// UpdateThread updates the fields set in the given thread
+	// update of an existing thread, and sets its update time
+	// to the given time.
 	//
-	// Updatethread do updates on all main attributes
-	// except ID, CreateBy, and CreateTime. So, make sure to
-	// use current values in the given data if do not want
-	// to update some specific attributes.
-	UpdateThread(ctx context.Context, thread thread.Thread) error
+	// The thread is only updated if its update time equals
+	// to the update version, otherwise ErrVersionConflict is
+	// returned, or ErrThreadNotFound if it does not exist.
+	UpdateThread(ctx context.Context, update thread.ThreadUpdate, updateTime time.Time) error
+
+	// CreateEvent records the given event in the outbox and
+	// return the created event ID.
+	CreateEvent(ctx context.Context, event outbox.Event) (int64, error)
 }
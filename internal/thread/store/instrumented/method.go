@@ -0,0 +1,55 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/x-sports/global/metrics"
+	"github.com/x-sports/internal/outbox"
+	"github.com/x-sports/internal/thread"
+)
+
+func (sc *storeClient) CreateThread(ctx context.Context, thread thread.Thread) (int64, error) {
+	ctx, done := observe(ctx, "CreateThread")
+	result, err := sc.next.CreateThread(ctx, thread)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetAllThreads(ctx context.Context, filter thread.Filter) ([]thread.Thread, error) {
+	ctx, done := observe(ctx, "GetAllThreads")
+	result, err := sc.next.GetAllThreads(ctx, filter)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetThreadByID(ctx context.Context, threadID int64) (thread.Thread, error) {
+	ctx, done := observe(ctx, "GetThreadByID")
+	result, err := sc.next.GetThreadByID(ctx, threadID)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) UpdateThread(ctx context.Context, update thread.ThreadUpdate, updateTime time.Time) error {
+	ctx, done := observe(ctx, "UpdateThread")
+	err := sc.next.UpdateThread(ctx, update, updateTime)
+	done(err)
+	return err
+}
+
+func (sc *storeClient) CreateEvent(ctx context.Context, event outbox.Event) (int64, error) {
+	ctx, done := observe(ctx, "CreateEvent")
+	result, err := sc.next.CreateEvent(ctx, event)
+	done(err)
+
+	// events created in a transaction may still be rolled back
+	if err == nil {
+		if sc.useTx {
+			sc.events = append(sc.events, event.Type)
+		} else {
+			metrics.CountEvents(event.Type)
+		}
+	}
+
+	return result, err
+}
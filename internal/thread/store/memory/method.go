@@ -0,0 +1,139 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/x-sports/internal/memory"
+	"github.com/x-sports/internal/outbox"
+	outboxmemstore "github.com/x-sports/internal/outbox/store/memory"
+	"github.com/x-sports/internal/thread"
+)
+
+func (sc *storeClient) CreateThread(ctx context.Context, reqThread thread.Thread) (int64, error) {
+	var threadID int64
+	err := sc.c.Do(func(data *memory.Data) error {
+		threadID = data.Threads.NextID()
+
+		// update time defaults to the create time, game
+		// names and icons are derived when read
+		reqThread.ID = threadID
+		reqThread.GameNames = ""
+		reqThread.GameIcons = ""
+		reqThread.Date = memory.Time(reqThread.Date)
+		reqThread.CreateTime = memory.Time(reqThread.CreateTime)
+		reqThread.UpdateTime = reqThread.CreateTime
+		data.Threads.Put(threadID, reqThread)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return threadID, nil
+}
+
+func (sc *storeClient) GetAllThreads(ctx context.Context, filter thread.Filter) ([]thread.Thread, error) {
+	result := make([]thread.Thread, 0)
+	err := sc.c.Do(func(data *memory.Data) error {
+		for _, t := range data.Threads.All() {
+			t, ok := joinGame(data, t)
+			if !ok {
+				continue
+			}
+			if filter.GameID > 0 && t.GameID != filter.GameID {
+				continue
+			}
+
+			result = append(result, t)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// latest first, the ID keeps the order stable
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].Date.Equal(result[j].Date) {
+			return result[i].Date.After(result[j].Date)
+		}
+		return result[i].ID > result[j].ID
+	})
+
+	if filter.Limit > 0 && len(result) > filter.Limit {
+		result = result[:filter.Limit]
+	}
+
+	return result, nil
+}
+
+func (sc *storeClient) GetThreadByID(ctx context.Context, threadID int64) (thread.Thread, error) {
+	var result thread.Thread
+	err := sc.c.Do(func(data *memory.Data) error {
+		t, ok := data.Threads.Get(threadID)
+		if ok {
+			t, ok = joinGame(data, t)
+		}
+		if !ok {
+			return thread.ErrThreadNotFound
+		}
+
+		result = t
+		return nil
+	})
+	if err != nil {
+		return thread.Thread{}, err
+	}
+
+	return result, nil
+}
+
+func (sc *storeClient) UpdateThread(ctx context.Context, update thread.ThreadUpdate, updateTime time.Time) error {
+	return sc.c.Do(func(data *memory.Data) error {
+		// the thread is not updated when its update time has
+		// changed
+		t, ok := data.Threads.Get(update.ID)
+		if !ok {
+			return thread.ErrThreadNotFound
+		}
+		if !t.UpdateTime.Equal(update.Version) {
+			return thread.ErrVersionConflict
+		}
+
+		t = update.Apply(t)
+		t.UpdateTime = memory.Time(updateTime)
+		data.Threads.Put(t.ID, t)
+
+		return nil
+	})
+}
+
+func (sc *storeClient) CreateEvent(ctx context.Context, reqEvent outbox.Event) (int64, error) {
+	var eventID int64
+	err := sc.c.Do(func(data *memory.Data) error {
+		eventID = outboxmemstore.CreateEvent(data, reqEvent)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return eventID, nil
+}
+
+// joinGame returns the given thread with the names and icons of
+// its game, and false if the game does not exist.
+func joinGame(data *memory.Data, t thread.Thread) (thread.Thread, bool) {
+	g, ok := data.Games.Get(t.GameID)
+	if !ok {
+		return thread.Thread{}, false
+	}
+
+	t.GameNames = g.GameNames
+	t.GameIcons = g.GameIcons
+	return t, true
+}
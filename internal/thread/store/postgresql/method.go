@@ -2,13 +2,23 @@ package postgresql
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/x-sports/internal/outbox"
+	outboxpgstore "github.com/x-sports/internal/outbox/store/postgresql"
 	"github.com/x-sports/internal/thread"
 )
 
 func (sc *storeClient) CreateThread(ctx context.Context, reqThread thread.Thread) (int64, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	// construct arguments filled with fields for the query
 	argsKV := map[string]interface{}{
 		"title":        reqThread.Title,
@@ -32,7 +42,7 @@ func (sc *storeClient) CreateThread(ctx context.Context, reqThread thread.Thread
 
 	// execute query
 	var threadID int64
-	err = sc.q.QueryRowx(query, args...).Scan(&threadID)
+	err = sc.q.QueryRowxContext(ctx, query, args...).Scan(&threadID)
 	if err != nil {
 		return 0, err
 	}
@@ -40,11 +50,38 @@ func (sc *storeClient) CreateThread(ctx context.Context, reqThread thread.Thread
 	return threadID, nil
 }
 
-func (sc *storeClient) GetAllThreads(ctx context.Context) ([]thread.Thread, error) {
-	query := fmt.Sprintf(queryGetThreads, "")
+func (sc *storeClient) GetAllThreads(ctx context.Context, filter thread.Filter) ([]thread.Thread, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// define variables to custom query
+	argsKV := make(map[string]interface{})
+	addConditions := make([]string, 0)
+
+	if filter.GameID > 0 {
+		addConditions = append(addConditions, "t.game_id = :game_id")
+		argsKV["game_id"] = filter.GameID
+	}
+
+	// construct strings to custom query
+	addCondition := strings.Join(addConditions, " AND ")
+
+	// since the query does not contains "WHERE" yet, need
+	// to add it if needed
+	if len(addConditions) > 0 {
+		addCondition = fmt.Sprintf("WHERE %s", addCondition)
+	}
+	addCondition = fmt.Sprintf("%s ORDER BY t.date DESC, t.id DESC", addCondition)
+
+	if filter.Limit > 0 {
+		addCondition = fmt.Sprintf("%s LIMIT :limit", addCondition)
+		argsKV["limit"] = filter.Limit
+	}
+	query := fmt.Sprintf(queryGetThreads, addCondition)
 
 	// prepare query
-	query, args, err := sqlx.Named(query, map[string]interface{}{})
+	query, args, err := sqlx.Named(query, argsKV)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +92,7 @@ func (sc *storeClient) GetAllThreads(ctx context.Context) ([]thread.Thread, erro
 	query = sc.q.Rebind(query)
 
 	// query to database
-	rows, err := sc.q.Queryx(query, args...)
+	rows, err := sc.q.QueryxContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -80,21 +117,43 @@ func (sc *storeClient) GetAllThreads(ctx context.Context) ([]thread.Thread, erro
 	return thread, nil
 }
 
-func (sc *storeClient) UpdateThread(ctx context.Context, reqThread thread.Thread) error {
+func (sc *storeClient) UpdateThread(ctx context.Context, update thread.ThreadUpdate, updateTime time.Time) error {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	// construct arguments filled with fields for the query
 	argsKV := map[string]interface{}{
-		"id":           reqThread.ID,
-		"title":        reqThread.Title,
-		"game_id":      reqThread.GameID,
-		"description":  reqThread.Description,
-		"image_thread": reqThread.ImageThread,
-		"date":         reqThread.Date,
-		"create_time":  reqThread.CreateTime,
-		"update_time":  reqThread.UpdateTime,
+		"id":          update.ID,
+		"version":     sql.NullTime{Time: update.Version, Valid: !update.Version.IsZero()},
+		"update_time": updateTime,
+	}
+
+	// only set the columns of the fields set in the update
+	sets := []string{"update_time = :update_time"}
+	if update.Title != nil {
+		sets = append(sets, "title = :title")
+		argsKV["title"] = *update.Title
+	}
+	if update.GameID != nil {
+		sets = append(sets, "game_id = :game_id")
+		argsKV["game_id"] = *update.GameID
+	}
+	if update.Description != nil {
+		sets = append(sets, "description = :description")
+		argsKV["description"] = *update.Description
+	}
+	if update.ImageThread != nil {
+		sets = append(sets, "image_thread = :image_thread")
+		argsKV["image_thread"] = *update.ImageThread
+	}
+	if update.Date != nil {
+		sets = append(sets, "date = :date")
+		argsKV["date"] = *update.Date
 	}
 
 	// prepare query
-	query, args, err := sqlx.Named(queryUpdateThread, argsKV)
+	query, args, err := sqlx.Named(fmt.Sprintf(queryUpdateThread, strings.Join(sets, ",\n\t\t")), argsKV)
 	if err != nil {
 		return err
 	}
@@ -105,19 +164,52 @@ func (sc *storeClient) UpdateThread(ctx context.Context, reqThread thread.Thread
 	query = sc.q.Rebind(query)
 
 	// execute query
-	_, err = sc.q.Exec(query, args...)
-	return err
+	res, err := sc.q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	// check whether the thread is updated, it is not when
+	// it does not exist or its update time has changed
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		_, err = sc.GetThreadByID(ctx, update.ID)
+		if err != nil {
+			return err
+		}
+		return thread.ErrVersionConflict
+	}
+
+	return nil
 }
 
 func (sc *storeClient) GetThreadByID(ctx context.Context, threadID int64) (thread.Thread, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
 	query := fmt.Sprintf(queryGetThreads, "WHERE t.id = $1")
 
 	// query single row
 	var tdb threadDB
-	err := sc.q.QueryRowx(query, threadID).StructScan(&tdb)
+	err := sc.q.QueryRowxContext(ctx, query, threadID).StructScan(&tdb)
+	if errors.Is(err, sql.ErrNoRows) {
+		return thread.Thread{}, thread.ErrThreadNotFound
+	}
 	if err != nil {
 		return thread.Thread{}, err
 	}
 
 	return tdb.format(), nil
 }
+
+func (sc *storeClient) CreateEvent(ctx context.Context, reqEvent outbox.Event) (int64, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	return outboxpgstore.CreateEvent(ctx, sc.q, reqEvent)
+}
@@ -9,13 +9,15 @@ const queryCreateThread = `
 		description,
 		image_thread,
 		date,
-		create_time
+		create_time,
+		update_time
 	) VALUES (
 		:title,
 		:game_id,
 		:description,
 		:image_thread,
 		:date,
+		:create_time,
 		:create_time
 	)  RETURNING
 		id
@@ -46,13 +48,8 @@ const queryUpdateThread = `
 	UPDATE
 		thread
 	SET
-		title = :title,
-		game_id = :game_id,
-		description = :description,
-		image_thread = :image_thread,
-		date = :date,
-		create_time = :create_time,
-		update_time = :update_time
+		%s
 	WHERE
-		id = :id
+		id = :id AND
+		update_time IS NOT DISTINCT FROM :version
 `
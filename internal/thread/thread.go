@@ -10,21 +10,21 @@ type Service interface {
 	// created thread ID.
 	CreateThread(ctx context.Context, thread Thread) (int64, error)
 
-	// GetAllThreads returns all thread.
-	GetAllThreads(ctx context.Context) ([]Thread, error)
+	// GetAllThreads returns all threads that match the given
+	// filter, sorted from the latest date.
+	GetAllThreads(ctx context.Context, filter Filter) ([]Thread, error)
 
 	// GetThreadByID returns a thread with the given
 	// thread ID.
 	GetThreadByID(ctx context.Context, threadID int64) (Thread, error)
 
-	// UpdateThread updates existing thread with the given
-	// thread data.
+	// UpdateThread updates the fields set in the given thread
+	// update of an existing thread, and returns the updated
+	// thread.
 	//
-	// Updatethread do updates on all main attributes
-	// except ID, CreateBy, and CreateTime. So, make sure to
-	// use current values in the given data if do not want
-	// to update some specific attributes.
-	UpdateThread(ctx context.Context, thread Thread) error
+	// ErrVersionConflict is returned if the update has a
+	// version and the thread has been updated since then.
+	UpdateThread(ctx context.Context, update ThreadUpdate) (Thread, error)
 }
 
 type Thread struct {
@@ -39,3 +39,51 @@ type Thread struct {
 	CreateTime  time.Time
 	UpdateTime  time.Time
 }
+
+// ThreadUpdate is a partial update of an existing thread, only
+// its non-nil fields are updated.
+type ThreadUpdate struct {
+	ID          int64
+	Title       *string
+	GameID      *int64
+	Description *string
+	ImageThread *string
+	Date        *time.Time
+
+	// Version is the update time of the thread the update is
+	// based on, zero value means no version check.
+	Version time.Time
+}
+
+// Apply returns the given thread with the fields set in the
+// update applied.
+func (u ThreadUpdate) Apply(t Thread) Thread {
+	if u.Title != nil {
+		t.Title = *u.Title
+	}
+	if u.GameID != nil {
+		t.GameID = *u.GameID
+	}
+	if u.Description != nil {
+		t.Description = *u.Description
+	}
+	if u.ImageThread != nil {
+		t.ImageThread = *u.ImageThread
+	}
+	if u.Date != nil {
+		t.Date = *u.Date
+	}
+
+	return t
+}
+
+// Filter denotes the filter used to get threads, zero value
+// fields are not used to filter.
+type Filter struct {
+	// GameID filters threads of the given game.
+	GameID int64
+
+	// Limit is the maximum number of threads, zero to get all
+	// threads.
+	Limit int
+}
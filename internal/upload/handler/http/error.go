@@ -2,6 +2,8 @@ package http
 
 import (
 	"errors"
+
+	"github.com/x-sports/global/helper"
 )
 
 // Followings are the known errors from upload HTTP handlers.
@@ -10,29 +12,17 @@ var (
 	// bad/invalid.
 	errBadRequest = errors.New("BAD_REQUEST")
 
-	// errInvalidToken is returned when the given token is
-	// invalid
-	errInvalidToken = errors.New("INVALID_TOKEN")
-
-	// errInternalServerError is returned when there is an
-	// internal error.
-	errInternalServerError = errors.New("INTERNAL_SERVER_ERROR")
-
 	// errFileTooLarge is returned when the request giving
 	// file that size are bigger than max file size.
 	errFileTooLarge = errors.New("FILE_TOO_LARGE")
 
+	// errUploadDisabled is returned when upload is not
+	// configured.
+	errUploadDisabled = errors.New("UPLOAD_DISABLED")
+
 	// errMethodNotAllowed is returned when accessing not
 	// allowed HTTP method.
 	errMethodNotAllowed = errors.New("METHOD_NOT_ALLOWED")
-
-	// errRequestTimeout is returned when processing time has
-	// reached the timeout limit.
-	errRequestTimeout = errors.New("REQUEST_TIMEOUT")
-
-	// errUnauthorizedAccess is returned when the request
-	// is unaothorized.
-	errUnauthorizedAccess = errors.New("UNAUTHORIZED_ACCESS")
 )
 
 var (
@@ -43,4 +33,18 @@ var (
 	// and the handler should just return `errInternal` as the
 	// error instead
 	mapHTTPError = map[error]error{}
+
+	// mapHTTPStatus maps HTTP error into its status code when
+	// it is not a bad request error.
+	mapHTTPStatus = map[error]int{}
 )
+
+// newEndpoint returns helper.Endpoint of the handler with the
+// given name.
+func newEndpoint(name string) helper.Endpoint {
+	return helper.Endpoint{
+		Name:      "[Upload HTTP][" + name + "]",
+		MapError:  mapHTTPError,
+		MapStatus: mapHTTPStatus,
+	}
+}
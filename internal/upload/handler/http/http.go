@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/cloudinary/cloudinary-go/v2"
 	"github.com/gorilla/mux"
 	"github.com/x-sports/internal/admin"
 )
@@ -12,10 +13,25 @@ var (
 	errUnknownConfig = errors.New("unknown config name")
 )
 
+// defaultMaxFileSize is the maximum size of an uploaded file
+// used if it is not configured.
+const defaultMaxFileSize int64 = 1024 * 1024 // 1 MB
+
 // Handler contains admin HTTP-handlers.
 type Handler struct {
-	handlers map[string]*handler
-	admin    admin.Service
+	handlers    map[string]*handler
+	admin       admin.Service
+	cld         *cloudinary.Cloudinary
+	maxFileSize int64
+}
+
+// Config denotes the upload configuration. Upload is disabled
+// if the Cloudinary credentials are empty.
+type Config struct {
+	CloudinaryName   string
+	CloudinaryKey    string
+	CloudinarySecret string
+	MaxFileSize      int64
 }
 
 // handler is the HTTP handler wrapper.
@@ -41,10 +57,24 @@ var (
 )
 
 // New creates a new Handler.
-func New(admin admin.Service, identities []HandlerIdentity) (*Handler, error) {
+func New(admin admin.Service, config Config, identities []HandlerIdentity) (*Handler, error) {
 	h := &Handler{
-		handlers: make(map[string]*handler),
-		admin:    admin,
+		handlers:    make(map[string]*handler),
+		admin:       admin,
+		maxFileSize: config.MaxFileSize,
+	}
+	if h.maxFileSize <= 0 {
+		h.maxFileSize = defaultMaxFileSize
+	}
+
+	// create Cloudinary client once, instead of on every
+	// upload
+	if config.CloudinaryName != "" {
+		cld, err := cloudinary.NewFromParams(config.CloudinaryName, config.CloudinaryKey, config.CloudinarySecret)
+		if err != nil {
+			return nil, err
+		}
+		h.cld = cld
 	}
 
 	// apply options
@@ -75,7 +105,9 @@ func (h *Handler) createHTTPHandler(configName string) (http.Handler, error) {
 	switch configName {
 	case HandlerUpload.Name:
 		httpHandler = &uploadHandler{
-			admin: h.admin,
+			admin:       h.admin,
+			cld:         h.cld,
+			maxFileSize: h.maxFileSize,
 		}
 	default:
 		return httpHandler, errUnknownConfig
@@ -86,7 +118,7 @@ func (h *Handler) createHTTPHandler(configName string) (http.Handler, error) {
 // Start starts all HTTP handlers.
 func (h *Handler) Start(multiplexer *mux.Router) error {
 	for _, handler := range h.handlers {
-		multiplexer.Handle(handler.identity.URL, handler.h)
+		multiplexer.Handle(handler.identity.URL, handler.h).Name(handler.identity.Name)
 	}
 	return nil
 }
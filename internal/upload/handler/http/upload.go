@@ -2,25 +2,21 @@ package http
 
 import (
 	"context"
-	"encoding/json"
-	"log"
 	"net/http"
-	"os"
 	"time"
 
 	"github.com/cloudinary/cloudinary-go/v2"
 	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
 
 	"github.com/x-sports/global/helper"
+	"github.com/x-sports/global/metrics"
 	"github.com/x-sports/internal/admin"
 )
 
-var (
-	maxFileSize int64 = 1024 * 1024 // 1 MB
-)
-
 type uploadHandler struct {
-	admin admin.Service
+	admin       admin.Service
+	cld         *cloudinary.Cloudinary
+	maxFileSize int64
 }
 
 func (h *uploadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -34,114 +30,47 @@ func (h *uploadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *uploadHandler) handleUpload(w http.ResponseWriter, r *http.Request) {
-	// add timeout to context
-	ctx, cancel := context.WithTimeout(r.Context(), 5000*time.Millisecond)
-	defer cancel()
-
-	var (
-		err        error           // stores error in this handler
-		source     string          // stores request source
-		resBody    []byte          // stores response body to write
-		statusCode = http.StatusOK // stores response status code
-	)
+	endpoint := newEndpoint("handleUpload")
+	endpoint.Timeout = 5000 * time.Millisecond
 
-	// write response
-	defer func() {
-		// error
+	helper.Serve(w, r, endpoint, func(ctx context.Context) (string, error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
 		if err != nil {
-			log.Printf("[Upload HTTP][handleUpload] Failed to upload file. Source: %s, Err: %s\n", source, err.Error())
-			helper.WriteErrorResponse(w, statusCode, []string{err.Error()})
-			return
+			return "", err
 		}
-		// success
-		helper.WriteResponse(w, resBody, statusCode, helper.JSONContentTypeDecorator)
-	}()
-
-	// prepare channels for main go routine
-	resChan := make(chan string, 1)
-	errChan := make(chan error, 1)
 
-	go func() {
-		// get token from header
-		token, err := helper.GetBearerTokenFromHeader(r)
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errInvalidToken
-			return
-		}
-
-		// check access token
-		err = checkAccessToken(ctx, h.admin, token, "handleUpload")
-		if err != nil {
-			statusCode = http.StatusUnauthorized
-			errChan <- err
-			return
+		// upload is disabled if Cloudinary is not configured
+		if h.cld == nil {
+			return "", helper.NewHTTPError(http.StatusServiceUnavailable, errUploadDisabled)
 		}
 
 		// parse request body as multipart/form-data
-		err = r.ParseMultipartForm(maxFileSize)
+		err = r.ParseMultipartForm(h.maxFileSize)
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
+			return "", helper.NewHTTPError(http.StatusBadRequest, errBadRequest)
 		}
 
 		// get file from form-data
 		uploaded, uploadedHeader, err := r.FormFile("file")
 		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
+			return "", helper.NewHTTPError(http.StatusBadRequest, errBadRequest)
 		}
 		defer uploaded.Close()
 
 		// get and validates file size
 		uploadedSize := uploadedHeader.Size
-		if uploadedSize > maxFileSize {
-			statusCode = http.StatusBadRequest
-			errChan <- errFileTooLarge
-			return
-		}
-
-		cld, err := cloudinary.NewFromParams(os.Getenv("CLOUDINARY_API_NAME"), os.Getenv("CLOUDINARY_API_KEY"), os.Getenv("CLOUDINARY_API_SECRET"))
-		if err != nil {
-			statusCode = http.StatusBadRequest
-			errChan <- errBadRequest
-			return
+		if uploadedSize > h.maxFileSize {
+			return "", helper.NewHTTPError(http.StatusBadRequest, errFileTooLarge)
 		}
 
 		// create file
-		res, err := cld.Upload.Upload(ctx, uploaded, uploader.UploadParams{Folder: "x-sports"})
+		res, err := h.cld.Upload.Upload(ctx, uploaded, uploader.UploadParams{Folder: "x-sports"})
+		metrics.CountUpload(uploadedSize, err)
 		if err != nil {
-			// determine error and status code, by default its internal error
-			parsedErr := errInternalServerError
-			statusCode = http.StatusInternalServerError
-			if v, ok := mapHTTPError[err]; ok {
-				parsedErr = v
-				statusCode = http.StatusBadRequest
-			}
-
-			// log the actual error if its internal error
-			if statusCode == http.StatusInternalServerError {
-				log.Printf("[Upload HTTP][handleUpload] Internal error from CreateFile. Err: %s\n", err.Error())
-			}
-
-			errChan <- parsedErr
-			return
+			return "", err
 		}
 
-		resChan <- res.SecureURL
-	}()
-
-	// wait and handle main go routine
-	select {
-	case <-ctx.Done():
-		statusCode = http.StatusGatewayTimeout
-		err = errRequestTimeout
-	case err = <-errChan:
-	case data := <-resChan:
-		resBody, err = json.Marshal(helper.ResponseEnvelope{
-			Data: data,
-		})
-	}
+		return res.SecureURL, nil
+	})
 }
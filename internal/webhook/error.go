@@ -0,0 +1,33 @@
+package webhook
+
+import "errors"
+
+var (
+	// ErrInvalidSubscriptionID is returned when the given
+	// subscription id is invalid.
+	ErrInvalidSubscriptionID = errors.New("invalid subscription id")
+
+	// ErrInvalidDeliveryID is returned when the given
+	// delivery id is invalid.
+	ErrInvalidDeliveryID = errors.New("invalid delivery id")
+
+	// ErrInvalidURL is returned when the given url is
+	// invalid.
+	ErrInvalidURL = errors.New("invalid url")
+
+	// ErrInvalidSecret is returned when the given secret is
+	// invalid.
+	ErrInvalidSecret = errors.New("invalid secret")
+
+	// ErrInvalidEventType is returned when the given event
+	// type is invalid.
+	ErrInvalidEventType = errors.New("invalid event type")
+
+	// ErrSubscriptionNotFound is returned when the
+	// subscription with the given ID does not exist.
+	ErrSubscriptionNotFound = errors.New("subscription not found")
+
+	// ErrDeliveryNotFound is returned when the
+	// delivery with the given ID does not exist.
+	ErrDeliveryNotFound = errors.New("delivery not found")
+)
@@ -0,0 +1,62 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/webhook"
+)
+
+type deliveriesHandler struct {
+	webhook webhook.Service
+	admin   admin.Service
+}
+
+func (h *deliveriesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	subscriptionID, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		slog.InfoContext(r.Context(), "failed to parse subscription ID", slog.String("handler", "[Webhook HTTP][deliveriesHandler]"), slog.String("id", vars["id"]), slog.String("error", err.Error()))
+		helper.WriteErrorResponse(w, http.StatusBadRequest, []string{errInvalidSubscriptionID.Error()})
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		h.handleGetDeliveries(w, r, subscriptionID)
+	default:
+		helper.WriteErrorResponse(w, http.StatusMethodNotAllowed, []string{errMethodNotAllowed.Error()})
+	}
+}
+
+func (h *deliveriesHandler) handleGetDeliveries(w http.ResponseWriter, r *http.Request, subscriptionID int64) {
+	helper.Serve(w, r, newEndpoint("handleGetDeliveries"), func(ctx context.Context) ([]deliveryHTTP, error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := h.webhook.GetDeliveries(ctx, subscriptionID)
+		if err != nil {
+			return nil, err
+		}
+
+		// format each deliveries
+		deliveries := make([]deliveryHTTP, 0)
+		for _, r := range res {
+			d, err := formatDelivery(r)
+			if err != nil {
+				return nil, err
+			}
+			deliveries = append(deliveries, d)
+		}
+
+		return deliveries, nil
+	})
+}
@@ -0,0 +1,86 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/webhook"
+)
+
+// Followings are the known errors from Webhook HTTP handlers.
+var (
+	// errInvalidSubscriptionID is returned when the given
+	// subscription ID is invalid.
+	errInvalidSubscriptionID = errors.New("INVALID_SUBSCRIPTION_ID")
+
+	// errInvalidDeliveryID is returned when the given
+	// delivery ID is invalid.
+	errInvalidDeliveryID = errors.New("INVALID_DELIVERY_ID")
+
+	// errInvalidURL is returned when the given URL is
+	// invalid.
+	errInvalidURL = errors.New("INVALID_URL")
+
+	// errInvalidSecret is returned when the given secret is
+	// invalid.
+	errInvalidSecret = errors.New("INVALID_SECRET")
+
+	// errInvalidEventType is returned when the given event
+	// type is invalid.
+	errInvalidEventType = errors.New("INVALID_EVENT_TYPE")
+
+	// errMethodNotAllowed is returned when accessing not
+	// allowed HTTP method.
+	errMethodNotAllowed = errors.New("METHOD_NOT_ALLOWED")
+
+	// errSubscriptionNotFound is returned when the
+	// subscription with the given ID does not exist.
+	errSubscriptionNotFound = errors.New("SUBSCRIPTION_NOT_FOUND")
+
+	// errDeliveryNotFound is returned when the
+	// delivery with the given ID does not exist.
+	errDeliveryNotFound = errors.New("DELIVERY_NOT_FOUND")
+
+	// errQueryTimeout is returned when a database query has
+	// reached its timeout limit.
+	errQueryTimeout = errors.New("QUERY_TIMEOUT")
+)
+
+var (
+	// mapHTTPError maps service error into HTTP error that
+	// categorize as bad request error, unless its status code
+	// is set in mapHTTPStatus.
+	//
+	// Internal server error-related should not be mapped
+	// here, and the handler should just return `errInternal`
+	// as the error instead
+	mapHTTPError = map[error]error{
+		webhook.ErrInvalidSubscriptionID: errInvalidSubscriptionID,
+		webhook.ErrInvalidDeliveryID:     errInvalidDeliveryID,
+		webhook.ErrInvalidURL:            errInvalidURL,
+		webhook.ErrInvalidSecret:         errInvalidSecret,
+		webhook.ErrInvalidEventType:      errInvalidEventType,
+		webhook.ErrSubscriptionNotFound:  errSubscriptionNotFound,
+		webhook.ErrDeliveryNotFound:      errDeliveryNotFound,
+		helper.ErrQueryTimeout:           errQueryTimeout,
+	}
+
+	// mapHTTPStatus maps HTTP error into its status code when
+	// it is not a bad request error.
+	mapHTTPStatus = map[error]int{
+		errQueryTimeout:         http.StatusGatewayTimeout,
+		errDeliveryNotFound:     http.StatusNotFound,
+		errSubscriptionNotFound: http.StatusNotFound,
+	}
+)
+
+// newEndpoint returns helper.Endpoint of the handler with the
+// given name.
+func newEndpoint(name string) helper.Endpoint {
+	return helper.Endpoint{
+		Name:      "[Webhook HTTP][" + name + "]",
+		MapError:  mapHTTPError,
+		MapStatus: mapHTTPStatus,
+	}
+}
@@ -0,0 +1,71 @@
+package http
+
+import (
+	"encoding/json"
+
+	"github.com/x-sports/internal/webhook"
+)
+
+// formatSubscription formats the given subscription into
+// the respective HTTP-format object.
+func formatSubscription(s webhook.Subscription) (subscriptionHTTP, error) {
+	eventTypes := make([]string, 0, len(s.EventTypes))
+	for _, et := range s.EventTypes {
+		eventTypes = append(eventTypes, et.String())
+	}
+
+	createTime := s.CreateTime.Format(dateFormat)
+
+	var updateTime *string
+	if !s.UpdateTime.IsZero() {
+		tmp := s.UpdateTime.Format(dateFormat)
+		updateTime = &tmp
+	}
+
+	return subscriptionHTTP{
+		ID:         &s.ID,
+		URL:        &s.URL,
+		EventTypes: &eventTypes,
+		IsActive:   &s.IsActive,
+		CreateTime: &createTime,
+		UpdateTime: updateTime,
+	}, nil
+}
+
+// formatDelivery formats the given delivery into the
+// respective HTTP-format object.
+func formatDelivery(d webhook.Delivery) (deliveryHTTP, error) {
+	eventType := d.EventType.String()
+	payload := json.RawMessage(d.Payload)
+	status := d.Status.String()
+	createTime := d.CreateTime.Format(dateFormat)
+
+	var updateTime *string
+	if !d.UpdateTime.IsZero() {
+		tmp := d.UpdateTime.Format(dateFormat)
+		updateTime = &tmp
+	}
+
+	return deliveryHTTP{
+		ID:             &d.ID,
+		SubscriptionID: &d.SubscriptionID,
+		EventType:      &eventType,
+		Payload:        &payload,
+		Status:         &status,
+		Attempts:       &d.Attempts,
+		ResponseCode:   &d.ResponseCode,
+		LastError:      &d.LastError,
+		CreateTime:     &createTime,
+		UpdateTime:     updateTime,
+	}, nil
+}
+
+// parseEventTypes returns webhook.EventType list from the
+// given strings.
+func parseEventTypes(req []string) []webhook.EventType {
+	result := make([]webhook.EventType, 0, len(req))
+	for _, et := range req {
+		result = append(result, webhook.EventType(et))
+	}
+	return result
+}
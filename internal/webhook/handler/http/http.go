@@ -0,0 +1,165 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/webhook"
+)
+
+var (
+	errUnknownConfig = errors.New("unknown config name")
+)
+
+// dateFormat denotes the standard date format used in
+// webhook HTTP request and response.
+var dateFormat = "2006-01-02 15:04:05 -07:00"
+
+// Handler contains webhook HTTP-handlers.
+type Handler struct {
+	handlers map[string]*handler
+	webhook  webhook.Service
+	admin    admin.Service
+}
+
+// handler is the HTTP handler wrapper.
+type handler struct {
+	h        http.Handler
+	identity HandlerIdentity
+}
+
+// HandlerIdentity denotes the identity of an HTTP hanlder.
+type HandlerIdentity struct {
+	Name string
+	URL  string
+}
+
+// Followings are the known HTTP handler identities
+var (
+	// HandlerWebhook denotes HTTP handler to interact with
+	// a webhook subscription
+	HandlerWebhook = HandlerIdentity{
+		Name: "webhook",
+		URL:  "/webhooks/{id:[0-9]+}",
+	}
+
+	// HandlerWebhooks denotes HTTP handler to interact with
+	// webhook subscriptions
+	HandlerWebhooks = HandlerIdentity{
+		Name: "webhooks",
+		URL:  "/webhooks",
+	}
+
+	// HandlerDeliveries denotes HTTP handler to interact
+	// with deliveries of a webhook subscription
+	HandlerDeliveries = HandlerIdentity{
+		Name: "deliveries",
+		URL:  "/webhooks/{id:[0-9]+}/deliveries",
+	}
+
+	// HandlerReplay denotes HTTP handler to replay a
+	// delivery
+	HandlerReplay = HandlerIdentity{
+		Name: "replay",
+		URL:  "/webhooks/deliveries/{id:[0-9]+}/replay",
+	}
+)
+
+// New creates a new Handler.
+func New(webhook webhook.Service, admin admin.Service, identities []HandlerIdentity) (*Handler, error) {
+	h := &Handler{
+		handlers: make(map[string]*handler),
+		webhook:  webhook,
+		admin:    admin,
+	}
+
+	// apply options
+	for _, identity := range identities {
+		if h.handlers == nil {
+			h.handlers = map[string]*handler{}
+		}
+
+		h.handlers[identity.Name] = &handler{
+			identity: identity,
+		}
+
+		handler, err := h.createHTTPHandler(identity.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		h.handlers[identity.Name].h = handler
+	}
+
+	return h, nil
+}
+
+// createHTTPHandler creates a new HTTP handler that
+// implements http.Handler.
+func (h *Handler) createHTTPHandler(configName string) (http.Handler, error) {
+	var httpHandler http.Handler
+	switch configName {
+	case HandlerWebhook.Name:
+		httpHandler = &webhookHandler{
+			webhook: h.webhook,
+			admin:   h.admin,
+		}
+	case HandlerWebhooks.Name:
+		httpHandler = &webhooksHandler{
+			webhook: h.webhook,
+			admin:   h.admin,
+		}
+	case HandlerDeliveries.Name:
+		httpHandler = &deliveriesHandler{
+			webhook: h.webhook,
+			admin:   h.admin,
+		}
+	case HandlerReplay.Name:
+		httpHandler = &replayHandler{
+			webhook: h.webhook,
+			admin:   h.admin,
+		}
+	default:
+		return httpHandler, errUnknownConfig
+	}
+	return httpHandler, nil
+}
+
+// Start starts all HTTP handlers.
+func (h *Handler) Start(multiplexer *mux.Router) error {
+	for _, handler := range h.handlers {
+		multiplexer.Handle(handler.identity.URL, handler.h).Name(handler.identity.Name)
+	}
+	return nil
+}
+
+// subscriptionHTTP denotes webhook subscription object in
+// HTTP request or response body. Secret is only read from
+// request and never written in response.
+type subscriptionHTTP struct {
+	ID         *int64    `json:"id"`
+	URL        *string   `json:"url"`
+	Secret     *string   `json:"secret,omitempty"`
+	EventTypes *[]string `json:"event_types"`
+	IsActive   *bool     `json:"is_active"`
+	CreateTime *string   `json:"create_time"`
+	UpdateTime *string   `json:"update_time"`
+}
+
+// deliveryHTTP denotes webhook delivery object in HTTP
+// response body.
+type deliveryHTTP struct {
+	ID             *int64           `json:"id"`
+	SubscriptionID *int64           `json:"subscription_id"`
+	EventType      *string          `json:"event_type"`
+	Payload        *json.RawMessage `json:"payload"`
+	Status         *string          `json:"status"`
+	Attempts       *int             `json:"attempts"`
+	ResponseCode   *int             `json:"response_code"`
+	LastError      *string          `json:"last_error"`
+	CreateTime     *string          `json:"create_time"`
+	UpdateTime     *string          `json:"update_time"`
+}
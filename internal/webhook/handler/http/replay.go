@@ -0,0 +1,47 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/webhook"
+)
+
+type replayHandler struct {
+	webhook webhook.Service
+	admin   admin.Service
+}
+
+func (h *replayHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	deliveryID, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		slog.InfoContext(r.Context(), "failed to parse delivery ID", slog.String("handler", "[Webhook HTTP][replayHandler]"), slog.String("id", vars["id"]), slog.String("error", err.Error()))
+		helper.WriteErrorResponse(w, http.StatusBadRequest, []string{errInvalidDeliveryID.Error()})
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		h.handleReplayDelivery(w, r, deliveryID)
+	default:
+		helper.WriteErrorResponse(w, http.StatusMethodNotAllowed, []string{errMethodNotAllowed.Error()})
+	}
+}
+
+func (h *replayHandler) handleReplayDelivery(w http.ResponseWriter, r *http.Request, deliveryID int64) {
+	helper.Serve(w, r, newEndpoint("handleReplayDelivery"), func(ctx context.Context) (int64, error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return 0, err
+		}
+
+		return h.webhook.ReplayDelivery(ctx, deliveryID)
+	})
+}
@@ -0,0 +1,135 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/webhook"
+)
+
+type webhookHandler struct {
+	webhook webhook.Service
+	admin   admin.Service
+}
+
+func (h *webhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	subscriptionID, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		slog.InfoContext(r.Context(), "failed to parse subscription ID", slog.String("handler", "[Webhook HTTP][webhookHandler]"), slog.String("id", vars["id"]), slog.String("error", err.Error()))
+		helper.WriteErrorResponse(w, http.StatusBadRequest, []string{errInvalidSubscriptionID.Error()})
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		h.handleGetSubscriptionByID(w, r, subscriptionID)
+	case http.MethodPatch:
+		h.handleUpdateSubscription(w, r, subscriptionID)
+	case http.MethodDelete:
+		h.handleDeleteSubscriptionByID(w, r, subscriptionID)
+	default:
+		helper.WriteErrorResponse(w, http.StatusMethodNotAllowed, []string{errMethodNotAllowed.Error()})
+	}
+}
+
+func (h *webhookHandler) handleGetSubscriptionByID(w http.ResponseWriter, r *http.Request, subscriptionID int64) {
+	helper.Serve(w, r, newEndpoint("handleGetSubscriptionByID"), func(ctx context.Context) (subscriptionHTTP, error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return subscriptionHTTP{}, err
+		}
+
+		// TODO: add authorization flow with roles
+
+		res, err := h.webhook.GetSubscriptionByID(ctx, subscriptionID)
+		if err != nil {
+			return subscriptionHTTP{}, err
+		}
+
+		return formatSubscription(res)
+	})
+}
+
+func (h *webhookHandler) handleUpdateSubscription(w http.ResponseWriter, r *http.Request, subscriptionID int64) {
+	helper.Serve(w, r, newEndpoint("handleUpdateSubscription"), func(ctx context.Context) (int64, error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return 0, err
+		}
+
+		// decode request body
+		request := subscriptionHTTP{}
+		err = helper.DecodeJSON(r, &request)
+		if err != nil {
+			return 0, err
+		}
+
+		// get current subscription
+		current, err := h.webhook.GetSubscriptionByID(ctx, subscriptionID)
+		if err != nil {
+			return 0, err
+		}
+
+		// format HTTP request into service object
+		reqSubscription, err := parseSubscriptionFromUpdateRequest(request, current)
+		if err != nil {
+			return 0, helper.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		err = h.webhook.UpdateSubscription(ctx, reqSubscription)
+		if err != nil {
+			return 0, err
+		}
+
+		return subscriptionID, nil
+	})
+}
+
+func (h *webhookHandler) handleDeleteSubscriptionByID(w http.ResponseWriter, r *http.Request, subscriptionID int64) {
+	helper.Serve(w, r, newEndpoint("handleDeleteSubscriptionByID"), func(ctx context.Context) (int64, error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return 0, err
+		}
+
+		err = h.webhook.DeleteSubscriptionByID(ctx, subscriptionID)
+		if err != nil {
+			return 0, err
+		}
+
+		return subscriptionID, nil
+	})
+}
+
+// parseSubscriptionFromUpdateRequest returns
+// webhook.Subscription from the given HTTP request object.
+func parseSubscriptionFromUpdateRequest(sh subscriptionHTTP, current webhook.Subscription) (webhook.Subscription, error) {
+	result := current
+
+	if sh.URL != nil {
+		result.URL = *sh.URL
+	}
+
+	if sh.Secret != nil {
+		result.Secret = *sh.Secret
+	}
+
+	if sh.EventTypes != nil {
+		result.EventTypes = parseEventTypes(*sh.EventTypes)
+	}
+
+	if sh.IsActive != nil {
+		result.IsActive = *sh.IsActive
+	}
+
+	return result, nil
+}
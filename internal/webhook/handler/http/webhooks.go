@@ -0,0 +1,104 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/internal/admin"
+	"github.com/x-sports/internal/webhook"
+)
+
+type webhooksHandler struct {
+	webhook webhook.Service
+	admin   admin.Service
+}
+
+func (h *webhooksHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.handleGetAllSubscriptions(w, r)
+	case http.MethodPost:
+		h.handleCreateSubscription(w, r)
+	default:
+		helper.WriteErrorResponse(w, http.StatusMethodNotAllowed, []string{errMethodNotAllowed.Error()})
+	}
+}
+
+func (h *webhooksHandler) handleGetAllSubscriptions(w http.ResponseWriter, r *http.Request) {
+	helper.Serve(w, r, newEndpoint("handleGetAllSubscriptions"), func(ctx context.Context) ([]subscriptionHTTP, error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := h.webhook.GetAllSubscriptions(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// format each subscriptions
+		subscriptions := make([]subscriptionHTTP, 0)
+		for _, r := range res {
+			v, err := formatSubscription(r)
+			if err != nil {
+				return nil, err
+			}
+			subscriptions = append(subscriptions, v)
+		}
+
+		return subscriptions, nil
+	})
+}
+
+func (h *webhooksHandler) handleCreateSubscription(w http.ResponseWriter, r *http.Request) {
+	helper.Serve(w, r, newEndpoint("handleCreateSubscription"), func(ctx context.Context) (int64, error) {
+		// check access token
+		_, err := helper.Authenticate(ctx, r, h.admin.ValidateToken)
+		if err != nil {
+			return 0, err
+		}
+
+		// decode request body
+		request := subscriptionHTTP{}
+		err = helper.DecodeJSON(r, &request)
+		if err != nil {
+			return 0, err
+		}
+
+		// format HTTP request into service object
+		reqSubscription, err := parseSubscriptionFromCreateRequest(request)
+		if err != nil {
+			return 0, helper.NewHTTPError(http.StatusBadRequest, err)
+		}
+
+		return h.webhook.CreateSubscription(ctx, reqSubscription)
+	})
+}
+
+// parseSubscriptionFromCreateRequest returns
+// webhook.Subscription from the given HTTP request object.
+func parseSubscriptionFromCreateRequest(sh subscriptionHTTP) (webhook.Subscription, error) {
+	result := webhook.Subscription{
+		IsActive: true,
+	}
+
+	if sh.URL != nil {
+		result.URL = *sh.URL
+	}
+
+	if sh.Secret != nil {
+		result.Secret = *sh.Secret
+	}
+
+	if sh.EventTypes != nil {
+		result.EventTypes = parseEventTypes(*sh.EventTypes)
+	}
+
+	if sh.IsActive != nil {
+		result.IsActive = *sh.IsActive
+	}
+
+	return result, nil
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/x-sports/internal/webhook"
+)
+
+// Followings are the headers sent along with a delivery.
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+func (s *service) DeliverPending(ctx context.Context) (int, error) {
+	// the deliveries are sent without transaction, so that no
+	// lock is held while waiting for the subscriptions
+	pgStoreClient, err := s.pgStore.NewClient(false)
+	if err != nil {
+		return 0, err
+	}
+
+	// claim the due deliveries, so that other workers skip
+	// them until the lease time
+	now := s.timeNow()
+	deliveries, err := pgStoreClient.ClaimPendingDeliveries(ctx, now, now.Add(s.config.LeaseTimeout), s.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	// deliveries of the same event share the subscriptions
+	subscriptions := make(map[int64]webhook.Subscription)
+	for _, delivery := range deliveries {
+		subscription, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			subscription, err = pgStoreClient.GetSubscriptionByID(ctx, delivery.SubscriptionID)
+			if errors.Is(err, webhook.ErrSubscriptionNotFound) {
+				// the deliveries are deleted along with the
+				// subscription
+				continue
+			}
+			if err != nil {
+				return 0, err
+			}
+			subscriptions[subscription.ID] = subscription
+		}
+
+		err = s.deliver(ctx, pgStoreClient, subscription, delivery)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+func (s *service) Run(ctx context.Context) {
+	for {
+		delivered, err := s.DeliverPending(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to send deliveries", slog.String("error", err.Error()))
+		}
+
+		// keep sending without waiting while there might be
+		// more due deliveries
+		if err == nil && delivered >= s.config.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.config.PollInterval):
+		}
+	}
+}
+
+// deliver does an attempt of the given delivery to the
+// subscription and records it in the delivery log using the
+// given store client. A failed delivery is scheduled to be
+// attempted again with exponential backoff until the maximum
+// attempts is reached.
+func (s *service) deliver(ctx context.Context, pgStoreClient PGStoreClient, subscription webhook.Subscription, delivery webhook.Delivery) error {
+	delivery.Attempts++
+
+	code, err := s.send(ctx, subscription, delivery)
+	delivery.ResponseCode = code
+	delivery.LastError = ""
+	switch {
+	case err == nil:
+		delivery.Status = webhook.DeliveryStatusSuccess
+	case delivery.Attempts >= s.config.MaxAttempts:
+		delivery.Status = webhook.DeliveryStatusFailed
+		delivery.LastError = err.Error()
+	default:
+		delivery.Status = webhook.DeliveryStatusPending
+		delivery.LastError = err.Error()
+		delivery.NextAttemptTime = s.timeNow().Add(s.backoff(delivery.Attempts))
+	}
+	delivery.UpdateTime = s.timeNow()
+
+	return pgStoreClient.UpdateDelivery(ctx, delivery)
+}
+
+// backoff returns the wait time before the next attempt of
+// a delivery that has failed the given number of attempts.
+func (s *service) backoff(attempts int) time.Duration {
+	backoff := s.config.InitialBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= s.config.MaxBackoff {
+			return s.config.MaxBackoff
+		}
+	}
+	return backoff
+}
+
+// send does a single attempt of the delivery and returns the
+// response status code. Non-2xx response is considered as an
+// error.
+func (s *service) send(ctx context.Context, subscription webhook.Subscription, delivery webhook.Delivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, delivery.EventType.String())
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderSignature, "sha256="+Sign(subscription.Secret, delivery.Payload))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// drain the body so that the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Sign returns hex encoded HMAC-SHA256 of the payload using
+// the given secret. Receivers verify a delivery by comparing
+// it with the signature header.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+
+	"github.com/x-sports/global/tracing"
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/news"
+	"github.com/x-sports/internal/outbox"
+	"github.com/x-sports/internal/thread"
+)
+
+func (s *service) HandleEvent(ctx context.Context, event outbox.Event) error {
+	ctx, span := tracing.Start(ctx, "webhook.Service.HandleEvent")
+	defer span.End()
+
+	// decode the event data into the respective domain
+	// object so that the payload is formatted the same way
+	// as when it is published directly
+	var data interface{}
+	switch event.Type {
+	case match.EventTypeCreated, match.EventTypeCompleted:
+		var m match.Match
+		if err := event.Decode(&m); err != nil {
+			return err
+		}
+		data = m
+	case match.EventTypeUpdated:
+		var u match.UpdatedEvent
+		if err := event.Decode(&u); err != nil {
+			return err
+		}
+		data = u.Current
+	case news.EventTypeCreated, news.EventTypeUpdated:
+		var n news.News
+		if err := event.Decode(&n); err != nil {
+			return err
+		}
+		data = n
+	case thread.EventTypeCreated, thread.EventTypeUpdated:
+		var t thread.Thread
+		if err := event.Decode(&t); err != nil {
+			return err
+		}
+		data = t
+	default:
+		return outbox.ErrUnknownEventType
+	}
+
+	return s.Publish(ctx, event.Type, data)
+}
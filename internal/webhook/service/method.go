@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/x-sports/global/helper"
+	"github.com/x-sports/global/tracing"
+	"github.com/x-sports/internal/webhook"
+)
+
+func (s *service) CreateSubscription(ctx context.Context, reqSubscription webhook.Subscription) (int64, error) {
+	ctx, span := tracing.Start(ctx, "webhook.Service.CreateSubscription")
+	defer span.End()
+
+	// validate field
+	err := validateSubscription(reqSubscription)
+	if err != nil {
+		return 0, err
+	}
+
+	reqSubscription.CreateTime = s.timeNow()
+
+	// create subscription in a transaction
+	var subscriptionID int64
+	err = helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		var err error
+		subscriptionID, err = pgStoreClient.CreateSubscription(ctx, reqSubscription)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return subscriptionID, nil
+}
+
+func (s *service) GetAllSubscriptions(ctx context.Context) ([]webhook.Subscription, error) {
+	ctx, span := tracing.Start(ctx, "webhook.Service.GetAllSubscriptions")
+	defer span.End()
+
+	// get pg store client without using transaction
+	pgStoreClient, err := s.pgStore.NewClient(false)
+	if err != nil {
+		return nil, err
+	}
+
+	// get all subscriptions from postgre
+	subscriptions, err := pgStoreClient.GetAllSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func (s *service) GetSubscriptionByID(ctx context.Context, subscriptionID int64) (webhook.Subscription, error) {
+	ctx, span := tracing.Start(ctx, "webhook.Service.GetSubscriptionByID")
+	defer span.End()
+
+	// validate subscription id
+	if subscriptionID <= 0 {
+		return webhook.Subscription{}, webhook.ErrInvalidSubscriptionID
+	}
+
+	// get pg store client without using transaction
+	pgStoreClient, err := s.pgStore.NewClient(false)
+	if err != nil {
+		return webhook.Subscription{}, err
+	}
+
+	// get subscription from pgstore
+	result, err := pgStoreClient.GetSubscriptionByID(ctx, subscriptionID)
+	if err != nil {
+		return webhook.Subscription{}, err
+	}
+
+	return result, nil
+}
+
+func (s *service) UpdateSubscription(ctx context.Context, reqSubscription webhook.Subscription) error {
+	ctx, span := tracing.Start(ctx, "webhook.Service.UpdateSubscription")
+	defer span.End()
+
+	// validate field
+	if reqSubscription.ID <= 0 {
+		return webhook.ErrInvalidSubscriptionID
+	}
+
+	err := validateSubscription(reqSubscription)
+	if err != nil {
+		return err
+	}
+
+	// modify fields
+	reqSubscription.UpdateTime = s.timeNow()
+
+	// updates subscription in pgstore in a transaction
+	return helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		return pgStoreClient.UpdateSubscription(ctx, reqSubscription)
+	})
+}
+
+func (s *service) DeleteSubscriptionByID(ctx context.Context, subscriptionID int64) error {
+	ctx, span := tracing.Start(ctx, "webhook.Service.DeleteSubscriptionByID")
+	defer span.End()
+
+	// validate subscription id
+	if subscriptionID <= 0 {
+		return webhook.ErrInvalidSubscriptionID
+	}
+
+	// delete subscription from pgstore in a transaction
+	return helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		return pgStoreClient.DeleteSubscriptionByID(ctx, subscriptionID)
+	})
+}
+
+func (s *service) GetDeliveries(ctx context.Context, subscriptionID int64) ([]webhook.Delivery, error) {
+	ctx, span := tracing.Start(ctx, "webhook.Service.GetDeliveries")
+	defer span.End()
+
+	// validate subscription id
+	if subscriptionID <= 0 {
+		return nil, webhook.ErrInvalidSubscriptionID
+	}
+
+	// get pg store client without using transaction
+	pgStoreClient, err := s.pgStore.NewClient(false)
+	if err != nil {
+		return nil, err
+	}
+
+	// get all deliveries from postgre
+	deliveries, err := pgStoreClient.GetDeliveries(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (s *service) ReplayDelivery(ctx context.Context, deliveryID int64) (int64, error) {
+	ctx, span := tracing.Start(ctx, "webhook.Service.ReplayDelivery")
+	defer span.End()
+
+	// validate delivery id
+	if deliveryID <= 0 {
+		return 0, webhook.ErrInvalidDeliveryID
+	}
+
+	// record the replay in a transaction, it is sent by Run
+	var delivery webhook.Delivery
+	err := helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		// get the replayed delivery and its subscription, the
+		// subscription is replayed even if it is not active
+		// anymore since replay is requested explicitly
+		previous, err := pgStoreClient.GetDeliveryByID(ctx, deliveryID)
+		if err != nil {
+			return err
+		}
+
+		subscription, err := pgStoreClient.GetSubscriptionByID(ctx, previous.SubscriptionID)
+		if err != nil {
+			return err
+		}
+
+		// replay as a new delivery so that the log of the
+		// previous delivery is kept
+		delivery = webhook.Delivery{
+			SubscriptionID:  subscription.ID,
+			EventType:       previous.EventType,
+			Payload:         previous.Payload,
+			Status:          webhook.DeliveryStatusPending,
+			CreateTime:      s.timeNow(),
+			NextAttemptTime: s.timeNow(),
+		}
+
+		delivery.ID, err = pgStoreClient.CreateDelivery(ctx, delivery)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return delivery.ID, nil
+}
+
+func (s *service) Publish(ctx context.Context, eventType string, data interface{}) error {
+	ctx, span := tracing.Start(ctx, "webhook.Service.Publish")
+	defer span.End()
+
+	// validate event type
+	et := webhook.EventType(eventType)
+	if _, valid := webhook.EventTypeList[et]; !valid {
+		return webhook.ErrInvalidEventType
+	}
+
+	// log a delivery for every interested subscription in a
+	// transaction, they are sent by Run so that pending
+	// deliveries survive restarts
+	return helper.WithTransaction(s.pgStore.NewClient, func(pgStoreClient PGStoreClient) error {
+		subscriptions, err := pgStoreClient.GetActiveSubscriptions(ctx, et)
+		if err != nil {
+			return err
+		}
+
+		if len(subscriptions) == 0 {
+			return nil
+		}
+
+		// build the payload once so that every subscription
+		// receives the exact same body
+		payload, err := buildPayload(et, data, s.timeNow())
+		if err != nil {
+			return err
+		}
+
+		for _, subscription := range subscriptions {
+			_, err = pgStoreClient.CreateDelivery(ctx, webhook.Delivery{
+				SubscriptionID:  subscription.ID,
+				EventType:       et,
+				Payload:         payload,
+				Status:          webhook.DeliveryStatusPending,
+				CreateTime:      s.timeNow(),
+				NextAttemptTime: s.timeNow(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// validateSubscription validates fields of the given
+// Subscription whether its comply the predetermined rules.
+func validateSubscription(reqSubscription webhook.Subscription) error {
+	u, err := url.Parse(reqSubscription.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return webhook.ErrInvalidURL
+	}
+
+	if reqSubscription.Secret == "" {
+		return webhook.ErrInvalidSecret
+	}
+
+	if len(reqSubscription.EventTypes) == 0 {
+		return webhook.ErrInvalidEventType
+	}
+
+	for _, et := range reqSubscription.EventTypes {
+		if _, valid := webhook.EventTypeList[et]; !valid {
+			return webhook.ErrInvalidEventType
+		}
+	}
+
+	return nil
+}
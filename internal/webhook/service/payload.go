@@ -0,0 +1,115 @@
+package service
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/x-sports/internal/match"
+	"github.com/x-sports/internal/news"
+	"github.com/x-sports/internal/thread"
+	"github.com/x-sports/internal/webhook"
+)
+
+// payloadTimeFormat is the time format used in payloads.
+const payloadTimeFormat = time.RFC3339
+
+// payload denotes the body sent to subscriptions.
+type payload struct {
+	Event      string      `json:"event"`
+	CreateTime string      `json:"create_time"`
+	Data       interface{} `json:"data"`
+}
+
+// matchPayload denotes match data in a payload.
+type matchPayload struct {
+	ID              int64   `json:"id"`
+	TournamentNames string  `json:"tournament_names"`
+	GameID          int64   `json:"game_id"`
+	GameNames       string  `json:"game_names"`
+	TeamAID         int64   `json:"team_a_id"`
+	TeamANames      string  `json:"team_a_names"`
+	TeamAOdds       float32 `json:"team_a_odds"`
+	TeamBID         int64   `json:"team_b_id"`
+	TeamBNames      string  `json:"team_b_names"`
+	TeamBOdds       float32 `json:"team_b_odds"`
+	Date            string  `json:"date"`
+	MatchLink       string  `json:"match_link"`
+	Status          string  `json:"status"`
+	Winner          int64   `json:"winner"`
+}
+
+// newsPayload denotes news data in a payload.
+type newsPayload struct {
+	ID          int64  `json:"id"`
+	Title       string `json:"title"`
+	GameID      int64  `json:"game_id"`
+	GameNames   string `json:"game_names"`
+	Description string `json:"description"`
+	ImageNews   string `json:"image_news"`
+	Date        string `json:"date"`
+}
+
+// threadPayload denotes thread data in a payload.
+type threadPayload struct {
+	ID          int64  `json:"id"`
+	Title       string `json:"title"`
+	GameID      int64  `json:"game_id"`
+	GameNames   string `json:"game_names"`
+	Description string `json:"description"`
+	ImageThread string `json:"image_thread"`
+	Date        string `json:"date"`
+}
+
+// buildPayload returns JSON encoded payload of the given
+// event. Known domain data are formatted into a stable
+// shape, others are encoded as is.
+func buildPayload(eventType webhook.EventType, data interface{}, createTime time.Time) ([]byte, error) {
+	var formatted interface{}
+	switch d := data.(type) {
+	case match.Match:
+		formatted = matchPayload{
+			ID:              d.ID,
+			TournamentNames: d.TournamentNames,
+			GameID:          d.GameID,
+			GameNames:       d.GameNames,
+			TeamAID:         d.TeamAID,
+			TeamANames:      d.TeamANames,
+			TeamAOdds:       d.TeamAOdds,
+			TeamBID:         d.TeamBID,
+			TeamBNames:      d.TeamBNames,
+			TeamBOdds:       d.TeamBOdds,
+			Date:            d.Date.Format(payloadTimeFormat),
+			MatchLink:       d.MatchLink,
+			Status:          d.Status.String(),
+			Winner:          d.Winner,
+		}
+	case news.News:
+		formatted = newsPayload{
+			ID:          d.ID,
+			Title:       d.Title,
+			GameID:      d.GameID,
+			GameNames:   d.GameNames,
+			Description: d.Description,
+			ImageNews:   d.ImageNews,
+			Date:        d.Date.Format(payloadTimeFormat),
+		}
+	case thread.Thread:
+		formatted = threadPayload{
+			ID:          d.ID,
+			Title:       d.Title,
+			GameID:      d.GameID,
+			GameNames:   d.GameNames,
+			Description: d.Description,
+			ImageThread: d.ImageThread,
+			Date:        d.Date.Format(payloadTimeFormat),
+		}
+	default:
+		formatted = data
+	}
+
+	return json.Marshal(payload{
+		Event:      eventType.String(),
+		CreateTime: createTime.Format(payloadTimeFormat),
+		Data:       formatted,
+	})
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"net/http"
+	"time"
+)
+
+// Following constans are config default values.
+const (
+	defaultMaxAttempts    = 5
+	defaultInitialBackoff = 1 * time.Second
+	defaultMaxBackoff     = 1 * time.Minute
+	defaultTimeout        = 5 * time.Second
+	defaultPollInterval   = 1 * time.Second
+	defaultBatchSize      = 100
+	defaultLeaseTimeout   = 5 * time.Minute
+)
+
+// service implements webhook.Service.
+type service struct {
+	pgStore    PGStore
+	config     Config
+	httpClient *http.Client
+	timeNow    func() time.Time
+}
+
+// Config denotes service configuration
+//
+// Adding a new field should also add the corresponding default
+// value in getDefaultConfig().
+type Config struct {
+	// MaxAttempts is the maximum number of attempts of a
+	// delivery before it is marked as failed.
+	MaxAttempts int
+
+	// InitialBackoff is the wait time before the first
+	// retry, it is doubled on every next retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the upper bound of the wait time between
+	// retries.
+	MaxBackoff time.Duration
+
+	// Timeout is the timeout of a single delivery request.
+	Timeout time.Duration
+
+	// PollInterval is the wait time between sends when there
+	// is no pending delivery.
+	PollInterval time.Duration
+
+	// BatchSize is the maximum number of deliveries sent in a
+	// single DeliverPending.
+	BatchSize int
+
+	// LeaseTimeout is the time a worker has to send the
+	// deliveries it claims, after which they are claimed
+	// again, e.g. when the worker has crashed.
+	LeaseTimeout time.Duration
+}
+
+// getDefaultConfig returns service configuration with the
+// predefined default values.
+func getDefaultConfig() Config {
+	return Config{
+		MaxAttempts:    defaultMaxAttempts,
+		InitialBackoff: defaultInitialBackoff,
+		MaxBackoff:     defaultMaxBackoff,
+		Timeout:        defaultTimeout,
+		PollInterval:   defaultPollInterval,
+		BatchSize:      defaultBatchSize,
+		LeaseTimeout:   defaultLeaseTimeout,
+	}
+}
+
+// New creates a new service.
+func New(pgStore PGStore, options ...Option) (*service, error) {
+	s := &service{
+		pgStore: pgStore,
+		config:  getDefaultConfig(),
+		timeNow: time.Now,
+	}
+
+	// apply options
+	for _, opt := range options {
+		if err := opt(s); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.httpClient == nil {
+		s.httpClient = &http.Client{Timeout: s.config.Timeout}
+	}
+
+	return s, nil
+}
+
+// Option controls the behavior of service.
+type Option func(*service) error
+
+// WithConfig returns Option to set service configuration.
+func WithConfig(config Config) Option {
+	return func(s *service) error {
+		if config.MaxAttempts > 0 {
+			s.config.MaxAttempts = config.MaxAttempts
+		}
+		if config.InitialBackoff > 0 {
+			s.config.InitialBackoff = config.InitialBackoff
+		}
+		if config.MaxBackoff > 0 {
+			s.config.MaxBackoff = config.MaxBackoff
+		}
+		if config.Timeout > 0 {
+			s.config.Timeout = config.Timeout
+		}
+		if config.PollInterval > 0 {
+			s.config.PollInterval = config.PollInterval
+		}
+		if config.BatchSize > 0 {
+			s.config.BatchSize = config.BatchSize
+		}
+		if config.LeaseTimeout > 0 {
+			s.config.LeaseTimeout = config.LeaseTimeout
+		}
+		return nil
+	}
+}
+
+// WithHTTPClient returns Option to set the HTTP client used
+// to send deliveries.
+func WithHTTPClient(client *http.Client) Option {
+	return func(s *service) error {
+		s.httpClient = client
+		return nil
+	}
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/x-sports/internal/webhook"
+)
+
+// PGStore is the PostgreSQL store for webhook service.
+type PGStore interface {
+	NewClient(useTx bool) (PGStoreClient, error)
+}
+
+type PGStoreClient interface {
+	// Commit commits the transaction.
+	Commit() error
+
+	// Rollback aborts the transaction.
+	Rollback() error
+
+	// CreateSubscription creates a new subscription and
+	// return the created subscription ID.
+	CreateSubscription(ctx context.Context, subscription webhook.Subscription) (int64, error)
+
+	// GetAllSubscriptions returns all subscriptions.
+	GetAllSubscriptions(ctx context.Context) ([]webhook.Subscription, error)
+
+	// GetActiveSubscriptions returns all active
+	// subscriptions subscribed to the given event type.
+	GetActiveSubscriptions(ctx context.Context, eventType webhook.EventType) ([]webhook.Subscription, error)
+
+	// GetSubscriptionByID returns a subscription with the
+	// given subscription ID.
+	GetSubscriptionByID(ctx context.Context, subscriptionID int64) (webhook.Subscription, error)
+
+	// UpdateSubscription updates a subscription.
+	UpdateSubscription(ctx context.Context, subscription webhook.Subscription) error
+
+	// DeleteSubscriptionByID deletes a subscription with the
+	// given subscription ID.
+	DeleteSubscriptionByID(ctx context.Context, subscriptionID int64) error
+
+	// CreateDelivery creates a new delivery and return the
+	// created delivery ID.
+	CreateDelivery(ctx context.Context, delivery webhook.Delivery) (int64, error)
+
+	// GetDeliveries returns all deliveries of a subscription
+	// with the given subscription ID.
+	GetDeliveries(ctx context.Context, subscriptionID int64) ([]webhook.Delivery, error)
+
+	// GetDeliveryByID returns a delivery with the given
+	// delivery ID.
+	GetDeliveryByID(ctx context.Context, deliveryID int64) (webhook.Delivery, error)
+
+	// ClaimPendingDeliveries returns at most limit pending
+	// deliveries that are due to be attempted at the given
+	// time. The returned deliveries are not due until the
+	// given lease time, so that they are not claimed by others
+	// while they are sent.
+	ClaimPendingDeliveries(ctx context.Context, now time.Time, leaseTime time.Time, limit int) ([]webhook.Delivery, error)
+
+	// UpdateDelivery updates status, attempts, response code,
+	// last error, update time, and next attempt time of a
+	// delivery.
+	UpdateDelivery(ctx context.Context, delivery webhook.Delivery) error
+}
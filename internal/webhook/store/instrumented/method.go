@@ -0,0 +1,85 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/x-sports/internal/webhook"
+)
+
+func (sc *storeClient) CreateSubscription(ctx context.Context, subscription webhook.Subscription) (int64, error) {
+	ctx, done := observe(ctx, "CreateSubscription")
+	result, err := sc.next.CreateSubscription(ctx, subscription)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetAllSubscriptions(ctx context.Context) ([]webhook.Subscription, error) {
+	ctx, done := observe(ctx, "GetAllSubscriptions")
+	result, err := sc.next.GetAllSubscriptions(ctx)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetActiveSubscriptions(ctx context.Context, eventType webhook.EventType) ([]webhook.Subscription, error) {
+	ctx, done := observe(ctx, "GetActiveSubscriptions")
+	result, err := sc.next.GetActiveSubscriptions(ctx, eventType)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetSubscriptionByID(ctx context.Context, subscriptionID int64) (webhook.Subscription, error) {
+	ctx, done := observe(ctx, "GetSubscriptionByID")
+	result, err := sc.next.GetSubscriptionByID(ctx, subscriptionID)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) UpdateSubscription(ctx context.Context, subscription webhook.Subscription) error {
+	ctx, done := observe(ctx, "UpdateSubscription")
+	err := sc.next.UpdateSubscription(ctx, subscription)
+	done(err)
+	return err
+}
+
+func (sc *storeClient) DeleteSubscriptionByID(ctx context.Context, subscriptionID int64) error {
+	ctx, done := observe(ctx, "DeleteSubscriptionByID")
+	err := sc.next.DeleteSubscriptionByID(ctx, subscriptionID)
+	done(err)
+	return err
+}
+
+func (sc *storeClient) CreateDelivery(ctx context.Context, delivery webhook.Delivery) (int64, error) {
+	ctx, done := observe(ctx, "CreateDelivery")
+	result, err := sc.next.CreateDelivery(ctx, delivery)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetDeliveries(ctx context.Context, subscriptionID int64) ([]webhook.Delivery, error) {
+	ctx, done := observe(ctx, "GetDeliveries")
+	result, err := sc.next.GetDeliveries(ctx, subscriptionID)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) GetDeliveryByID(ctx context.Context, deliveryID int64) (webhook.Delivery, error) {
+	ctx, done := observe(ctx, "GetDeliveryByID")
+	result, err := sc.next.GetDeliveryByID(ctx, deliveryID)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) ClaimPendingDeliveries(ctx context.Context, now time.Time, leaseTime time.Time, limit int) ([]webhook.Delivery, error) {
+	ctx, done := observe(ctx, "ClaimPendingDeliveries")
+	result, err := sc.next.ClaimPendingDeliveries(ctx, now, leaseTime, limit)
+	done(err)
+	return result, err
+}
+
+func (sc *storeClient) UpdateDelivery(ctx context.Context, delivery webhook.Delivery) error {
+	ctx, done := observe(ctx, "UpdateDelivery")
+	err := sc.next.UpdateDelivery(ctx, delivery)
+	done(err)
+	return err
+}
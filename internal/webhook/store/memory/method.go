@@ -0,0 +1,214 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/x-sports/internal/memory"
+	"github.com/x-sports/internal/webhook"
+)
+
+func (sc *storeClient) CreateSubscription(ctx context.Context, reqSubscription webhook.Subscription) (int64, error) {
+	var subscriptionID int64
+	err := sc.c.Do(func(data *memory.Data) error {
+		subscriptionID = data.Subscriptions.NextID()
+
+		reqSubscription.ID = subscriptionID
+		reqSubscription.EventTypes = append([]webhook.EventType(nil), reqSubscription.EventTypes...)
+		reqSubscription.UpdateTime = time.Time{}
+		data.Subscriptions.Put(subscriptionID, reqSubscription)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return subscriptionID, nil
+}
+
+func (sc *storeClient) GetAllSubscriptions(ctx context.Context) ([]webhook.Subscription, error) {
+	var subscriptions []webhook.Subscription
+	err := sc.c.Do(func(data *memory.Data) error {
+		subscriptions = data.Subscriptions.All()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func (sc *storeClient) GetActiveSubscriptions(ctx context.Context, eventType webhook.EventType) ([]webhook.Subscription, error) {
+	subscriptions := make([]webhook.Subscription, 0)
+	err := sc.c.Do(func(data *memory.Data) error {
+		for _, s := range data.Subscriptions.All() {
+			if !s.IsActive {
+				continue
+			}
+
+			for _, et := range s.EventTypes {
+				if et == eventType {
+					subscriptions = append(subscriptions, s)
+					break
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func (sc *storeClient) GetSubscriptionByID(ctx context.Context, subscriptionID int64) (webhook.Subscription, error) {
+	var result webhook.Subscription
+	err := sc.c.Do(func(data *memory.Data) error {
+		s, ok := data.Subscriptions.Get(subscriptionID)
+		if !ok {
+			return webhook.ErrSubscriptionNotFound
+		}
+
+		result = s
+		return nil
+	})
+	if err != nil {
+		return webhook.Subscription{}, err
+	}
+
+	return result, nil
+}
+
+func (sc *storeClient) UpdateSubscription(ctx context.Context, reqSubscription webhook.Subscription) error {
+	return sc.c.Do(func(data *memory.Data) error {
+		s, ok := data.Subscriptions.Get(reqSubscription.ID)
+		if !ok {
+			return webhook.ErrSubscriptionNotFound
+		}
+
+		s.URL = reqSubscription.URL
+		s.Secret = reqSubscription.Secret
+		s.EventTypes = append([]webhook.EventType(nil), reqSubscription.EventTypes...)
+		s.IsActive = reqSubscription.IsActive
+		s.UpdateTime = reqSubscription.UpdateTime
+		data.Subscriptions.Put(s.ID, s)
+
+		return nil
+	})
+}
+
+func (sc *storeClient) DeleteSubscriptionByID(ctx context.Context, subscriptionID int64) error {
+	return sc.c.Do(func(data *memory.Data) error {
+		if !data.Subscriptions.Delete(subscriptionID) {
+			return webhook.ErrSubscriptionNotFound
+		}
+		return nil
+	})
+}
+
+func (sc *storeClient) CreateDelivery(ctx context.Context, reqDelivery webhook.Delivery) (int64, error) {
+	var deliveryID int64
+	err := sc.c.Do(func(data *memory.Data) error {
+		deliveryID = data.Deliveries.NextID()
+
+		reqDelivery.ID = deliveryID
+		reqDelivery.UpdateTime = time.Time{}
+		data.Deliveries.Put(deliveryID, reqDelivery)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return deliveryID, nil
+}
+
+func (sc *storeClient) GetDeliveries(ctx context.Context, subscriptionID int64) ([]webhook.Delivery, error) {
+	deliveries := make([]webhook.Delivery, 0)
+	err := sc.c.Do(func(data *memory.Data) error {
+		for _, d := range data.Deliveries.All() {
+			if d.SubscriptionID == subscriptionID {
+				deliveries = append(deliveries, d)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(deliveries, func(i, j int) bool {
+		return deliveries[i].CreateTime.After(deliveries[j].CreateTime)
+	})
+
+	return deliveries, nil
+}
+
+func (sc *storeClient) GetDeliveryByID(ctx context.Context, deliveryID int64) (webhook.Delivery, error) {
+	var result webhook.Delivery
+	err := sc.c.Do(func(data *memory.Data) error {
+		d, ok := data.Deliveries.Get(deliveryID)
+		if !ok {
+			return webhook.ErrDeliveryNotFound
+		}
+
+		result = d
+		return nil
+	})
+	if err != nil {
+		return webhook.Delivery{}, err
+	}
+
+	return result, nil
+}
+
+func (sc *storeClient) ClaimPendingDeliveries(ctx context.Context, now time.Time, leaseTime time.Time, limit int) ([]webhook.Delivery, error) {
+	deliveries := make([]webhook.Delivery, 0)
+	err := sc.c.Do(func(data *memory.Data) error {
+		for _, d := range data.Deliveries.All() {
+			if len(deliveries) >= limit {
+				break
+			}
+			if d.Status != webhook.DeliveryStatusPending || d.NextAttemptTime.After(now) {
+				continue
+			}
+
+			d.NextAttemptTime = leaseTime
+			data.Deliveries.Put(d.ID, d)
+			deliveries = append(deliveries, d)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (sc *storeClient) UpdateDelivery(ctx context.Context, reqDelivery webhook.Delivery) error {
+	return sc.c.Do(func(data *memory.Data) error {
+		d, ok := data.Deliveries.Get(reqDelivery.ID)
+		if !ok {
+			return webhook.ErrDeliveryNotFound
+		}
+
+		d.Status = reqDelivery.Status
+		d.Attempts = reqDelivery.Attempts
+		d.ResponseCode = reqDelivery.ResponseCode
+		d.LastError = reqDelivery.LastError
+		d.UpdateTime = reqDelivery.UpdateTime
+		d.NextAttemptTime = reqDelivery.NextAttemptTime
+		data.Deliveries.Put(d.ID, d)
+
+		return nil
+	})
+}
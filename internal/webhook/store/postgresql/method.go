@@ -0,0 +1,367 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/x-sports/internal/webhook"
+)
+
+func (sc *storeClient) CreateSubscription(ctx context.Context, reqSubscription webhook.Subscription) (int64, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// construct arguments filled with fields for the query
+	argsKV := map[string]interface{}{
+		"url":         reqSubscription.URL,
+		"secret":      reqSubscription.Secret,
+		"event_types": toEventTypesDB(reqSubscription.EventTypes),
+		"is_active":   reqSubscription.IsActive,
+		"create_time": reqSubscription.CreateTime,
+	}
+
+	// prepare query
+	query, args, err := sqlx.Named(queryCreateSubscription, argsKV)
+	if err != nil {
+		return 0, err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return 0, err
+	}
+	query = sc.q.Rebind(query)
+
+	// execute query
+	var subscriptionID int64
+	err = sc.q.QueryRowxContext(ctx, query, args...).Scan(&subscriptionID)
+	if err != nil {
+		return 0, err
+	}
+
+	return subscriptionID, nil
+}
+
+func (sc *storeClient) GetAllSubscriptions(ctx context.Context) ([]webhook.Subscription, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	query := fmt.Sprintf(queryGetSubscriptions, "")
+
+	return sc.getSubscriptions(ctx, query)
+}
+
+func (sc *storeClient) GetActiveSubscriptions(ctx context.Context, eventType webhook.EventType) ([]webhook.Subscription, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	query := fmt.Sprintf(queryGetSubscriptions, "WHERE s.is_active = TRUE AND $1 = ANY(s.event_types)")
+
+	return sc.getSubscriptions(ctx, query, eventType.String())
+}
+
+// getSubscriptions returns subscriptions returned by the
+// given query.
+func (sc *storeClient) getSubscriptions(ctx context.Context, query string, args ...interface{}) ([]webhook.Subscription, error) {
+	// query to database
+	rows, err := sc.q.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// read subscriptions
+	subscriptions := make([]webhook.Subscription, 0)
+	for rows.Next() {
+		var row subscriptionDB
+		err = rows.StructScan(&row)
+		if err != nil {
+			return nil, err
+		}
+
+		subscriptions = append(subscriptions, row.format())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func (sc *storeClient) GetSubscriptionByID(ctx context.Context, subscriptionID int64) (webhook.Subscription, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	query := fmt.Sprintf(queryGetSubscriptions, "WHERE s.id = $1")
+
+	// query single row
+	var sdb subscriptionDB
+	err := sc.q.QueryRowxContext(ctx, query, subscriptionID).StructScan(&sdb)
+	if errors.Is(err, sql.ErrNoRows) {
+		return webhook.Subscription{}, webhook.ErrSubscriptionNotFound
+	}
+	if err != nil {
+		return webhook.Subscription{}, err
+	}
+
+	return sdb.format(), nil
+}
+
+func (sc *storeClient) UpdateSubscription(ctx context.Context, reqSubscription webhook.Subscription) error {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// construct arguments filled with fields for the query
+	argsKV := map[string]interface{}{
+		"id":          reqSubscription.ID,
+		"url":         reqSubscription.URL,
+		"secret":      reqSubscription.Secret,
+		"event_types": toEventTypesDB(reqSubscription.EventTypes),
+		"is_active":   reqSubscription.IsActive,
+		"update_time": reqSubscription.UpdateTime,
+	}
+
+	// prepare query
+	query, args, err := sqlx.Named(queryUpdateSubscription, argsKV)
+	if err != nil {
+		return err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return err
+	}
+	query = sc.q.Rebind(query)
+
+	// execute query
+	res, err := sc.q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	// check whether the subscription exists
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return webhook.ErrSubscriptionNotFound
+	}
+
+	return nil
+}
+
+func (sc *storeClient) DeleteSubscriptionByID(ctx context.Context, subscriptionID int64) error {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// construct arguments filled with fields for the query
+	argsKV := map[string]interface{}{
+		"id": subscriptionID,
+	}
+
+	// prepare query
+	query, args, err := sqlx.Named(queryDeleteSubscriptionByID, argsKV)
+	if err != nil {
+		return err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return err
+	}
+	query = sc.q.Rebind(query)
+
+	// execute query
+	res, err := sc.q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	// check whether the subscription exists
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return webhook.ErrSubscriptionNotFound
+	}
+
+	return nil
+}
+
+func (sc *storeClient) CreateDelivery(ctx context.Context, reqDelivery webhook.Delivery) (int64, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// construct arguments filled with fields for the query
+	argsKV := map[string]interface{}{
+		"subscription_id":   reqDelivery.SubscriptionID,
+		"event_type":        reqDelivery.EventType.String(),
+		"payload":           reqDelivery.Payload,
+		"status":            reqDelivery.Status,
+		"attempts":          reqDelivery.Attempts,
+		"response_code":     reqDelivery.ResponseCode,
+		"last_error":        reqDelivery.LastError,
+		"create_time":       reqDelivery.CreateTime,
+		"next_attempt_time": reqDelivery.NextAttemptTime,
+	}
+
+	// prepare query
+	query, args, err := sqlx.Named(queryCreateDelivery, argsKV)
+	if err != nil {
+		return 0, err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return 0, err
+	}
+	query = sc.q.Rebind(query)
+
+	// execute query
+	var deliveryID int64
+	err = sc.q.QueryRowxContext(ctx, query, args...).Scan(&deliveryID)
+	if err != nil {
+		return 0, err
+	}
+
+	return deliveryID, nil
+}
+
+func (sc *storeClient) GetDeliveries(ctx context.Context, subscriptionID int64) ([]webhook.Delivery, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	query := fmt.Sprintf(queryGetDeliveries, "WHERE d.subscription_id = $1")
+
+	// query to database
+	rows, err := sc.q.QueryxContext(ctx, query, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// read deliveries
+	deliveries := make([]webhook.Delivery, 0)
+	for rows.Next() {
+		var row deliveryDB
+		err = rows.StructScan(&row)
+		if err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, row.format())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (sc *storeClient) GetDeliveryByID(ctx context.Context, deliveryID int64) (webhook.Delivery, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	query := fmt.Sprintf(queryGetDeliveries, "WHERE d.id = $1")
+
+	// query single row
+	var ddb deliveryDB
+	err := sc.q.QueryRowxContext(ctx, query, deliveryID).StructScan(&ddb)
+	if errors.Is(err, sql.ErrNoRows) {
+		return webhook.Delivery{}, webhook.ErrDeliveryNotFound
+	}
+	if err != nil {
+		return webhook.Delivery{}, err
+	}
+
+	return ddb.format(), nil
+}
+
+func (sc *storeClient) ClaimPendingDeliveries(ctx context.Context, now time.Time, leaseTime time.Time, limit int) ([]webhook.Delivery, error) {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// query to database
+	rows, err := sc.q.QueryxContext(ctx, queryClaimPendingDeliveries, now, leaseTime, webhook.DeliveryStatusPending, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// read deliveries
+	deliveries := make([]webhook.Delivery, 0)
+	for rows.Next() {
+		var row deliveryDB
+		err = rows.StructScan(&row)
+		if err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, row.format())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (sc *storeClient) UpdateDelivery(ctx context.Context, reqDelivery webhook.Delivery) error {
+	// add query timeout to context
+	ctx, cancel := context.WithTimeout(ctx, sc.queryTimeout)
+	defer cancel()
+
+	// construct arguments filled with fields for the query
+	argsKV := map[string]interface{}{
+		"id":                reqDelivery.ID,
+		"status":            reqDelivery.Status,
+		"attempts":          reqDelivery.Attempts,
+		"response_code":     reqDelivery.ResponseCode,
+		"last_error":        reqDelivery.LastError,
+		"update_time":       reqDelivery.UpdateTime,
+		"next_attempt_time": reqDelivery.NextAttemptTime,
+	}
+
+	// prepare query
+	query, args, err := sqlx.Named(queryUpdateDelivery, argsKV)
+	if err != nil {
+		return err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return err
+	}
+	query = sc.q.Rebind(query)
+
+	// execute query
+	res, err := sc.q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	// check whether the delivery exists
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return webhook.ErrDeliveryNotFound
+	}
+
+	return nil
+}
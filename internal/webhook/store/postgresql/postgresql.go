@@ -0,0 +1,151 @@
+package postgresql
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/x-sports/internal/webhook"
+	"github.com/x-sports/internal/webhook/service"
+)
+
+var (
+	errInvalidCommit   = errors.New("cannot do commit on non-transactional querier")
+	errInvalidRollback = errors.New("cannot do rollback on non-transactional querier")
+)
+
+// store implements webhook/service.PGStore
+type store struct {
+	db           *sqlx.DB
+	queryTimeout time.Duration
+}
+
+// storeClient implements webhook/service.PGStoreClient
+type storeClient struct {
+	q            sqlx.ExtContext
+	queryTimeout time.Duration
+}
+
+// New creates a new store whose queries are limited to the
+// given timeout.
+func New(db *sqlx.DB, queryTimeout time.Duration) (*store, error) {
+	s := &store{
+		db:           db,
+		queryTimeout: queryTimeout,
+	}
+
+	return s, nil
+}
+
+func (s *store) NewClient(useTx bool) (service.PGStoreClient, error) {
+	var q sqlx.ExtContext
+
+	// determine what object should be use as querier
+	q = s.db
+	if useTx {
+		var err error
+		q, err = s.db.Beginx()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &storeClient{
+		q:            q,
+		queryTimeout: s.queryTimeout,
+	}, nil
+}
+
+func (sc *storeClient) Commit() error {
+	if tx, ok := sc.q.(*sqlx.Tx); ok {
+		return tx.Commit()
+	}
+	return errInvalidCommit
+}
+
+func (sc *storeClient) Rollback() error {
+	if tx, ok := sc.q.(*sqlx.Tx); ok {
+		return tx.Rollback()
+	}
+	return errInvalidRollback
+}
+
+// subscriptionDB denotes a subscription data in the store.
+type subscriptionDB struct {
+	ID         int64          `db:"id"`
+	URL        string         `db:"url"`
+	Secret     string         `db:"secret"`
+	EventTypes pq.StringArray `db:"event_types"`
+	IsActive   bool           `db:"is_active"`
+	CreateTime time.Time      `db:"create_time"`
+	UpdateTime *time.Time     `db:"update_time"`
+}
+
+// format formats database struct into domain struct.
+func (sdb *subscriptionDB) format() webhook.Subscription {
+	s := webhook.Subscription{
+		ID:         sdb.ID,
+		URL:        sdb.URL,
+		Secret:     sdb.Secret,
+		EventTypes: make([]webhook.EventType, 0, len(sdb.EventTypes)),
+		IsActive:   sdb.IsActive,
+		CreateTime: sdb.CreateTime,
+	}
+
+	for _, et := range sdb.EventTypes {
+		s.EventTypes = append(s.EventTypes, webhook.EventType(et))
+	}
+
+	if sdb.UpdateTime != nil {
+		s.UpdateTime = *sdb.UpdateTime
+	}
+
+	return s
+}
+
+// deliveryDB denotes a delivery data in the store.
+type deliveryDB struct {
+	ID              int64                  `db:"id"`
+	SubscriptionID  int64                  `db:"subscription_id"`
+	EventType       string                 `db:"event_type"`
+	Payload         []byte                 `db:"payload"`
+	Status          webhook.DeliveryStatus `db:"status"`
+	Attempts        int                    `db:"attempts"`
+	ResponseCode    int                    `db:"response_code"`
+	LastError       string                 `db:"last_error"`
+	CreateTime      time.Time              `db:"create_time"`
+	UpdateTime      *time.Time             `db:"update_time"`
+	NextAttemptTime time.Time              `db:"next_attempt_time"`
+}
+
+// format formats database struct into domain struct.
+func (ddb *deliveryDB) format() webhook.Delivery {
+	d := webhook.Delivery{
+		ID:              ddb.ID,
+		SubscriptionID:  ddb.SubscriptionID,
+		EventType:       webhook.EventType(ddb.EventType),
+		Payload:         ddb.Payload,
+		Status:          ddb.Status,
+		Attempts:        ddb.Attempts,
+		ResponseCode:    ddb.ResponseCode,
+		LastError:       ddb.LastError,
+		CreateTime:      ddb.CreateTime,
+		NextAttemptTime: ddb.NextAttemptTime,
+	}
+
+	if ddb.UpdateTime != nil {
+		d.UpdateTime = *ddb.UpdateTime
+	}
+
+	return d
+}
+
+// toEventTypesDB converts event types into database array.
+func toEventTypesDB(eventTypes []webhook.EventType) pq.StringArray {
+	result := make(pq.StringArray, 0, len(eventTypes))
+	for _, et := range eventTypes {
+		result = append(result, et.String())
+	}
+	return result
+}
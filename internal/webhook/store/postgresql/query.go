@@ -0,0 +1,158 @@
+package postgresql
+
+const queryCreateSubscription = `
+	INSERT INTO
+		webhook_subscription
+	(
+		url,
+		secret,
+		event_types,
+		is_active,
+		create_time
+	) VALUES (
+		:url,
+		:secret,
+		:event_types,
+		:is_active,
+		:create_time
+	)  RETURNING
+		id
+`
+
+const queryGetSubscriptions = `
+	SELECT
+		s.id,
+		s.url,
+		s.secret,
+		s.event_types,
+		s.is_active,
+		s.create_time,
+		s.update_time
+	FROM
+		webhook_subscription s
+	%s
+	ORDER BY
+		s.id
+`
+
+const queryUpdateSubscription = `
+	UPDATE
+		webhook_subscription
+	SET
+		url = :url,
+		secret = :secret,
+		event_types = :event_types,
+		is_active = :is_active,
+		update_time = :update_time
+	WHERE
+		id = :id
+`
+
+const queryDeleteSubscriptionByID = `
+	DELETE FROM
+		webhook_subscription
+	WHERE
+		id = :id
+`
+
+const queryCreateDelivery = `
+	INSERT INTO
+		webhook_delivery
+	(
+		subscription_id,
+		event_type,
+		payload,
+		status,
+		attempts,
+		response_code,
+		last_error,
+		create_time,
+		next_attempt_time
+	) VALUES (
+		:subscription_id,
+		:event_type,
+		:payload,
+		:status,
+		:attempts,
+		:response_code,
+		:last_error,
+		:create_time,
+		:next_attempt_time
+	)  RETURNING
+		id
+`
+
+const queryGetDeliveries = `
+	SELECT
+		d.id,
+		d.subscription_id,
+		d.event_type,
+		d.payload,
+		d.status,
+		d.attempts,
+		d.response_code,
+		d.last_error,
+		d.create_time,
+		d.update_time,
+		d.next_attempt_time
+	FROM
+		webhook_delivery d
+	%s
+	ORDER BY
+		d.create_time DESC
+`
+
+const queryUpdateDelivery = `
+	UPDATE
+		webhook_delivery
+	SET
+		status = :status,
+		attempts = :attempts,
+		response_code = :response_code,
+		last_error = :last_error,
+		update_time = :update_time,
+		next_attempt_time = :next_attempt_time
+	WHERE
+		id = :id
+`
+
+const queryClaimPendingDeliveries = `
+	WITH claimed AS (
+		UPDATE
+			webhook_delivery
+		SET
+			next_attempt_time = $2
+		WHERE
+			id IN (
+				SELECT
+					d.id
+				FROM
+					webhook_delivery d
+				WHERE
+					d.status = $3 AND
+					d.next_attempt_time <= $1
+				ORDER BY
+					d.id
+				LIMIT $4
+				FOR UPDATE SKIP LOCKED
+			)
+		RETURNING
+			id,
+			subscription_id,
+			event_type,
+			payload,
+			status,
+			attempts,
+			response_code,
+			last_error,
+			create_time,
+			update_time,
+			next_attempt_time
+	)
+	SELECT
+		*
+	FROM
+		claimed
+	ORDER BY
+		id
+`
@@ -0,0 +1,171 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/x-sports/internal/outbox"
+)
+
+type Service interface {
+	// CreateSubscription creates a new subscription and
+	// return the created subscription ID.
+	CreateSubscription(ctx context.Context, subscription Subscription) (int64, error)
+
+	// GetAllSubscriptions returns all subscriptions.
+	GetAllSubscriptions(ctx context.Context) ([]Subscription, error)
+
+	// GetSubscriptionByID returns a subscription with the
+	// given subscription ID.
+	GetSubscriptionByID(ctx context.Context, subscriptionID int64) (Subscription, error)
+
+	// UpdateSubscription updates existing subscription with
+	// the given subscription data.
+	//
+	// UpdateSubscription do updates on all main attributes
+	// except ID and CreateTime. So, make sure to use current
+	// values in the given data if do not want to update some
+	// specific attributes.
+	UpdateSubscription(ctx context.Context, subscription Subscription) error
+
+	// DeleteSubscriptionByID deletes a subscription with the
+	// given subscription ID.
+	DeleteSubscriptionByID(ctx context.Context, subscriptionID int64) error
+
+	// GetDeliveries returns all deliveries of a subscription
+	// with the given subscription ID.
+	GetDeliveries(ctx context.Context, subscriptionID int64) ([]Delivery, error)
+
+	// ReplayDelivery sends again the payload of a delivery
+	// with the given delivery ID as a new delivery, and
+	// return the new delivery ID.
+	ReplayDelivery(ctx context.Context, deliveryID int64) (int64, error)
+
+	// Publish sends the given event to every active
+	// subscription subscribed to the event type. The
+	// deliveries are only recorded, they are sent by Run and
+	// retried on failure.
+	Publish(ctx context.Context, eventType string, data interface{}) error
+
+	// DeliverPending sends a batch of pending deliveries that
+	// are due and return the number of deliveries sent.
+	//
+	// The deliveries are claimed before they are sent, so
+	// that other workers skip them. A failed delivery is
+	// scheduled to be attempted later, until it fails too many
+	// times.
+	DeliverPending(ctx context.Context) (int, error)
+
+	// Run sends pending deliveries periodically until the
+	// given context is done.
+	Run(ctx context.Context)
+
+	// HandleEvent handles match, news, and thread events
+	// delivered from the outbox by publishing them.
+	HandleEvent(ctx context.Context, event outbox.Event) error
+}
+
+// Subscription denotes an external URL subscribed to some
+// event types.
+type Subscription struct {
+	ID         int64
+	URL        string
+	Secret     string
+	EventTypes []EventType
+	IsActive   bool
+	CreateTime time.Time
+	UpdateTime time.Time
+}
+
+// Subscribes reports whether the subscription is subscribed
+// to the given event type.
+func (s Subscription) Subscribes(eventType EventType) bool {
+	for _, et := range s.EventTypes {
+		if et == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Delivery denotes a payload sent, or to be sent, to a
+// subscription.
+type Delivery struct {
+	ID             int64
+	SubscriptionID int64
+	EventType      EventType
+	Payload        []byte
+	Status         DeliveryStatus
+	Attempts       int
+	ResponseCode   int
+	LastError      string
+	CreateTime     time.Time
+	UpdateTime     time.Time
+
+	// NextAttemptTime is the time the pending delivery is
+	// due to be attempted.
+	NextAttemptTime time.Time
+}
+
+// EventType denotes type of an event sent to subscriptions.
+type EventType string
+
+// Followings are the known event types.
+const (
+	EventTypeMatchCreated   EventType = "match.created"
+	EventTypeMatchUpdated   EventType = "match.updated"
+	EventTypeMatchCompleted EventType = "match.completed"
+	EventTypeNewsCreated    EventType = "news.created"
+	EventTypeNewsUpdated    EventType = "news.updated"
+	EventTypeThreadCreated  EventType = "thread.created"
+	EventTypeThreadUpdated  EventType = "thread.updated"
+)
+
+var (
+	// EventTypeList is a list of valid event types.
+	EventTypeList = map[EventType]struct{}{
+		EventTypeMatchCreated:   {},
+		EventTypeMatchUpdated:   {},
+		EventTypeMatchCompleted: {},
+		EventTypeNewsCreated:    {},
+		EventTypeNewsUpdated:    {},
+		EventTypeThreadCreated:  {},
+		EventTypeThreadUpdated:  {},
+	}
+)
+
+// String returns string representaion of an event type.
+func (et EventType) String() string {
+	return string(et)
+}
+
+// DeliveryStatus denotes status of a delivery.
+type DeliveryStatus int
+
+// Followings are the known delivery statuses.
+const (
+	DeliveryStatusUnknown DeliveryStatus = 0
+	DeliveryStatusPending DeliveryStatus = 1
+	DeliveryStatusSuccess DeliveryStatus = 2
+	DeliveryStatusFailed  DeliveryStatus = 3
+)
+
+var (
+	// deliveryStatusName maps delivery status to it's string
+	// representation.
+	deliveryStatusName = map[DeliveryStatus]string{
+		DeliveryStatusPending: "pending",
+		DeliveryStatusSuccess: "success",
+		DeliveryStatusFailed:  "failed",
+	}
+)
+
+// Value returns int value of a delivery status.
+func (ds DeliveryStatus) Value() int {
+	return int(ds)
+}
+
+// String returns string representaion of a delivery status.
+func (ds DeliveryStatus) String() string {
+	return deliveryStatusName[ds]
+}